@@ -3,7 +3,10 @@ CREATE TYPE city AS ENUM ('Москва', 'Санкт-Петербург', 'Ка
 CREATE TABLE IF NOT EXISTS pvz (
     id UUID PRIMARY KEY,
     city city NOT NULL,
-    registered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+    registered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    time_zone TEXT NOT NULL DEFAULT 'Europe/Moscow',
+    opens_at TIME NOT NULL DEFAULT '00:00',
+    closes_at TIME NOT NULL DEFAULT '24:00'
 );
 
 CREATE TYPE status AS ENUM ('in_progress', 'close');
@@ -13,22 +16,215 @@ CREATE TABLE IF NOT EXISTS receptions (
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
     pvz_id UUID NOT NULL,
     status status NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    -- Users who opened and closed the reception, NULL when unknown.
+    created_by UUID,
+    closed_by UUID,
     FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
 );
 
-CREATE UNIQUE INDEX reception_in_progress_unique ON receptions (id, status) WHERE status = 'in_progress';
+CREATE INDEX receptions_created_by ON receptions (created_by);
+CREATE INDEX receptions_closed_by ON receptions (closed_by);
 
+-- At most one reception per PVZ can be in progress.
+CREATE UNIQUE INDEX reception_in_progress_unique ON receptions (pvz_id) WHERE status = 'in_progress';
 
-CREATE TYPE product_type AS ENUM ('электроника', 'одежда', 'обувь');
+
+-- Catalogue of product types managed by moderators. Names are display names
+-- keyed by language code. Inactive types stay on the products already
+-- received but cannot be used for new ones.
+CREATE TABLE IF NOT EXISTS product_types (
+    code TEXT PRIMARY KEY,
+    names JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+INSERT INTO product_types (code, names) VALUES
+    ('электроника', '{"ru": "Электроника", "en": "Electronics"}'),
+    ('одежда', '{"ru": "Одежда", "en": "Clothes"}'),
+    ('обувь', '{"ru": "Обувь", "en": "Shoes"}');
+
+CREATE TYPE inspection_status AS ENUM ('accepted', 'damaged', 'rejected');
+
+-- Lifecycle of a product at the PVZ: received into a reception, stored once
+-- the reception is closed, then issued to the customer or returned to the
+-- sender.
+CREATE TYPE product_status AS ENUM ('received', 'stored', 'issued', 'returned');
 
 CREATE TABLE IF NOT EXISTS products (
     id UUID PRIMARY KEY,
     reception_id UUID NOT NULL,
-    type product_type NOT NULL,
+    type TEXT NOT NULL REFERENCES product_types(code),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    -- Barcode or order number printed on the parcel, NULL when not scanned.
+    barcode TEXT,
+    -- Physical attributes and inspection outcome, NULL when not recorded.
+    -- Weight is in grams, dimensions in centimetres, declared value in kopecks.
+    weight_grams INTEGER CHECK (weight_grams > 0),
+    length_cm INTEGER CHECK (length_cm > 0),
+    width_cm INTEGER CHECK (width_cm > 0),
+    height_cm INTEGER CHECK (height_cm > 0),
+    declared_value BIGINT CHECK (declared_value >= 0),
+    inspection_status inspection_status,
+    inspection_reason TEXT,
+    status product_status NOT NULL DEFAULT 'received',
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+-- A parcel is received at most once per reception.
+CREATE UNIQUE INDEX products_reception_barcode_unique ON products (reception_id, barcode);
+CREATE INDEX products_barcode ON products (barcode);
+
+-- Status changes of products after they were received, with who made them,
+-- changed_by is NULL for changes made by background jobs.
+CREATE TABLE IF NOT EXISTS product_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    from_status product_status NOT NULL,
+    to_status product_status NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    changed_by UUID,
+    FOREIGN KEY(product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX product_transitions_product_id ON product_transitions (product_id);
+
+-- Customer orders waiting at a PVZ. The pickup code is told to the customer
+-- and is unique among the orders of the PVZ not picked up yet.
+CREATE TABLE IF NOT EXISTS orders (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL,
+    pickup_code TEXT NOT NULL,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    issued_at TIMESTAMP WITH TIME ZONE,
+    issued_by UUID,
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX orders_waiting_pickup_code_unique ON orders (pvz_id, pickup_code) WHERE issued_at IS NULL;
+
+-- Products of the orders, a product belongs to one order at most.
+CREATE TABLE IF NOT EXISTS order_products (
+    product_id UUID PRIMARY KEY,
+    order_id UUID NOT NULL,
+    FOREIGN KEY(product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX order_products_order_id ON order_products (order_id);
+
+CREATE TYPE cell_allocation AS ENUM ('fill_first', 'type_grouped');
+
+-- How free storage cells of a PVZ are picked for accepted products.
+CREATE TABLE IF NOT EXISTS storage_layouts (
+    pvz_id UUID PRIMARY KEY,
+    allocation cell_allocation NOT NULL DEFAULT 'fill_first',
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+-- Storage cells of a PVZ in allocation order. A typed cell is kept for
+-- products of that type under type-grouped allocation. A cell holds one product
+-- at most and is freed when the product is issued, returned or deleted.
+CREATE TABLE IF NOT EXISTS storage_cells (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL,
+    code TEXT NOT NULL,
+    type TEXT REFERENCES product_types(code),
+    position INTEGER NOT NULL,
+    product_id UUID UNIQUE,
+    UNIQUE (pvz_id, code),
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE,
+    FOREIGN KEY(product_id) REFERENCES products(id) ON DELETE SET NULL
+);
+
+CREATE INDEX storage_cells_pvz_id_position ON storage_cells (pvz_id, position);
+
+-- Expected product counts from the packing list of a delivery, attached when
+-- the reception is opened.
+CREATE TABLE IF NOT EXISTS reception_manifests (
+    reception_id UUID NOT NULL,
+    type TEXT NOT NULL REFERENCES product_types(code),
+    count INTEGER NOT NULL CHECK (count > 0),
+    PRIMARY KEY (reception_id, type),
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+-- Differences between the manifest and the products of a closed reception,
+-- one row per product type that is missing or extra.
+CREATE TABLE IF NOT EXISTS reception_discrepancies (
+    reception_id UUID NOT NULL,
+    type TEXT NOT NULL REFERENCES product_types(code),
+    missing INTEGER NOT NULL DEFAULT 0,
+    extra INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (reception_id, type),
     FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
 );
 
+-- Closed receptions put back in progress by moderators, with the close they
+-- undid.
+CREATE TABLE IF NOT EXISTS reception_reopenings (
+    id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL,
+    reopened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    reopened_by UUID NOT NULL,
+    reason TEXT NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    closed_by UUID,
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX reception_reopenings_reception_id ON reception_reopenings (reception_id);
+
+-- Products removed from a reception, kept to know who deleted them.
+CREATE TABLE IF NOT EXISTS deleted_products (
+    id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL,
+    type TEXT NOT NULL REFERENCES product_types(code),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by UUID,
+    barcode TEXT,
+    weight_grams INTEGER,
+    length_cm INTEGER,
+    width_cm INTEGER,
+    height_cm INTEGER,
+    declared_value BIGINT,
+    inspection_status inspection_status,
+    inspection_reason TEXT,
+    status product_status NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_by UUID,
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX deleted_products_reception_id ON deleted_products (reception_id);
+
+-- Daily rollups for analytics, keyed by the calendar day in the PVZ time zone.
+-- They are updated in the same transaction as the receptions and products.
+CREATE TABLE IF NOT EXISTS reception_daily_stats (
+    pvz_id UUID NOT NULL,
+    day DATE NOT NULL,
+    receptions INTEGER NOT NULL DEFAULT 0,
+    closed_receptions INTEGER NOT NULL DEFAULT 0,
+    reception_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY(pvz_id, day),
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE INDEX reception_daily_stats_day ON reception_daily_stats (day);
+
+CREATE TABLE IF NOT EXISTS product_daily_stats (
+    pvz_id UUID NOT NULL,
+    day DATE NOT NULL,
+    type TEXT NOT NULL REFERENCES product_types(code),
+    products INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(pvz_id, day, type),
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE INDEX product_daily_stats_day ON product_daily_stats (day);
+
 CREATE TYPE role AS ENUM ('employee', 'moderator');
 
 CREATE TABLE IF NOT EXISTS users (
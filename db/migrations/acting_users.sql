@@ -0,0 +1,31 @@
+-- Adds the users who opened, closed, added and deleted receptions and products
+-- to a database created before them. The users of existing rows are unknown
+-- and stay NULL. Run it once, after
+-- db/migrations/reception_in_progress_unique.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/acting_users.sql
+BEGIN;
+
+ALTER TABLE receptions
+    ADD COLUMN created_by UUID,
+    ADD COLUMN closed_by UUID;
+
+CREATE INDEX receptions_created_by ON receptions (created_by);
+CREATE INDEX receptions_closed_by ON receptions (closed_by);
+
+ALTER TABLE products ADD COLUMN created_by UUID;
+
+-- Products removed from a reception, kept to know who deleted them.
+CREATE TABLE IF NOT EXISTS deleted_products (
+    id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL,
+    type product_type NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_by UUID,
+    deleted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_by UUID,
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX deleted_products_reception_id ON deleted_products (reception_id);
+
+COMMIT;
@@ -0,0 +1,47 @@
+-- Adds the daily analytics rollups to a database created before them and
+-- fills them from the existing receptions and products. Receptions closed
+-- before the migration have no close time: they are counted as opened, not as
+-- closed, so they do not skew the average duration. Run it once, after
+-- db/migrations/pvz_working_hours.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/daily_stats.sql
+BEGIN;
+
+ALTER TABLE receptions ADD COLUMN closed_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS reception_daily_stats (
+    pvz_id UUID NOT NULL,
+    day DATE NOT NULL,
+    receptions INTEGER NOT NULL DEFAULT 0,
+    closed_receptions INTEGER NOT NULL DEFAULT 0,
+    reception_seconds DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY(pvz_id, day),
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE INDEX reception_daily_stats_day ON reception_daily_stats (day);
+
+CREATE TABLE IF NOT EXISTS product_daily_stats (
+    pvz_id UUID NOT NULL,
+    day DATE NOT NULL,
+    type product_type NOT NULL,
+    products INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(pvz_id, day, type),
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE INDEX product_daily_stats_day ON product_daily_stats (day);
+
+INSERT INTO reception_daily_stats (pvz_id, day, receptions)
+SELECT receptions.pvz_id, (receptions.created_at AT TIME ZONE pvz.time_zone)::date, count(*)
+FROM receptions
+JOIN pvz ON pvz.id = receptions.pvz_id
+GROUP BY 1, 2;
+
+INSERT INTO product_daily_stats (pvz_id, day, type, products)
+SELECT receptions.pvz_id, (products.created_at AT TIME ZONE pvz.time_zone)::date, products.type, count(*)
+FROM products
+JOIN receptions ON receptions.id = products.reception_id
+JOIN pvz ON pvz.id = receptions.pvz_id
+GROUP BY 1, 2, 3;
+
+COMMIT;
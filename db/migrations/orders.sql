@@ -0,0 +1,28 @@
+-- Adds customer orders and their pickup codes to a database created before
+-- them. Run it once, after db/migrations/product_statuses.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/orders.sql
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS orders (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL,
+    pickup_code TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    created_by UUID,
+    issued_at TIMESTAMP WITH TIME ZONE,
+    issued_by UUID,
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX orders_waiting_pickup_code_unique ON orders (pvz_id, pickup_code) WHERE issued_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS order_products (
+    product_id UUID PRIMARY KEY,
+    order_id UUID NOT NULL,
+    FOREIGN KEY(product_id) REFERENCES products(id) ON DELETE CASCADE,
+    FOREIGN KEY(order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX order_products_order_id ON order_products (order_id);
+
+COMMIT;
@@ -0,0 +1,26 @@
+-- Adds the physical attributes and inspection outcome of products to a
+-- database created before them. Run it once, after
+-- db/migrations/product_types.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/product_attributes.sql
+BEGIN;
+
+CREATE TYPE inspection_status AS ENUM ('accepted', 'damaged', 'rejected');
+
+ALTER TABLE products
+    ADD COLUMN weight_grams INTEGER CHECK (weight_grams > 0),
+    ADD COLUMN length_cm INTEGER CHECK (length_cm > 0),
+    ADD COLUMN width_cm INTEGER CHECK (width_cm > 0),
+    ADD COLUMN height_cm INTEGER CHECK (height_cm > 0),
+    ADD COLUMN declared_value BIGINT CHECK (declared_value >= 0),
+    ADD COLUMN inspection_status inspection_status,
+    ADD COLUMN inspection_reason TEXT;
+ALTER TABLE deleted_products
+    ADD COLUMN weight_grams INTEGER,
+    ADD COLUMN length_cm INTEGER,
+    ADD COLUMN width_cm INTEGER,
+    ADD COLUMN height_cm INTEGER,
+    ADD COLUMN declared_value BIGINT,
+    ADD COLUMN inspection_status inspection_status,
+    ADD COLUMN inspection_reason TEXT;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- Adds product barcodes to a database created before them. Existing products
+-- have no barcode. Run it once, after db/migrations/reception_manifests.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/product_barcodes.sql
+BEGIN;
+
+-- Barcode or order number printed on the parcel, NULL when not scanned.
+ALTER TABLE products ADD COLUMN barcode TEXT;
+ALTER TABLE deleted_products ADD COLUMN barcode TEXT;
+
+-- A parcel is received at most once per reception.
+CREATE UNIQUE INDEX products_reception_barcode_unique ON products (reception_id, barcode);
+CREATE INDEX products_barcode ON products (barcode);
+
+COMMIT;
@@ -0,0 +1,29 @@
+-- Adds the product lifecycle to a database created before it. Products of
+-- closed receptions become stored, the ones inspected as rejected returned.
+-- Run it once, after db/migrations/product_attributes.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/product_statuses.sql
+BEGIN;
+
+CREATE TYPE product_status AS ENUM ('received', 'stored', 'issued', 'returned');
+
+ALTER TABLE products ADD COLUMN status product_status NOT NULL DEFAULT 'received';
+ALTER TABLE deleted_products ADD COLUMN status product_status NOT NULL DEFAULT 'received';
+
+UPDATE products SET status = CASE WHEN products.inspection_status = 'rejected'
+    THEN 'returned'::product_status ELSE 'stored'::product_status END
+FROM receptions
+WHERE receptions.id = products.reception_id AND receptions.status = 'close';
+
+CREATE TABLE IF NOT EXISTS product_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    from_status product_status NOT NULL,
+    to_status product_status NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    changed_by UUID,
+    FOREIGN KEY(product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+CREATE INDEX product_transitions_product_id ON product_transitions (product_id);
+
+COMMIT;
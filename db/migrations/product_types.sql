@@ -0,0 +1,37 @@
+-- Moves a database created before the product catalogue from the
+-- product_type enum to the product_types table. Run it once, after
+-- db/migrations/product_barcodes.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/product_types.sql
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS product_types (
+    code TEXT PRIMARY KEY,
+    names JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+INSERT INTO product_types (code, names) VALUES
+    ('электроника', '{"ru": "Электроника", "en": "Electronics"}'),
+    ('одежда', '{"ru": "Одежда", "en": "Clothes"}'),
+    ('обувь', '{"ru": "Обувь", "en": "Shoes"}')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE products
+    ALTER COLUMN type TYPE TEXT USING type::text,
+    ADD FOREIGN KEY (type) REFERENCES product_types(code);
+ALTER TABLE deleted_products
+    ALTER COLUMN type TYPE TEXT USING type::text,
+    ADD FOREIGN KEY (type) REFERENCES product_types(code);
+ALTER TABLE reception_manifests
+    ALTER COLUMN type TYPE TEXT USING type::text,
+    ADD FOREIGN KEY (type) REFERENCES product_types(code);
+ALTER TABLE reception_discrepancies
+    ALTER COLUMN type TYPE TEXT USING type::text,
+    ADD FOREIGN KEY (type) REFERENCES product_types(code);
+ALTER TABLE product_daily_stats
+    ALTER COLUMN type TYPE TEXT USING type::text,
+    ADD FOREIGN KEY (type) REFERENCES product_types(code);
+
+DROP TYPE product_type;
+
+COMMIT;
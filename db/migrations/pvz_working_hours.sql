@@ -0,0 +1,12 @@
+-- Adds the time zone and working hours of PVZs to a database created before
+-- them. Existing PVZs work around the clock in Moscow time. Run it once, first
+-- of the migrations:
+--   psql "$DB_CONNECTION" -f db/migrations/pvz_working_hours.sql
+BEGIN;
+
+ALTER TABLE pvz
+    ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'Europe/Moscow',
+    ADD COLUMN opens_at TIME NOT NULL DEFAULT '00:00',
+    ADD COLUMN closes_at TIME NOT NULL DEFAULT '24:00';
+
+COMMIT;
@@ -0,0 +1,26 @@
+-- Replaces the reception_in_progress_unique index of a database created before
+-- it was fixed: the old index on (id, status) let a PVZ have several
+-- receptions in progress. The newest reception in progress of each PVZ is
+-- kept, the older ones are closed without a close time, like the receptions
+-- closed before the analytics. Writes to receptions wait for the migration.
+-- Run it once, after db/migrations/daily_stats.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/reception_in_progress_unique.sql
+BEGIN;
+
+LOCK TABLE receptions IN SHARE ROW EXCLUSIVE MODE;
+
+UPDATE receptions SET status = 'close'
+WHERE receptions.status = 'in_progress'
+    AND EXISTS (
+        SELECT 1 FROM receptions newer
+        WHERE newer.pvz_id = receptions.pvz_id
+            AND newer.status = 'in_progress'
+            AND (newer.created_at, newer.id) > (receptions.created_at, receptions.id)
+    );
+
+DROP INDEX reception_in_progress_unique;
+
+-- At most one reception per PVZ can be in progress.
+CREATE UNIQUE INDEX reception_in_progress_unique ON receptions (pvz_id) WHERE status = 'in_progress';
+
+COMMIT;
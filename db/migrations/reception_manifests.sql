@@ -0,0 +1,28 @@
+-- Adds the expected manifests of receptions and their discrepancy reports to
+-- a database created before them. Run it once, after
+-- db/migrations/reception_reopenings.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/reception_manifests.sql
+BEGIN;
+
+-- Expected product counts from the packing list of a delivery, attached when
+-- the reception is opened.
+CREATE TABLE IF NOT EXISTS reception_manifests (
+    reception_id UUID NOT NULL,
+    type product_type NOT NULL,
+    count INTEGER NOT NULL CHECK (count > 0),
+    PRIMARY KEY (reception_id, type),
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+-- Differences between the manifest and the products of a closed reception,
+-- one row per product type that is missing or extra.
+CREATE TABLE IF NOT EXISTS reception_discrepancies (
+    reception_id UUID NOT NULL,
+    type product_type NOT NULL,
+    missing INTEGER NOT NULL DEFAULT 0,
+    extra INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (reception_id, type),
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+COMMIT;
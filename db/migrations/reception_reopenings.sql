@@ -0,0 +1,21 @@
+-- Adds the audit of reopened receptions to a database created before it. Run
+-- it once, after db/migrations/acting_users.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/reception_reopenings.sql
+BEGIN;
+
+-- Closed receptions put back in progress by moderators, with the close they
+-- undid.
+CREATE TABLE IF NOT EXISTS reception_reopenings (
+    id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL,
+    reopened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    reopened_by UUID NOT NULL,
+    reason TEXT NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    closed_by UUID,
+    FOREIGN KEY(reception_id) REFERENCES receptions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX reception_reopenings_reception_id ON reception_reopenings (reception_id);
+
+COMMIT;
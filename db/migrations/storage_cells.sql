@@ -0,0 +1,28 @@
+-- Adds storage cells and their allocation to a database created before them.
+-- Run it once, after db/migrations/orders.sql:
+--   psql "$DB_CONNECTION" -f db/migrations/storage_cells.sql
+BEGIN;
+
+CREATE TYPE cell_allocation AS ENUM ('fill_first', 'type_grouped');
+
+CREATE TABLE IF NOT EXISTS storage_layouts (
+    pvz_id UUID PRIMARY KEY,
+    allocation cell_allocation NOT NULL DEFAULT 'fill_first',
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS storage_cells (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL,
+    code TEXT NOT NULL,
+    type TEXT REFERENCES product_types(code),
+    position INTEGER NOT NULL,
+    product_id UUID UNIQUE,
+    UNIQUE (pvz_id, code),
+    FOREIGN KEY(pvz_id) REFERENCES pvz(id) ON DELETE CASCADE,
+    FOREIGN KEY(product_id) REFERENCES products(id) ON DELETE SET NULL
+);
+
+CREATE INDEX storage_cells_pvz_id_position ON storage_cells (pvz_id, position);
+
+COMMIT;
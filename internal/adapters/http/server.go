@@ -2,15 +2,27 @@ package http
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"avito_pvz/internal/domain"
 	oapi "avito_pvz/internal/generated/oapi"
+
+	"github.com/oapi-codegen/runtime/types"
 )
 
+// CtxAcceptKey holds the Accept header of the request in the handler context.
+const CtxAcceptKey string = "accept_media_type"
+
+// mediaTypePVZPageV2 selects the paged response of GET /pvz.
+const mediaTypePVZPageV2 = "application/vnd.avito-pvz.v2+json"
+
 type Server struct {
-	pvzs       domain.PVZsInterface
-	receptions domain.ReceptionsInterface
-	users      domain.UsersInterface
+	pvzs         domain.PVZsInterface
+	receptions   domain.ReceptionsInterface
+	users        domain.UsersInterface
+	analytics    domain.AnalyticsInterface
+	productTypes domain.ProductTypesInterface
 }
 
 var _ oapi.StrictServerInterface = (*Server)(nil)
@@ -19,11 +31,15 @@ func NewServer(
 	pvzs domain.PVZsInterface,
 	receptions domain.ReceptionsInterface,
 	users domain.UsersInterface,
+	analytics domain.AnalyticsInterface,
+	productTypes domain.ProductTypesInterface,
 ) *Server {
 	return &Server{
-		pvzs:       pvzs,
-		receptions: receptions,
-		users:      users,
+		pvzs:         pvzs,
+		receptions:   receptions,
+		users:        users,
+		analytics:    analytics,
+		productTypes: productTypes,
 	}
 }
 
@@ -36,3 +52,50 @@ func (s *Server) GetCurrentUserFromCtx(ctx context.Context) domain.Authenticated
 
 	return authUser
 }
+
+func acceptsMediaType(ctx context.Context, mediaType string) bool {
+	accept, _ := ctx.Value(CtxAcceptKey).(string)
+
+	return strings.Contains(accept, mediaType)
+}
+
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
+
+func dateOrNil(date *types.Date) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	return &date.Time
+}
+
+// convertEnum maps an optional generated enum value to its domain type.
+func convertEnum[R, T ~string](v *T) *R {
+	if v == nil {
+		return nil
+	}
+
+	converted := R(*v)
+	return &converted
+}
+
+// convertEnums maps optional generated enum values to their domain type.
+func convertEnums[R, T ~string](values *[]T) []R {
+	if values == nil {
+		return nil
+	}
+
+	converted := make([]R, 0, len(*values))
+	for _, v := range *values {
+		converted = append(converted, R(v))
+	}
+
+	return converted
+}
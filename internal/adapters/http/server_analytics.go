@@ -0,0 +1,163 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+	oapi "avito_pvz/internal/generated/oapi"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Server) GetAnalyticsPvzDaily(
+	ctx context.Context,
+	request oapi.GetAnalyticsPvzDailyRequestObject,
+) (oapi.GetAnalyticsPvzDailyResponseObject, error) {
+	stats, err := s.analytics.Daily(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		nil,
+		request.Params.From.Time,
+		request.Params.To.Time,
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsPvzDaily403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsPvzDaily400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.GetAnalyticsPvzDaily200JSONResponse(toDailyStats(stats)), nil
+}
+
+func (s *Server) GetAnalyticsPvzPvzIdDaily(
+	ctx context.Context,
+	request oapi.GetAnalyticsPvzPvzIdDailyRequestObject,
+) (oapi.GetAnalyticsPvzPvzIdDailyResponseObject, error) {
+	stats, err := s.analytics.Daily(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		&request.PvzId,
+		request.Params.From.Time,
+		request.Params.To.Time,
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsPvzPvzIdDaily403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrPVZNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsPvzPvzIdDaily404JSONResponse{
+			Message: "ПВЗ не найден",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsPvzPvzIdDaily400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.GetAnalyticsPvzPvzIdDaily200JSONResponse(toDailyStats(stats)), nil
+}
+
+func (s *Server) GetAnalyticsCities(
+	ctx context.Context,
+	request oapi.GetAnalyticsCitiesRequestObject,
+) (oapi.GetAnalyticsCitiesResponseObject, error) {
+	report, err := s.analytics.Cities(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		request.Params.From.Time,
+		request.Params.To.Time,
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsCities403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetAnalyticsCities400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := oapi.GetAnalyticsCities200JSONResponse{
+		From:         types.Date{Time: report.From},
+		To:           types.Date{Time: report.To},
+		PreviousFrom: types.Date{Time: report.PreviousFrom},
+		PreviousTo:   types.Date{Time: report.PreviousTo},
+		Cities:       make([]oapi.CityReport, 0, len(report.Cities)),
+		Fleet: oapi.ReportComparison{
+			Current:      toReportStats(report.Fleet.Current),
+			PreviousWeek: toReportStats(report.Fleet.PreviousWeek),
+		},
+	}
+	for _, city := range report.Cities {
+		response.Cities = append(response.Cities, oapi.CityReport{
+			City:         oapi.CityReportCity(city.City),
+			Current:      toReportStats(city.Current),
+			PreviousWeek: toReportStats(city.PreviousWeek),
+		})
+	}
+
+	return response, nil
+}
+
+func toReportStats(stats domain.CityStats) oapi.ReportStats {
+	busiest := make([]oapi.BusyPVZ, 0, len(stats.Busiest))
+	for _, pvz := range stats.Busiest {
+		busiest = append(busiest, oapi.BusyPVZ{
+			PvzId:      pvz.PVZID,
+			Receptions: pvz.Receptions,
+			Products:   pvz.Products,
+		})
+	}
+
+	return oapi.ReportStats{
+		Pvzs:             stats.PVZs,
+		Receptions:       stats.Receptions,
+		ReceptionsPerDay: float32(stats.ReceptionsPerDay),
+		Products:         toProductCounts(stats.ProductsByType),
+		Busiest:          busiest,
+	}
+}
+
+func toProductCounts(productsByType map[domain.ProductType]int) map[string]int {
+	products := make(map[string]int, len(productsByType))
+	for productType, count := range productsByType {
+		products[string(productType)] = count
+	}
+
+	return products
+}
+
+func toDailyStats(stats []domain.DailyStats) []oapi.DailyStats {
+	result := make([]oapi.DailyStats, 0, len(stats))
+	for _, day := range stats {
+		result = append(result, oapi.DailyStats{
+			Date:                    types.Date{Time: day.Day},
+			Receptions:              day.Receptions,
+			ClosedReceptions:        day.ClosedReceptions,
+			AverageReceptionSeconds: float32(day.AvgReceptionDuration.Seconds()),
+			Products:                toProductCounts(day.ProductsByType),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,229 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/adapters/http"
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	oapi "avito_pvz/internal/generated/oapi"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_GetAnalyticsPvzPvzIdDaily(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	from := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC)
+	params := oapi.GetAnalyticsPvzPvzIdDailyParams{From: types.Date{Time: from}, To: types.Date{Time: to}}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		params       oapi.GetAnalyticsPvzPvzIdDailyParams
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockAnalyticsRepository, *mocks.MockPVZsRepository)
+		check        func(*testing.T, oapi.GetAnalyticsPvzPvzIdDailyResponseObject, error)
+	}{
+		{
+			name:   "Success",
+			role:   domain.Moderator,
+			params: params,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				analyticsRepo *mocks.MockAnalyticsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil)
+				analyticsRepo.EXPECT().
+					Daily(mock.Anything, mock.Anything, &pvzID, from, to).
+					Return([]domain.DailyStats{{
+						Day:                  to,
+						Receptions:           1,
+						ClosedReceptions:     1,
+						AvgReceptionDuration: 90 * time.Second,
+						ProductsByType:       map[domain.ProductType]int{domain.Electronics: 2},
+					}}, nil)
+			},
+			check: func(t *testing.T, response oapi.GetAnalyticsPvzPvzIdDailyResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetAnalyticsPvzPvzIdDaily200JSONResponse)
+				require.True(t, ok)
+				require.Len(t, res, 2)
+				assert.Zero(t, res[0].Receptions)
+				assert.Equal(t, 1, res[1].Receptions)
+				assert.InDelta(t, 90, res[1].AverageReceptionSeconds, 0.001)
+				assert.Equal(t, map[string]int{"электроника": 2}, res[1].Products)
+			},
+		},
+		{
+			name:   "PVZ not found",
+			role:   domain.Employee,
+			params: params,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockAnalyticsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzRepo.EXPECT().
+					FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{}, domain.ErrPVZNotFound)
+			},
+			check: func(t *testing.T, response oapi.GetAnalyticsPvzPvzIdDailyResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetAnalyticsPvzPvzIdDaily404JSONResponse{}, response)
+			},
+		},
+		{
+			name:   "Invalid range",
+			role:   domain.Employee,
+			params: oapi.GetAnalyticsPvzPvzIdDailyParams{From: params.To, To: params.From},
+			check: func(t *testing.T, response oapi.GetAnalyticsPvzPvzIdDailyResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetAnalyticsPvzPvzIdDaily400JSONResponse{}, response)
+			},
+		},
+		{
+			name:   "Unknown role",
+			role:   domain.UserRole("guest"),
+			params: params,
+			check: func(t *testing.T, response oapi.GetAnalyticsPvzPvzIdDailyResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetAnalyticsPvzPvzIdDaily403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, analyticsRepo, pvzRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				nil,
+				nil,
+				domain.NewAnalyticsService(provider, analyticsRepo, pvzRepo),
+				nil,
+			)
+
+			response, err := server.GetAnalyticsPvzPvzIdDaily(
+				fixtureAuthCtx(t, test.role),
+				oapi.GetAnalyticsPvzPvzIdDailyRequestObject{PvzId: pvzID, Params: test.params},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_GetAnalyticsCities(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, time.April, 7, 0, 0, 0, 0, time.UTC)
+	params := oapi.GetAnalyticsCitiesParams{From: types.Date{Time: from}, To: types.Date{Time: from}}
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockAnalyticsRepository)
+		check        func(*testing.T, oapi.GetAnalyticsCitiesResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Moderator,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, analyticsRepo *mocks.MockAnalyticsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				analyticsRepo.EXPECT().
+					Cities(mock.Anything, mock.Anything, from, from, domain.BusiestPVZs).
+					Return([]domain.CityStats{{
+						City:           domain.Msk,
+						PVZs:           1,
+						Receptions:     2,
+						ProductsByType: map[domain.ProductType]int{domain.Shoes: 3},
+						Busiest:        []domain.BusyPVZ{{PVZID: pvzID, Receptions: 2, Products: 3}},
+					}}, nil)
+				analyticsRepo.EXPECT().
+					Cities(mock.Anything, mock.Anything, from.AddDate(0, 0, -7), from.AddDate(0, 0, -7), domain.BusiestPVZs).
+					Return(nil, nil)
+			},
+			check: func(t *testing.T, response oapi.GetAnalyticsCitiesResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetAnalyticsCities200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, from.AddDate(0, 0, -7), res.PreviousFrom.Time)
+				assert.Equal(t, from.AddDate(0, 0, -7), res.PreviousTo.Time)
+				require.Len(t, res.Cities, 1)
+				assert.Equal(t, oapi.CityReportCity("Москва"), res.Cities[0].City)
+				assert.Equal(t, 2, res.Cities[0].Current.Receptions)
+				assert.Zero(t, res.Cities[0].PreviousWeek.Receptions)
+				require.Len(t, res.Fleet.Current.Busiest, 1)
+				assert.Equal(t, pvzID, res.Fleet.Current.Busiest[0].PvzId)
+				assert.Equal(t, map[string]int{"обувь": 3}, res.Fleet.Current.Products)
+			},
+		},
+		{
+			name: "Employee",
+			role: domain.Employee,
+			check: func(t *testing.T, response oapi.GetAnalyticsCitiesResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetAnalyticsCities403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, analyticsRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				nil,
+				nil,
+				domain.NewAnalyticsService(provider, analyticsRepo, mocks.NewMockPVZsRepository(t)),
+				nil,
+			)
+
+			response, err := server.GetAnalyticsCities(
+				fixtureAuthCtx(t, test.role),
+				oapi.GetAnalyticsCitiesRequestObject{Params: params},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
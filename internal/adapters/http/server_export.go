@@ -0,0 +1,192 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	nethttp "net/http"
+	"time"
+
+	"avito_pvz/internal/domain"
+	oapi "avito_pvz/internal/generated/oapi"
+	"avito_pvz/internal/infra/xlsx"
+)
+
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+var exportHeader = []string{
+	"pvz_id",
+	"pvz_city",
+	"pvz_registered_at",
+	"reception_id",
+	"reception_created_at",
+	"reception_status",
+	"product_id",
+	"product_type",
+	"product_created_at",
+	"reception_created_by",
+	"reception_closed_by",
+	"product_created_by",
+	"product_barcode",
+	"product_status",
+}
+
+func (s *Server) GetPvzExport(
+	ctx context.Context,
+	request oapi.GetPvzExportRequestObject,
+) (oapi.GetPvzExportResponseObject, error) {
+	return pvzExportResponse{
+		ctx:      ctx,
+		pvzs:     s.pvzs,
+		authUser: s.GetCurrentUserFromCtx(ctx),
+		format:   valueOrZero(request.Params.Format),
+		filter: domain.PVZFilter{
+			Period: domain.Period{
+				From:      request.Params.StartDate,
+				To:        request.Params.EndDate,
+				LocalFrom: dateOrNil(request.Params.StartLocalDate),
+				LocalTo:   dateOrNil(request.Params.EndLocalDate),
+			},
+			Cities:             convertEnums[domain.PVZCity](request.Params.City),
+			Status:             convertEnum[domain.PVZStatus](request.Params.Status),
+			ProductTypes:       convertEnums[domain.ProductType](request.Params.ProductType),
+			ProductStatuses:    convertEnums[domain.ProductStatus](request.Params.ProductStatus),
+			ReceptionStatus:    convertEnum[domain.ReceptionStatus](request.Params.ReceptionStatus),
+			ReceptionCreatedBy: request.Params.ReceptionCreatedBy,
+			HasActiveReception: request.Params.HasActiveReception,
+			Sort:               domain.PVZSort(valueOrZero(request.Params.Sort)),
+			Descending:         valueOrZero(request.Params.Order) == oapi.GetPvzExportParamsOrderDesc,
+		},
+	}, nil
+}
+
+// pvzExportResponse runs the export while the response is written, so rows go
+// to the client as they are read. Status and headers are sent with the first
+// row; until then a failed export is still answered with a JSON error.
+type pvzExportResponse struct {
+	ctx      context.Context
+	pvzs     domain.PVZsInterface
+	authUser domain.AuthenticatedUser
+	format   oapi.GetPvzExportParamsFormat
+	filter   domain.PVZFilter
+}
+
+func (r pvzExportResponse) VisitGetPvzExportResponse(w nethttp.ResponseWriter) error {
+	var rows exportWriter
+	start := func() error {
+		var err error
+		rows, err = r.start(w)
+		if err != nil {
+			return err
+		}
+
+		return rows.WriteRow(exportHeader)
+	}
+
+	started := false
+	err := r.pvzs.Export(r.ctx, r.authUser, r.filter, func(row domain.ExportRow) error {
+		if !started {
+			started = true
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		return rows.WriteRow(toExportRecord(row))
+	})
+
+	if err == domain.ErrNotAuthorized {
+		return oapi.GetPvzExport403JSONResponse{
+			Message: "Доступ запрещен",
+		}.VisitGetPvzExportResponse(w)
+	}
+
+	if err != nil && !started {
+		return oapi.GetPvzExport400JSONResponse{
+			Message: "Неверный запрос",
+		}.VisitGetPvzExportResponse(w)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !started {
+		if err := start(); err != nil {
+			return err
+		}
+	}
+
+	return rows.Close()
+}
+
+func (r pvzExportResponse) start(w nethttp.ResponseWriter) (exportWriter, error) {
+	if r.format == oapi.GetPvzExportParamsFormatXlsx {
+		w.Header().Set("Content-Type", xlsxContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="pvz.xlsx"`)
+		w.WriteHeader(nethttp.StatusOK)
+
+		sheet, err := xlsx.NewWriter(w, "ПВЗ")
+		if err != nil {
+			return nil, err
+		}
+
+		return sheet, nil
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="pvz.csv"`)
+	w.WriteHeader(nethttp.StatusOK)
+
+	return newCSVWriter(w), nil
+}
+
+type exportWriter interface {
+	WriteRow(cells []string) error
+	Close() error
+}
+
+type csvWriter struct {
+	*csv.Writer
+}
+
+func newCSVWriter(w io.Writer) csvWriter {
+	return csvWriter{Writer: csv.NewWriter(w)}
+}
+
+func (w csvWriter) WriteRow(cells []string) error {
+	return w.Write(cells)
+}
+
+func (w csvWriter) Close() error {
+	w.Flush()
+
+	return w.Error()
+}
+
+func toExportRecord(row domain.ExportRow) []string {
+	return []string{
+		row.PVZ.ID.String(),
+		string(row.PVZ.City),
+		row.PVZ.RegisteredAt.Format(time.RFC3339),
+		row.Reception.ID.String(),
+		row.Reception.CreatedAt.Format(time.RFC3339),
+		string(row.Reception.Status),
+		row.Product.ID.String(),
+		string(row.Product.Type),
+		row.Product.CreatedAt.Format(time.RFC3339),
+		userOrEmpty(row.Reception.CreatedBy),
+		userOrEmpty(row.Reception.ClosedBy),
+		userOrEmpty(row.Product.CreatedBy),
+		valueOrZero(row.Product.Barcode),
+		string(row.Product.Status),
+	}
+}
+
+func userOrEmpty(userID *domain.UserID) string {
+	if userID == nil {
+		return ""
+	}
+
+	return userID.String()
+}
@@ -0,0 +1,244 @@
+package http_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/adapters/http"
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	oapi "avito_pvz/internal/generated/oapi"
+	"avito_pvz/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_GetPvzExport(t *testing.T) {
+	t.Parallel()
+
+	registeredAt := time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+	row := domain.ExportRow{
+		PVZ: domain.PVZ{ID: uuid.New(), City: domain.Msk, RegisteredAt: registeredAt},
+		Reception: domain.Reception{
+			ID:        uuid.New(),
+			CreatedAt: registeredAt.Add(time.Hour),
+			Status:    domain.Close,
+			CreatedBy: pointer.Ref(uuid.New()),
+		},
+		Product: domain.Product{
+			ID:        uuid.New(),
+			CreatedAt: registeredAt.Add(2 * time.Hour),
+			Type:      domain.Shoes,
+			Barcode:   pointer.Ref("4600000000017"),
+			Status:    domain.ProductStored,
+		},
+	}
+	exportRows := func(rows ...domain.ExportRow) func(
+		context.Context, domain.Connection, domain.PVZFilter, func(domain.ExportRow) error,
+	) error {
+		return func(_ context.Context, _ domain.Connection, _ domain.PVZFilter, each func(domain.ExportRow) error) error {
+			for _, row := range rows {
+				if err := each(row); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name         string
+		ctx          func(*testing.T) context.Context
+		params       oapi.GetPvzExportParams
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, *httptest.ResponseRecorder, error)
+	}{
+		{
+			name:   "CSV",
+			params: oapi.GetPvzExportParams{Order: pointer.Ref(oapi.GetPvzExportParamsOrderDesc)},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Export(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt, Descending: true},
+						mock.Anything,
+					).
+					RunAndReturn(exportRows(row))
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 200, recorder.Code)
+				assert.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+				assert.Contains(t, recorder.Header().Get("Content-Disposition"), "pvz.csv")
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 2)
+				assert.Equal(t, "pvz_id", records[0][0])
+				assert.Equal(t, []string{
+					row.PVZ.ID.String(),
+					"Москва",
+					"2025-04-01T09:00:00Z",
+					row.Reception.ID.String(),
+					"2025-04-01T10:00:00Z",
+					"close",
+					row.Product.ID.String(),
+					"обувь",
+					"2025-04-01T11:00:00Z",
+					row.Reception.CreatedBy.String(),
+					"",
+					"",
+					"4600000000017",
+					"stored",
+				}, records[1])
+			},
+		},
+		{
+			name:   "XLSX",
+			params: oapi.GetPvzExportParams{Format: pointer.Ref(oapi.GetPvzExportParamsFormatXlsx)},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Export(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(exportRows(row, row))
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 200, recorder.Code)
+				assert.Contains(t, recorder.Header().Get("Content-Type"), "spreadsheetml")
+
+				body := recorder.Body.Bytes()
+				archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+				require.NoError(t, err)
+
+				var names []string
+				for _, file := range archive.File {
+					names = append(names, file.Name)
+				}
+				assert.Contains(t, names, "xl/worksheets/sheet1.xml")
+			},
+		},
+		{
+			name: "Empty export has header",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Export(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(exportRows())
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 200, recorder.Code)
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 1)
+			},
+		},
+		{
+			name: "Not authorized",
+			ctx:  func(t *testing.T) context.Context { return t.Context() },
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 403, recorder.Code)
+				assert.Contains(t, recorder.Body.String(), "Доступ запрещен")
+			},
+		},
+		{
+			name: "Invalid filter",
+			params: oapi.GetPvzExportParams{
+				City: &oapi.PVZListCity{"Новосибирск"},
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 400, recorder.Code)
+			},
+		},
+		{
+			name: "Error after first row",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Export(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(
+						ctx context.Context,
+						c domain.Connection,
+						filter domain.PVZFilter,
+						each func(domain.ExportRow) error,
+					) error {
+						require.NoError(t, exportRows(row)(ctx, c, filter, each))
+
+						return errors.New("connection lost")
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.Error(t, err)
+				assert.Equal(t, 200, recorder.Code)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := mocks.NewMockConnectionProvider(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(conn, pvzRepo)
+			}
+
+			server := http.NewServer(
+				domain.NewPVZService(conn, pvzRepo, productRepo, receptionRepo, metrics),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			ctx := fixtureAuthCtx(t, domain.Employee)
+			if test.ctx != nil {
+				ctx = test.ctx(t)
+			}
+
+			response, err := server.GetPvzExport(ctx, oapi.GetPvzExportRequestObject{Params: test.params})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			err = response.VisitGetPvzExportResponse(recorder)
+			test.check(t, recorder, err)
+		})
+	}
+}
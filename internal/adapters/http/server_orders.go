@@ -0,0 +1,105 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+	oapi "avito_pvz/internal/generated/oapi"
+)
+
+func (s *Server) PostPvzPvzIdOrders(
+	ctx context.Context,
+	request oapi.PostPvzPvzIdOrdersRequestObject,
+) (oapi.PostPvzPvzIdOrdersResponseObject, error) {
+	order, err := s.receptions.CreateOrder(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		request.PvzId,
+		request.Body.ProductIds,
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdOrders403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdOrders404JSONResponse{
+			Message: "Товар не найден на ПВЗ",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInOrder) ||
+		errors.Is(err, domain.ErrAvitoServiceCreateOrderUnavailableProduct) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdOrders409JSONResponse{
+			Message: "Товар уже в заказе, выдан или возвращен",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdOrders400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PostPvzPvzIdOrders201JSONResponse(toOrder(order)), nil
+}
+
+func (s *Server) PostPvzPvzIdPickup(
+	ctx context.Context,
+	request oapi.PostPvzPvzIdPickupRequestObject,
+) (oapi.PostPvzPvzIdPickupResponseObject, error) {
+	order, err := s.receptions.Pickup(ctx, s.GetCurrentUserFromCtx(ctx), request.PvzId, request.Body.Code)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdPickup403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrOrderNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdPickup404JSONResponse{
+			Message: "На ПВЗ нет ожидающего заказа с этим кодом",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInvalidTransition) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdPickup409JSONResponse{
+			Message: "Не все товары заказа хранятся на ПВЗ",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdPickup400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PostPvzPvzIdPickup200JSONResponse(toOrder(order)), nil
+}
+
+func toOrder(order domain.OrderProducts) oapi.Order {
+	products := make([]oapi.Product, 0, len(order.Products))
+	for _, product := range order.Products {
+		products = append(products, toProduct(product))
+	}
+
+	return oapi.Order{
+		Id:         order.Order.ID,
+		PvzId:      order.Order.PVZID,
+		PickupCode: order.Order.PickupCode,
+		DateTime:   order.Order.CreatedAt,
+		CreatedBy:  order.Order.CreatedBy,
+		IssuedAt:   order.Order.IssuedAt,
+		IssuedBy:   order.Order.IssuedBy,
+		Products:   products,
+	}
+}
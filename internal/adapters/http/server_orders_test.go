@@ -0,0 +1,419 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"avito_pvz/internal/adapters/http"
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	oapi "avito_pvz/internal/generated/oapi"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_PostPvzPvzIdOrders(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := domain.Reception{ID: uuid.New(), PVZID: pvzID}
+	product := domain.Product{
+		ID:          uuid.New(),
+		ReceptionID: reception.ID,
+		Type:        domain.Shoes,
+		Status:      domain.ProductStored,
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		body         *oapi.PostPvzPvzIdOrdersJSONRequestBody
+		prepareMocks func(
+			*mocks.MockConnectionProvider,
+			*mocks.MockReceptionsRepository,
+			*mocks.MockProductsRepository,
+			*mocks.MockOrdersRepository,
+		)
+		check func(*testing.T, oapi.PostPvzPvzIdOrdersResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Employee,
+			body: &oapi.PostPvzPvzIdOrdersJSONRequestBody{ProductIds: []uuid.UUID{product.ID}},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product}, nil)
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{reception}, nil)
+				orders.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdOrdersResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostPvzPvzIdOrders201JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, pvzID, res.PvzId)
+				assert.Regexp(t, `^[0-9]{6}$`, res.PickupCode)
+				assert.Nil(t, res.IssuedAt)
+				require.Len(t, res.Products, 1)
+				assert.Equal(t, product.ID, *res.Products[0].Id)
+			},
+		},
+		{
+			name: "Product not found",
+			role: domain.Employee,
+			body: &oapi.PostPvzPvzIdOrdersJSONRequestBody{ProductIds: []uuid.UUID{product.ID}},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				_ *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				products.EXPECT().FindByIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+				receptions.EXPECT().FindByIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdOrdersResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdOrders404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Product in another order",
+			role: domain.Employee,
+			body: &oapi.PostPvzPvzIdOrdersJSONRequestBody{ProductIds: []uuid.UUID{product.ID}},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product}, nil)
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{reception}, nil)
+				orders.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrProductInOrder)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdOrdersResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdOrders409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Repeated product",
+			role: domain.Employee,
+			body: &oapi.PostPvzPvzIdOrdersJSONRequestBody{ProductIds: []uuid.UUID{product.ID, product.ID}},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdOrdersResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdOrders400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Moderator not authorized",
+			role: domain.Moderator,
+			body: &oapi.PostPvzPvzIdOrdersJSONRequestBody{ProductIds: []uuid.UUID{product.ID}},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdOrdersResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdOrders403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+			orderRepo := mocks.NewMockOrdersRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, receptionRepo, productRepo, orderRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					productRepo,
+					mocks.NewMockPVZsRepository(t),
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					orderRepo,
+					mocks.NewMockStorageCellsRepository(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostPvzPvzIdOrders(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostPvzPvzIdOrdersRequestObject{PvzId: pvzID, Body: test.body},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PostPvzPvzIdPickup(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	order := domain.Order{ID: uuid.New(), PVZID: pvzID, PickupCode: "123456"}
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		code         string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository, *mocks.MockOrdersRepository)
+		check        func(*testing.T, oapi.PostPvzPvzIdPickupResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Employee,
+			code: order.PickupCode,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				orders.EXPECT().FindWaiting(mock.Anything, mock.Anything, pvzID, order.PickupCode).Return(order, nil)
+				orders.EXPECT().
+					FindProducts(mock.Anything, mock.Anything, order.ID).
+					Return([]domain.Product{product}, nil)
+				issued := product
+				issued.Status = domain.ProductIssued
+				products.EXPECT().ChangeStatus(mock.Anything, mock.Anything, mock.Anything).Return(issued, nil)
+				orders.EXPECT().MarkIssued(mock.Anything, mock.Anything, order.ID, mock.Anything).Return(nil)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdPickupResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostPvzPvzIdPickup200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, order.ID, res.Id)
+				assert.NotNil(t, res.IssuedAt)
+				require.Len(t, res.Products, 1)
+				assert.Equal(t, oapi.Issued, res.Products[0].Status)
+			},
+		},
+		{
+			name: "Order not found",
+			role: domain.Employee,
+			code: order.PickupCode,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				orders.EXPECT().
+					FindWaiting(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Order{}, domain.ErrOrderNotFound)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdPickupResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdPickup404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Product not stored",
+			role: domain.Employee,
+			code: order.PickupCode,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				received := product
+				received.Status = domain.ProductReceived
+				orders.EXPECT().
+					FindWaiting(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(order, nil)
+				orders.EXPECT().
+					FindProducts(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{received}, nil)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdPickupResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdPickup409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Invalid code",
+			role: domain.Employee,
+			code: "1234",
+			check: func(t *testing.T, response oapi.PostPvzPvzIdPickupResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdPickup400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Moderator not authorized",
+			role: domain.Moderator,
+			code: order.PickupCode,
+			check: func(t *testing.T, response oapi.PostPvzPvzIdPickupResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdPickup403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+			orderRepo := mocks.NewMockOrdersRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, productRepo, orderRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					mocks.NewMockReceptionsRepository(t),
+					productRepo,
+					mocks.NewMockPVZsRepository(t),
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					orderRepo,
+					fixtureStorageCells(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostPvzPvzIdPickup(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostPvzPvzIdPickupRequestObject{
+					PvzId: pvzID,
+					Body:  &oapi.PostPvzPvzIdPickupJSONRequestBody{Code: test.code},
+				},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+// A product of a waiting order is issued only with the order, on its own it is
+// neither issued nor returned.
+func TestServer_PostProductsProductIdIssueOrdered(t *testing.T) {
+	t.Parallel()
+
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	provider := mocks.NewMockConnectionProvider(t)
+	provider.EXPECT().
+		ExecuteTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+			return f(ctx, nil)
+		}).
+		Twice()
+	productRepo := mocks.NewMockProductsRepository(t)
+	productRepo.EXPECT().
+		FindByIDs(mock.Anything, mock.Anything, []domain.ProductID{product.ID}).
+		Return([]domain.Product{product}, nil).
+		Twice()
+	orderRepo := mocks.NewMockOrdersRepository(t)
+	orderRepo.EXPECT().
+		FindWaitingByProduct(mock.Anything, mock.Anything, product.ID).
+		Return(domain.Order{ID: uuid.New()}, nil).
+		Twice()
+
+	server := http.NewServer(
+		nil,
+		domain.NewReceptionService(
+			provider,
+			mocks.NewMockReceptionsRepository(t),
+			productRepo,
+			mocks.NewMockPVZsRepository(t),
+			mocks.NewMockAnalyticsRepository(t),
+			mocks.NewMockProductTypesRepository(t),
+			orderRepo,
+			mocks.NewMockStorageCellsRepository(t),
+			mocks.NewMockMetrics(t),
+		),
+		nil,
+		nil,
+		nil,
+	)
+	ctx := fixtureAuthCtx(t, domain.Employee)
+
+	issued, err := server.PostProductsProductIdIssue(
+		ctx,
+		oapi.PostProductsProductIdIssueRequestObject{ProductId: product.ID},
+	)
+	require.NoError(t, err)
+	require.IsType(t, oapi.PostProductsProductIdIssue409JSONResponse{}, issued)
+
+	returned, err := server.PostProductsProductIdReturn(
+		ctx,
+		oapi.PostProductsProductIdReturnRequestObject{ProductId: product.ID},
+	)
+	require.NoError(t, err)
+	require.IsType(t, oapi.PostProductsProductIdReturn409JSONResponse{}, returned)
+}
+
+// fixtureNoWaitingOrders stands for products that belong to no waiting order.
+func fixtureNoWaitingOrders(t *testing.T) *mocks.MockOrdersRepository {
+	t.Helper()
+
+	repo := mocks.NewMockOrdersRepository(t)
+	repo.EXPECT().
+		FindWaitingByProduct(mock.Anything, mock.Anything, mock.Anything).
+		Return(domain.Order{}, domain.ErrOrderNotFound).
+		Maybe()
+
+	return repo
+}
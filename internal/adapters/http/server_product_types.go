@@ -0,0 +1,114 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+	oapi "avito_pvz/internal/generated/oapi"
+)
+
+func (s *Server) GetProductTypes(
+	ctx context.Context,
+	request oapi.GetProductTypesRequestObject,
+) (oapi.GetProductTypesResponseObject, error) {
+	entries, err := s.productTypes.FindAll(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		valueOrZero(request.Params.IncludeInactive),
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetProductTypes403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetProductTypes400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := make(oapi.GetProductTypes200JSONResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, toProductTypeEntry(entry))
+	}
+
+	return response, nil
+}
+
+func (s *Server) PostProductTypes(
+	ctx context.Context,
+	request oapi.PostProductTypesRequestObject,
+) (oapi.PostProductTypesResponseObject, error) {
+	entry, err := s.productTypes.Create(ctx, s.GetCurrentUserFromCtx(ctx), domain.ProductTypeEntry{
+		Code:   domain.ProductType(request.Body.Code),
+		Names:  request.Body.Names,
+		Active: request.Body.Active,
+	})
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductTypes403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductTypeExists) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductTypes409JSONResponse{
+			Message: "Тип товара с таким кодом уже есть",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductTypes400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PostProductTypes201JSONResponse(toProductTypeEntry(entry)), nil
+}
+
+func (s *Server) PutProductTypesCode(
+	ctx context.Context,
+	request oapi.PutProductTypesCodeRequestObject,
+) (oapi.PutProductTypesCodeResponseObject, error) {
+	entry, err := s.productTypes.Update(ctx, s.GetCurrentUserFromCtx(ctx), domain.ProductTypeEntry{
+		Code:   domain.ProductType(request.Code),
+		Names:  request.Body.Names,
+		Active: request.Body.Active,
+	})
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutProductTypesCode403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductTypeNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutProductTypesCode404JSONResponse{
+			Message: "Тип товара не найден",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutProductTypesCode400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PutProductTypesCode200JSONResponse(toProductTypeEntry(entry)), nil
+}
+
+func toProductTypeEntry(entry domain.ProductTypeEntry) oapi.ProductTypeEntry {
+	return oapi.ProductTypeEntry{
+		Code:   string(entry.Code),
+		Names:  entry.Names,
+		Active: entry.Active,
+	}
+}
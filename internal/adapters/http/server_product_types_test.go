@@ -0,0 +1,320 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"avito_pvz/internal/adapters/http"
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	oapi "avito_pvz/internal/generated/oapi"
+	"avito_pvz/internal/infra/pointer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_GetProductTypes(t *testing.T) {
+	t.Parallel()
+
+	entries := []domain.ProductTypeEntry{
+		{Code: domain.Shoes, Names: map[string]string{"ru": "Обувь", "en": "Shoes"}, Active: true},
+		{Code: "мебель", Names: map[string]string{"ru": "Мебель"}},
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		params       oapi.GetProductTypesParams
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductTypesRepository)
+		check        func(*testing.T, oapi.GetProductTypesResponseObject, error)
+	}{
+		{
+			name:   "Success",
+			role:   domain.Employee,
+			params: oapi.GetProductTypesParams{IncludeInactive: pointer.Ref(true)},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().FindAll(mock.Anything, mock.Anything, true).Return(entries, nil)
+			},
+			check: func(t *testing.T, response oapi.GetProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetProductTypes200JSONResponse)
+				require.True(t, ok)
+				require.Len(t, res, 2)
+				assert.Equal(t, "обувь", res[0].Code)
+				assert.Equal(t, "Shoes", res[0].Names["en"])
+				assert.False(t, res[1].Active)
+			},
+		},
+		{
+			name: "Repository error",
+			role: domain.Moderator,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().FindAll(mock.Anything, mock.Anything, false).Return(nil, errors.New("some error"))
+			},
+			check: func(t *testing.T, response oapi.GetProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetProductTypes400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Unknown role",
+			role: domain.UserRole("guest"),
+			check: func(t *testing.T, response oapi.GetProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetProductTypes403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repo := mocks.NewMockProductTypesRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repo)
+			}
+
+			server := http.NewServer(nil, nil, nil, nil, domain.NewProductTypeService(provider, repo))
+
+			response, err := server.GetProductTypes(
+				fixtureAuthCtx(t, test.role),
+				oapi.GetProductTypesRequestObject{Params: test.params},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PostProductTypes(t *testing.T) {
+	t.Parallel()
+
+	body := &oapi.PostProductTypesJSONRequestBody{
+		Code:   " мебель ",
+		Names:  map[string]string{"ru": "Мебель", "en": "Furniture"},
+		Active: true,
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		body         *oapi.PostProductTypesJSONRequestBody
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductTypesRepository)
+		check        func(*testing.T, oapi.PostProductTypesResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Moderator,
+			body: body,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.MatchedBy(func(entry domain.ProductTypeEntry) bool {
+						return entry.Code == "мебель"
+					})).
+					Return(nil)
+			},
+			check: func(t *testing.T, response oapi.PostProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostProductTypes201JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, "мебель", res.Code)
+				assert.True(t, res.Active)
+			},
+		},
+		{
+			name: "Code already exists",
+			role: domain.Moderator,
+			body: body,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(domain.ErrProductTypeExists)
+			},
+			check: func(t *testing.T, response oapi.PostProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductTypes409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Invalid language",
+			role: domain.Moderator,
+			body: &oapi.PostProductTypesJSONRequestBody{Code: "мебель", Names: map[string]string{"rus": "Мебель"}},
+			check: func(t *testing.T, response oapi.PostProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductTypes400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Employee not authorized",
+			role: domain.Employee,
+			body: body,
+			check: func(t *testing.T, response oapi.PostProductTypesResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductTypes403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repo := mocks.NewMockProductTypesRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repo)
+			}
+
+			server := http.NewServer(nil, nil, nil, nil, domain.NewProductTypeService(provider, repo))
+
+			response, err := server.PostProductTypes(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostProductTypesRequestObject{Body: test.body},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PutProductTypesCode(t *testing.T) {
+	t.Parallel()
+
+	body := &oapi.PutProductTypesCodeJSONRequestBody{
+		Names:  map[string]string{"ru": "Обувь"},
+		Active: false,
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		body         *oapi.PutProductTypesCodeJSONRequestBody
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductTypesRepository)
+		check        func(*testing.T, oapi.PutProductTypesCodeResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Moderator,
+			body: body,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Update(mock.Anything, mock.Anything, domain.ProductTypeEntry{
+						Code:  domain.Shoes,
+						Names: map[string]string{"ru": "Обувь"},
+					}).
+					Return(nil)
+			},
+			check: func(t *testing.T, response oapi.PutProductTypesCodeResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PutProductTypesCode200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, "обувь", res.Code)
+				assert.False(t, res.Active)
+			},
+		},
+		{
+			name: "Type not found",
+			role: domain.Moderator,
+			body: body,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(domain.ErrProductTypeNotFound)
+			},
+			check: func(t *testing.T, response oapi.PutProductTypesCodeResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PutProductTypesCode404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "No names",
+			role: domain.Moderator,
+			body: &oapi.PutProductTypesCodeJSONRequestBody{Active: true},
+			check: func(t *testing.T, response oapi.PutProductTypesCodeResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PutProductTypesCode400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Employee not authorized",
+			role: domain.Employee,
+			body: body,
+			check: func(t *testing.T, response oapi.PutProductTypesCodeResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PutProductTypesCode403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repo := mocks.NewMockProductTypesRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repo)
+			}
+
+			server := http.NewServer(nil, nil, nil, nil, domain.NewProductTypeService(provider, repo))
+
+			response, err := server.PutProductTypesCode(
+				fixtureAuthCtx(t, test.role),
+				oapi.PutProductTypesCodeRequestObject{Code: string(domain.Shoes), Body: test.body},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+// fixtureProductTypes accepts every product type as an active catalogue entry.
+func fixtureProductTypes(t *testing.T) *mocks.MockProductTypesRepository {
+	t.Helper()
+
+	repo := mocks.NewMockProductTypesRepository(t)
+	repo.EXPECT().
+		FindByCodes(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ domain.Connection, codes []domain.ProductType) ([]domain.ProductTypeEntry, error) {
+			entries := make([]domain.ProductTypeEntry, 0, len(codes))
+			for _, code := range codes {
+				entries = append(entries, domain.ProductTypeEntry{Code: code, Active: true})
+			}
+
+			return entries, nil
+		}).
+		Maybe()
+
+	return repo
+}
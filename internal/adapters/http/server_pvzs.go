@@ -2,17 +2,32 @@ package http
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
 
 	"avito_pvz/internal/domain"
 	oapi "avito_pvz/internal/generated/oapi"
 	"avito_pvz/internal/infra/pointer"
+
+	"github.com/oapi-codegen/runtime/types"
 )
 
 func (s *Server) PostPvz(
 	ctx context.Context,
 	request oapi.PostPvzRequestObject,
 ) (oapi.PostPvzResponseObject, error) {
-	pvz, err := s.pvzs.Create(ctx, s.GetCurrentUserFromCtx(ctx), domain.PVZCity(request.Body.City))
+	pvz, err := s.pvzs.Create(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		domain.PVZCity(request.Body.City),
+		domain.PVZSchedule{
+			TimeZone: valueOrZero(request.Body.TimeZone),
+			OpensAt:  valueOrZero(request.Body.OpensAt),
+			ClosesAt: valueOrZero(request.Body.ClosesAt),
+		},
+	)
 
 	if err == domain.ErrNotAuthorized {
 		//nolint:nilerr // generated code expects error in response.
@@ -28,24 +43,36 @@ func (s *Server) PostPvz(
 		}, nil
 	}
 
-	return oapi.PostPvz201JSONResponse{
-		City:             oapi.PVZCity(pvz.City),
-		Id:               pointer.Ref(pvz.ID),
-		RegistrationDate: pointer.Ref(pvz.RegisteredAt),
-	}, nil
+	return oapi.PostPvz201JSONResponse(toPVZ(pvz)), nil
 }
 
 func (s *Server) GetPvz(
 	ctx context.Context,
 	request oapi.GetPvzRequestObject,
 ) (oapi.GetPvzResponseObject, error) {
-	all, err := s.pvzs.FindPVZReceptionProducts(
+	page, err := s.pvzs.FindPVZReceptionProducts(
 		ctx,
 		s.GetCurrentUserFromCtx(ctx),
-		request.Params.StartDate,
-		request.Params.EndDate,
+		domain.PVZFilter{
+			Period: domain.Period{
+				From:      request.Params.StartDate,
+				To:        request.Params.EndDate,
+				LocalFrom: dateOrNil(request.Params.StartLocalDate),
+				LocalTo:   dateOrNil(request.Params.EndLocalDate),
+			},
+			Cities:             convertEnums[domain.PVZCity](request.Params.City),
+			Status:             convertEnum[domain.PVZStatus](request.Params.Status),
+			ProductTypes:       convertEnums[domain.ProductType](request.Params.ProductType),
+			ProductStatuses:    convertEnums[domain.ProductStatus](request.Params.ProductStatus),
+			ReceptionStatus:    convertEnum[domain.ReceptionStatus](request.Params.ReceptionStatus),
+			ReceptionCreatedBy: request.Params.ReceptionCreatedBy,
+			HasActiveReception: request.Params.HasActiveReception,
+			Sort:               domain.PVZSort(valueOrZero(request.Params.Sort)),
+			Descending:         valueOrZero(request.Params.Order) == oapi.GetPvzParamsOrderDesc,
+		},
 		request.Params.Page,
 		request.Params.Limit,
+		request.Params.Cursor,
 	)
 	if err == domain.ErrNotAuthorized {
 		//nolint:nilerr // generated code expects error in response.
@@ -61,6 +88,13 @@ func (s *Server) GetPvz(
 		}, nil
 	}
 
+	if acceptsMediaType(ctx, mediaTypePVZPageV2) {
+		return oapi.GetPvz200ApplicationVndAvitoPvzV2PlusJSONResponse{
+			Body:    toPVZPage(page, request.Params),
+			Headers: oapi.GetPvz200ResponseHeaders{XNextCursor: valueOrZero(page.NextCursor)},
+		}, nil
+	}
+
 	type RespReception = struct {
 		Products  *[]oapi.Product `json:"products,omitempty"`
 		Reception *oapi.Reception `json:"reception,omitempty"`
@@ -71,32 +105,20 @@ func (s *Server) GetPvz(
 		Receptions *[]RespReception `json:"receptions,omitempty"`
 	}
 
-	var response oapi.GetPvz200JSONResponse
+	response := oapi.GetPvz200JSONResponse{
+		Headers: oapi.GetPvz200ResponseHeaders{XNextCursor: valueOrZero(page.NextCursor)},
+	}
 
-	for _, pvzData := range all {
-		pvz := &oapi.PVZ{
-			Id:               pointer.Ref(pvzData.PVZ.ID),
-			City:             oapi.PVZCity(pvzData.PVZ.City),
-			RegistrationDate: pointer.Ref(pvzData.PVZ.RegisteredAt),
-		}
+	for _, pvzData := range page.Items {
+		pvz := pointer.Ref(toPVZ(pvzData.PVZ))
 
 		var receptions []RespReception
 		for _, receptionData := range pvzData.Receptions {
-			reception := &oapi.Reception{
-				DateTime: receptionData.Reception.CreatedAt,
-				Id:       pointer.Ref(receptionData.Reception.ID),
-				PvzId:    receptionData.Reception.PVZID,
-				Status:   oapi.ReceptionStatus(receptionData.Reception.Status),
-			}
+			reception := pointer.Ref(toReception(receptionData.Reception))
 
 			var products []oapi.Product
 			for _, productData := range receptionData.Products {
-				products = append(products, oapi.Product{
-					DateTime:    pointer.Ref(productData.CreatedAt),
-					Id:          pointer.Ref(productData.ID),
-					ReceptionId: productData.ReceptionID,
-					Type:        oapi.ProductType(productData.Type),
-				})
+				products = append(products, toProduct(productData))
 			}
 
 			receptions = append(receptions, RespReception{
@@ -105,7 +127,7 @@ func (s *Server) GetPvz(
 			})
 		}
 
-		response = append(response, RespItem{
+		response.Body = append(response.Body, RespItem{
 			Pvz:        pvz,
 			Receptions: pointer.Ref(receptions),
 		})
@@ -113,3 +135,359 @@ func (s *Server) GetPvz(
 
 	return response, nil
 }
+
+//nolint:revive,staticcheck // Method name must comply with generated code naming requirements.
+func (s *Server) GetPvzPvzId(
+	ctx context.Context,
+	request oapi.GetPvzPvzIdRequestObject,
+) (oapi.GetPvzPvzIdResponseObject, error) {
+	details, err := s.pvzs.FindDetails(ctx, s.GetCurrentUserFromCtx(ctx), request.PvzId)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzId403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrPVZNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzId404JSONResponse{
+			Message: "ПВЗ не найден",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzId400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := oapi.GetPvzPvzId200JSONResponse{
+		Pvz: toPVZ(details.PVZ),
+	}
+	response.Summary.ReceptionsTotal = details.ReceptionsTotal
+	response.Summary.ReceptionsClosed = details.ReceptionsClosed
+	response.Summary.ProductsByType = make(map[string]int, len(details.ProductsByType))
+	for productType, count := range details.ProductsByType {
+		response.Summary.ProductsByType[string(productType)] = count
+	}
+
+	if details.ActiveReception != nil {
+		response.ActiveReception = pointer.Ref(toReceptionProducts(*details.ActiveReception))
+	}
+
+	return response, nil
+}
+
+func toPVZ(pvz domain.PVZ) oapi.PVZ {
+	return oapi.PVZ{
+		Id:               pointer.Ref(pvz.ID),
+		City:             oapi.PVZCity(pvz.City),
+		RegistrationDate: pointer.Ref(pvz.RegisteredAt),
+		TimeZone:         pointer.Ref(pvz.TimeZone),
+		OpensAt:          pointer.Ref(pvz.OpensAt),
+		ClosesAt:         pointer.Ref(pvz.ClosesAt),
+	}
+}
+
+func toReception(reception domain.Reception) oapi.Reception {
+	return oapi.Reception{
+		DateTime:  reception.CreatedAt,
+		Id:        pointer.Ref(reception.ID),
+		PvzId:     reception.PVZID,
+		Status:    oapi.ReceptionStatus(reception.Status),
+		CreatedBy: reception.CreatedBy,
+		ClosedBy:  reception.ClosedBy,
+	}
+}
+
+func toClosedReception(closed domain.ClosedReception) oapi.ClosedReception {
+	reception := toReception(closed.Reception)
+	response := oapi.ClosedReception{
+		DateTime:  reception.DateTime,
+		Id:        reception.Id,
+		PvzId:     reception.PvzId,
+		Status:    oapi.ClosedReceptionStatus(reception.Status),
+		CreatedBy: reception.CreatedBy,
+		ClosedBy:  reception.ClosedBy,
+		Summary: oapi.ProductSummary{
+			Products:      closed.Summary.Products,
+			Accepted:      closed.Summary.Inspections[domain.InspectionAccepted],
+			Damaged:       closed.Summary.Inspections[domain.InspectionDamaged],
+			Rejected:      closed.Summary.Inspections[domain.InspectionRejected],
+			Uninspected:   closed.Summary.Uninspected,
+			Weight:        closed.Summary.WeightGrams,
+			Volume:        closed.Summary.VolumeCM3,
+			DeclaredValue: closed.Summary.DeclaredValue,
+		},
+	}
+	if closed.Discrepancy != nil {
+		response.Discrepancy = &oapi.DiscrepancyReport{
+			Missing: toManifestItems(closed.Discrepancy.Missing),
+			Extra:   toManifestItems(closed.Discrepancy.Extra),
+		}
+	}
+
+	return response
+}
+
+func toManifestItems(items []domain.ManifestItem) []oapi.ManifestItem {
+	converted := make([]oapi.ManifestItem, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, oapi.ManifestItem{Type: string(item.Type), Count: item.Count})
+	}
+
+	return converted
+}
+
+func toManifest(items []oapi.ManifestItem) domain.ReceptionManifest {
+	manifest := make(domain.ReceptionManifest, 0, len(items))
+	for _, item := range items {
+		manifest = append(manifest, domain.ManifestItem{Type: domain.ProductType(item.Type), Count: item.Count})
+	}
+
+	return manifest
+}
+
+func toProduct(product domain.Product) oapi.Product {
+	return oapi.Product{
+		DateTime:      pointer.Ref(product.CreatedAt),
+		Id:            pointer.Ref(product.ID),
+		ReceptionId:   product.ReceptionID,
+		Type:          string(product.Type),
+		CreatedBy:     product.CreatedBy,
+		Barcode:       product.Barcode,
+		Status:        oapi.ProductStatus(product.Status),
+		Weight:        product.WeightGrams,
+		Dimensions:    toDimensions(product.ProductAttributes),
+		DeclaredValue: product.DeclaredValue,
+		Inspection:    toInspection(product.ProductAttributes),
+	}
+}
+
+func toDimensions(attributes domain.ProductAttributes) *oapi.Dimensions {
+	if attributes.LengthCM == nil || attributes.WidthCM == nil || attributes.HeightCM == nil {
+		return nil
+	}
+
+	return &oapi.Dimensions{
+		Length: *attributes.LengthCM,
+		Width:  *attributes.WidthCM,
+		Height: *attributes.HeightCM,
+	}
+}
+
+func toInspection(attributes domain.ProductAttributes) *oapi.Inspection {
+	if attributes.InspectionStatus == nil {
+		return nil
+	}
+
+	return &oapi.Inspection{
+		Status: oapi.InspectionStatus(*attributes.InspectionStatus),
+		Reason: attributes.InspectionReason,
+	}
+}
+
+// toProductAttributes converts the optional attributes of a product in a
+// request.
+func toProductAttributes(
+	weight *int,
+	dimensions *oapi.Dimensions,
+	declaredValue *int64,
+	inspection *oapi.Inspection,
+) domain.ProductAttributes {
+	attributes := domain.ProductAttributes{
+		WeightGrams:   weight,
+		DeclaredValue: declaredValue,
+	}
+	if dimensions != nil {
+		attributes.LengthCM = &dimensions.Length
+		attributes.WidthCM = &dimensions.Width
+		attributes.HeightCM = &dimensions.Height
+	}
+	if inspection != nil {
+		attributes.InspectionStatus = pointer.Ref(domain.InspectionStatus(inspection.Status))
+		attributes.InspectionReason = inspection.Reason
+	}
+
+	return attributes
+}
+
+func toAddedProduct(added domain.AddedProduct) oapi.AddedProduct {
+	product := toProduct(added.Product)
+	duplicates := make([]oapi.Product, 0, len(added.Duplicates))
+	for _, duplicate := range added.Duplicates {
+		duplicates = append(duplicates, toProduct(duplicate))
+	}
+
+	return oapi.AddedProduct{
+		DateTime:      product.DateTime,
+		Id:            product.Id,
+		ReceptionId:   product.ReceptionId,
+		Type:          product.Type,
+		CreatedBy:     product.CreatedBy,
+		Barcode:       product.Barcode,
+		Status:        product.Status,
+		Weight:        product.Weight,
+		Dimensions:    product.Dimensions,
+		DeclaredValue: product.DeclaredValue,
+		Inspection:    product.Inspection,
+		Duplicates:    duplicates,
+		Cell:          toStorageCellRef(added.Cell),
+	}
+}
+
+func toProductLocation(location domain.ProductLocation) oapi.ProductLocation {
+	return oapi.ProductLocation{
+		Pvz:       toPVZ(location.PVZ),
+		Reception: toReception(location.Reception),
+		Product:   toProduct(location.Product),
+	}
+}
+
+func toReceptionProducts(reception domain.ReceptionsProducts) oapi.ReceptionProducts {
+	products := make([]oapi.Product, 0, len(reception.Products))
+	for _, product := range reception.Products {
+		products = append(products, toProduct(product))
+	}
+
+	return oapi.ReceptionProducts{
+		Reception: toReception(reception.Reception),
+		Products:  products,
+	}
+}
+
+func toPVZReceptions(item domain.PVZReceptionsProducts) oapi.PVZReceptions {
+	receptions := make([]oapi.ReceptionProducts, 0, len(item.Receptions))
+	for _, reception := range item.Receptions {
+		receptions = append(receptions, toReceptionProducts(reception))
+	}
+
+	return oapi.PVZReceptions{
+		Pvz:        toPVZ(item.PVZ),
+		Receptions: receptions,
+	}
+}
+
+func toPVZPage(page domain.PVZPage, params oapi.GetPvzParams) oapi.PVZPage {
+	response := oapi.PVZPage{
+		Items:   make([]oapi.PVZReceptions, 0, len(page.Items)),
+		Total:   page.Total,
+		Limit:   page.Limit,
+		Filters: toPVZFilters(page.Filter),
+	}
+	if page.Page != 0 {
+		response.Page = pointer.Ref(page.Page)
+	}
+
+	for _, item := range page.Items {
+		response.Items = append(response.Items, toPVZReceptions(item))
+	}
+
+	response.Links.Next = nextPVZPageLink(page, params)
+
+	return response
+}
+
+func toPVZFilters(filter domain.PVZFilter) oapi.PVZFilters {
+	filters := oapi.PVZFilters{
+		StartDate:          filter.From,
+		EndDate:            filter.To,
+		Status:             (*string)(filter.Status),
+		ReceptionStatus:    (*string)(filter.ReceptionStatus),
+		ReceptionCreatedBy: filter.ReceptionCreatedBy,
+		HasActiveReception: filter.HasActiveReception,
+		Sort:               string(filter.Sort),
+		Order:              string(oapi.GetPvzParamsOrderAsc),
+	}
+	if filter.Descending {
+		filters.Order = string(oapi.GetPvzParamsOrderDesc)
+	}
+	if filter.LocalFrom != nil {
+		filters.StartLocalDate = &types.Date{Time: *filter.LocalFrom}
+	}
+	if filter.LocalTo != nil {
+		filters.EndLocalDate = &types.Date{Time: *filter.LocalTo}
+	}
+	if 0 < len(filter.Cities) {
+		filters.City = pointer.Ref(convertEnums[string](&filter.Cities))
+	}
+	if 0 < len(filter.ProductTypes) {
+		filters.ProductType = pointer.Ref(convertEnums[string](&filter.ProductTypes))
+	}
+	if 0 < len(filter.ProductStatuses) {
+		filters.ProductStatus = pointer.Ref(convertEnums[string](&filter.ProductStatuses))
+	}
+
+	return filters
+}
+
+// nextPVZPageLink keeps paging the way the request did: by page number when
+// it was given or the sort does not allow a cursor, by cursor otherwise.
+func nextPVZPageLink(page domain.PVZPage, params oapi.GetPvzParams) *string {
+	query := pvzListQuery(params)
+
+	if params.Page != nil || page.Filter.Sort != domain.SortByRegisteredAt {
+		if page.Total <= page.Page*page.Limit {
+			return nil
+		}
+		query.Set("page", strconv.Itoa(page.Page+1))
+	} else {
+		if page.NextCursor == nil {
+			return nil
+		}
+		query.Set("cursor", *page.NextCursor)
+	}
+
+	return pointer.Ref("/pvz?" + query.Encode())
+}
+
+// pvzListQuery encodes the filters, sorting and limit of the request.
+func pvzListQuery(params oapi.GetPvzParams) url.Values {
+	query := url.Values{}
+	if params.StartDate != nil {
+		query.Set("startDate", params.StartDate.Format(time.RFC3339Nano))
+	}
+	if params.EndDate != nil {
+		query.Set("endDate", params.EndDate.Format(time.RFC3339Nano))
+	}
+	if params.StartLocalDate != nil {
+		query.Set("startLocalDate", params.StartLocalDate.String())
+	}
+	if params.EndLocalDate != nil {
+		query.Set("endLocalDate", params.EndLocalDate.String())
+	}
+	if params.Limit != nil {
+		query.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	for _, city := range valueOrZero(params.City) {
+		query.Add("city", city)
+	}
+	if params.Status != nil {
+		query.Set("status", string(*params.Status))
+	}
+	for _, productType := range valueOrZero(params.ProductType) {
+		query.Add("productType", productType)
+	}
+	for _, productStatus := range valueOrZero(params.ProductStatus) {
+		query.Add("productStatus", string(productStatus))
+	}
+	if params.ReceptionStatus != nil {
+		query.Set("receptionStatus", string(*params.ReceptionStatus))
+	}
+	if params.ReceptionCreatedBy != nil {
+		query.Set("receptionCreatedBy", params.ReceptionCreatedBy.String())
+	}
+	if params.HasActiveReception != nil {
+		query.Set("hasActiveReception", strconv.FormatBool(*params.HasActiveReception))
+	}
+	if params.Sort != nil {
+		query.Set("sort", string(*params.Sort))
+	}
+	if params.Order != nil {
+		query.Set("order", string(*params.Order))
+	}
+
+	return query
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"avito_pvz/internal/adapters/http"
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/generated/mocks"
 	oapi "avito_pvz/internal/generated/oapi"
+	"avito_pvz/internal/infra/pointer"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -18,7 +21,7 @@ import (
 func TestServer_PostPvz(t *testing.T) {
 	t.Parallel()
 
-	testCity := oapi.Москва
+	testCity := oapi.PVZCityМосква
 
 	tests := []struct {
 		name         string
@@ -94,10 +97,294 @@ func TestServer_PostPvz(t *testing.T) {
 				domain.NewPVZService(conn, pvzRepo, productRepo, receptionRepo, metrics),
 				nil,
 				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostPvz(fixtureAuthCtx(t, domain.Moderator), test.request)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_GetPvz(t *testing.T) {
+	t.Parallel()
+
+	pvz := domain.PVZ{ID: uuid.New(), City: domain.Msk, RegisteredAt: time.Now()}
+	employeeID := uuid.New()
+
+	tests := []struct {
+		name         string
+		params       oapi.GetPvzParams
+		accept       string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, oapi.GetPvzResponseObject, error)
+	}{
+		{
+			name:   "Full page has next cursor",
+			params: oapi.GetPvzParams{Limit: pointer.Ref(1)},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt},
+						domain.DefaultPage,
+						1,
+						(*domain.Cursor)(nil),
+					).
+					Return([]domain.PVZReceptionsProducts{{PVZ: pvz}}, nil)
+				repo.EXPECT().
+					Count(mock.Anything, mock.Anything, domain.PVZFilter{Sort: domain.SortByRegisteredAt}).
+					Return(3, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvz200JSONResponse)
+				require.True(t, ok)
+				require.Len(t, res.Body, 1)
+				assert.Equal(t, pvz.ID, *res.Body[0].Pvz.Id)
+
+				cursor, err := domain.DecodeCursor(res.Headers.XNextCursor)
+				require.NoError(t, err)
+				assert.Equal(t, pvz.ID, cursor.ID)
+			},
+		},
+		{
+			name:   "Paged envelope with cursor link",
+			params: oapi.GetPvzParams{Limit: pointer.Ref(1)},
+			accept: "application/vnd.avito-pvz.v2+json",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt},
+						domain.DefaultPage,
+						1,
+						(*domain.Cursor)(nil),
+					).
+					Return([]domain.PVZReceptionsProducts{{PVZ: pvz}}, nil)
+				repo.EXPECT().
+					Count(mock.Anything, mock.Anything, domain.PVZFilter{Sort: domain.SortByRegisteredAt}).
+					Return(3, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvz200ApplicationVndAvitoPvzV2PlusJSONResponse)
+				require.True(t, ok)
+				require.Len(t, res.Body.Items, 1)
+				assert.Equal(t, 3, res.Body.Total)
+				assert.Equal(t, 1, res.Body.Limit)
+				require.NotNil(t, res.Body.Links.Next)
+				assert.Contains(t, *res.Body.Links.Next, "cursor=")
+				assert.Equal(t, "registeredAt", string(res.Body.Filters.Sort))
+			},
+		},
+		{
+			name:   "Paged envelope with page link",
+			params: oapi.GetPvzParams{Page: pointer.Ref(1), Limit: pointer.Ref(1)},
+			accept: "application/vnd.avito-pvz.v2+json",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt},
+						1,
+						1,
+						(*domain.Cursor)(nil),
+					).
+					Return([]domain.PVZReceptionsProducts{{PVZ: pvz}}, nil)
+				repo.EXPECT().
+					Count(mock.Anything, mock.Anything, domain.PVZFilter{Sort: domain.SortByRegisteredAt}).
+					Return(3, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvz200ApplicationVndAvitoPvzV2PlusJSONResponse)
+				require.True(t, ok)
+				require.NotNil(t, res.Body.Page)
+				assert.Equal(t, 1, *res.Body.Page)
+				require.NotNil(t, res.Body.Links.Next)
+				assert.Contains(t, *res.Body.Links.Next, "page=2")
+			},
+		},
+		{
+			name:   "Filter by reception author",
+			params: oapi.GetPvzParams{Page: pointer.Ref(1), Limit: pointer.Ref(1), ReceptionCreatedBy: &employeeID},
+			accept: "application/vnd.avito-pvz.v2+json",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				filter := domain.PVZFilter{Sort: domain.SortByRegisteredAt, ReceptionCreatedBy: &employeeID}
+				repo.EXPECT().
+					SearchReceptionsProducts(mock.Anything, mock.Anything, filter, 1, 1, (*domain.Cursor)(nil)).
+					Return([]domain.PVZReceptionsProducts{{PVZ: pvz}}, nil)
+				repo.EXPECT().Count(mock.Anything, mock.Anything, filter).Return(2, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvz200ApplicationVndAvitoPvzV2PlusJSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, &employeeID, res.Body.Filters.ReceptionCreatedBy)
+				require.NotNil(t, res.Body.Links.Next)
+				assert.Contains(t, *res.Body.Links.Next, "receptionCreatedBy="+employeeID.String())
+			},
+		},
+		{
+			name:   "Invalid cursor",
+			params: oapi.GetPvzParams{Cursor: pointer.Ref("not a cursor")},
+			check: func(t *testing.T, response oapi.GetPvzResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetPvz400JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := mocks.NewMockConnectionProvider(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(conn, pvzRepo)
+			}
+
+			server := http.NewServer(
+				domain.NewPVZService(conn, pvzRepo, productRepo, receptionRepo, metrics),
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
-			response, err := server.PostPvz(t.Context(), test.request)
+			ctx := fixtureAuthCtx(t, domain.Employee)
+			if test.accept != "" {
+				ctx = context.WithValue(ctx, http.CtxAcceptKey, test.accept) //nolint:staticcheck
+			}
+
+			response, err := server.GetPvz(ctx, oapi.GetPvzRequestObject{Params: test.params})
 			test.check(t, response, err)
 		})
 	}
 }
+
+func TestServer_GetPvzPvzId(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, oapi.GetPvzPvzIdResponseObject, error)
+	}{
+		{
+			name: "Success",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindDetails(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZDetails{
+						PVZ:             domain.PVZ{ID: pvzID, City: domain.Msk},
+						ReceptionsTotal: 1,
+						ProductsByType:  map[domain.ProductType]int{domain.Clothes: 4},
+					}, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvzPvzId200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, pvzID, *res.Pvz.Id)
+				assert.Nil(t, res.ActiveReception)
+				assert.Equal(t, 1, res.Summary.ReceptionsTotal)
+				assert.Equal(t, 4, res.Summary.ProductsByType[string(domain.Clothes)])
+			},
+		},
+		{
+			name: "NotFound",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindDetails(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZDetails{}, errors.Join(errors.New("some error"), domain.ErrPVZNotFound))
+			},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetPvzPvzId404JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := mocks.NewMockConnectionProvider(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(conn, pvzRepo)
+			}
+
+			server := http.NewServer(
+				domain.NewPVZService(conn, pvzRepo, productRepo, receptionRepo, metrics),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.GetPvzPvzId(
+				fixtureAuthCtx(t, domain.Employee),
+				oapi.GetPvzPvzIdRequestObject{PvzId: pvzID},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func fixtureAuthCtx(t *testing.T, role domain.UserRole) context.Context {
+	t.Helper()
+
+	authUser, err := domain.AuthenticateByToken(uuid.NewString() + ":" + string(role))
+	require.NoError(t, err)
+
+	//nolint:staticcheck // the key type is shared with the auth middleware.
+	return context.WithValue(t.Context(), domain.CtxCurUserKey, authUser)
+}
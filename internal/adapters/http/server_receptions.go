@@ -2,10 +2,10 @@ package http
 
 import (
 	"context"
+	"errors"
 
 	"avito_pvz/internal/domain"
 	oapi "avito_pvz/internal/generated/oapi"
-	"avito_pvz/internal/infra/pointer"
 )
 
 //nolint:revive,staticcheck // Method name must comply with generated code naming requirements.
@@ -21,6 +21,13 @@ func (s *Server) PostPvzPvzIdCloseLastReception(
 		}, nil
 	}
 
+	if errors.Is(err, domain.ErrReceptionNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdCloseLastReception409JSONResponse{
+			Message: "Приемка уже закрыта другим запросом",
+		}, nil
+	}
+
 	if err != nil {
 		//nolint:nilerr // generated code expects error in response.
 		return oapi.PostPvzPvzIdCloseLastReception400JSONResponse{
@@ -28,12 +35,7 @@ func (s *Server) PostPvzPvzIdCloseLastReception(
 		}, nil
 	}
 
-	return oapi.PostPvzPvzIdCloseLastReception200JSONResponse{
-		Id:       pointer.Ref(reception.ID),
-		PvzId:    reception.PVZID,
-		Status:   oapi.ReceptionStatus(reception.Status),
-		DateTime: reception.CreatedAt,
-	}, nil
+	return oapi.PostPvzPvzIdCloseLastReception200JSONResponse(toClosedReception(reception)), nil
 }
 
 //nolint:revive,staticcheck // Method name must comply with generated code naming requirements.
@@ -49,6 +51,20 @@ func (s *Server) PostPvzPvzIdDeleteLastProduct(
 		}, nil
 	}
 
+	if errors.Is(err, domain.ErrProductInOrder) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdDeleteLastProduct409JSONResponse{
+			Message: "Последний товар в заказе",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInvalidTransition) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostPvzPvzIdDeleteLastProduct409JSONResponse{
+			Message: "Последний товар уже хранится, выдан или возвращен",
+		}, nil
+	}
+
 	if err != nil {
 		//nolint:nilerr // generated code expects error in response.
 		return oapi.PostPvzPvzIdDeleteLastProduct400JSONResponse{
@@ -59,6 +75,33 @@ func (s *Server) PostPvzPvzIdDeleteLastProduct(
 	return oapi.PostPvzPvzIdDeleteLastProduct200Response{}, nil
 }
 
+func (s *Server) GetProducts(
+	ctx context.Context,
+	request oapi.GetProductsRequestObject,
+) (oapi.GetProductsResponseObject, error) {
+	locations, err := s.receptions.FindProductsByBarcode(ctx, s.GetCurrentUserFromCtx(ctx), request.Params.Barcode)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetProducts403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetProducts400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := make(oapi.GetProducts200JSONResponse, 0, len(locations))
+	for _, location := range locations {
+		response = append(response, toProductLocation(location))
+	}
+
+	return response, nil
+}
+
 func (s *Server) PostProducts(
 	ctx context.Context,
 	request oapi.PostProductsRequestObject,
@@ -67,7 +110,16 @@ func (s *Server) PostProducts(
 		ctx,
 		s.GetCurrentUserFromCtx(ctx),
 		request.Body.PvzId,
-		domain.ProductType(request.Body.Type),
+		domain.ProductDraft{
+			Type:    domain.ProductType(request.Body.Type),
+			Barcode: request.Body.Barcode,
+			ProductAttributes: toProductAttributes(
+				request.Body.Weight,
+				request.Body.Dimensions,
+				request.Body.DeclaredValue,
+				request.Body.Inspection,
+			),
+		},
 	)
 	if err == domain.ErrNotAuthorized {
 		//nolint:nilerr // generated code expects error in response.
@@ -76,6 +128,13 @@ func (s *Server) PostProducts(
 		}, nil
 	}
 
+	if errors.Is(err, domain.ErrProductDuplicateBarcode) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProducts409JSONResponse{
+			Message: "Товар с таким штрихкодом уже есть в приемке",
+		}, nil
+	}
+
 	if err != nil {
 		//nolint:nilerr // generated code expects error in response.
 		return oapi.PostProducts400JSONResponse{
@@ -83,19 +142,71 @@ func (s *Server) PostProducts(
 		}, nil
 	}
 
-	return oapi.PostProducts201JSONResponse{
-		DateTime:    pointer.Ref(product.CreatedAt),
-		Id:          pointer.Ref(product.ID),
-		ReceptionId: product.ReceptionID,
-		Type:        oapi.ProductType(product.Type),
-	}, nil
+	return oapi.PostProducts201JSONResponse(toAddedProduct(product)), nil
+}
+
+func (s *Server) PostProductsBatch(
+	ctx context.Context,
+	request oapi.PostProductsBatchRequestObject,
+) (oapi.PostProductsBatchResponseObject, error) {
+	drafts := make([]domain.ProductDraft, 0, len(request.Body.Products))
+	for _, product := range request.Body.Products {
+		drafts = append(drafts, domain.ProductDraft{
+			Type:    domain.ProductType(product.Type),
+			Barcode: product.Barcode,
+			ProductAttributes: toProductAttributes(
+				product.Weight,
+				product.Dimensions,
+				product.DeclaredValue,
+				product.Inspection,
+			),
+		})
+	}
+
+	products, err := s.receptions.CreateProducts(ctx, s.GetCurrentUserFromCtx(ctx), request.Body.PvzId, drafts)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsBatch403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	// Barcodes repeated within the request are a bad request, not a conflict
+	// with the reception.
+	if errors.Is(err, domain.ErrProductDuplicateBarcode) &&
+		!errors.Is(err, domain.ErrAvitoServiceCreateProductsInvalidBatch) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsBatch409JSONResponse{
+			Message: "Товар с таким штрихкодом уже есть в приемке",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsBatch400JSONResponse{
+			Message: "Неверный запрос или нет активной приемки",
+		}, nil
+	}
+
+	created := make([]oapi.AddedProduct, 0, len(products))
+	for _, product := range products {
+		created = append(created, toAddedProduct(product))
+	}
+
+	return oapi.PostProductsBatch201JSONResponse{Products: created}, nil
 }
 
 func (s *Server) PostReceptions(
 	ctx context.Context,
 	request oapi.PostReceptionsRequestObject,
 ) (oapi.PostReceptionsResponseObject, error) {
-	reception, err := s.receptions.Create(ctx, s.GetCurrentUserFromCtx(ctx), request.Body.PvzId)
+	reception, err := s.receptions.Create(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		request.Body.PvzId,
+		valueOrZero(request.Body.Override),
+		toManifest(valueOrZero(request.Body.Manifest)),
+	)
 
 	if err == domain.ErrNotAuthorized {
 		//nolint:nilerr // generated code expects error in response.
@@ -104,16 +215,286 @@ func (s *Server) PostReceptions(
 		}, nil
 	}
 
+	if errors.Is(err, domain.ErrReceptionInProgress) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptions409JSONResponse{
+			Message: "Есть незакрытая приемка",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrAvitoServiceCreateReceptionOutsideWorkingHours) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptions400JSONResponse{
+			Message: "ПВЗ сейчас не работает",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrAvitoServiceCreateReceptionInvalidManifest) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptions400JSONResponse{
+			Message: "Неверный ожидаемый состав поставки",
+		}, nil
+	}
+
 	if err != nil {
 		//nolint:nilerr // generated code expects error in response.
 		return oapi.PostReceptions400JSONResponse{
-			Message: "Неверный запрос или есть незакрытая приемка",
+			Message: "Неверный запрос",
+		}, nil
+	}
+	return oapi.PostReceptions201JSONResponse(toReception(reception)), nil
+}
+
+//nolint:revive,staticcheck // Method name must comply with generated code naming requirements.
+func (s *Server) GetPvzPvzIdReceptions(
+	ctx context.Context,
+	request oapi.GetPvzPvzIdReceptionsRequestObject,
+) (oapi.GetPvzPvzIdReceptionsResponseObject, error) {
+	page, err := s.receptions.FindByPVZ(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		request.PvzId,
+		domain.ReceptionFilter{
+			Period: domain.Period{
+				From:      request.Params.StartDate,
+				To:        request.Params.EndDate,
+				LocalFrom: dateOrNil(request.Params.StartLocalDate),
+				LocalTo:   dateOrNil(request.Params.EndLocalDate),
+			},
+			Status:    convertEnum[domain.ReceptionStatus](request.Params.Status),
+			CreatedBy: request.Params.CreatedBy,
+			ClosedBy:  request.Params.ClosedBy,
+		},
+		request.Params.Page,
+		request.Params.Limit,
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzIdReceptions403JSONResponse{
+			Message: "Доступ запрещен",
 		}, nil
 	}
-	return oapi.PostReceptions201JSONResponse{
-		DateTime: reception.CreatedAt,
-		Id:       pointer.Ref(reception.ID),
-		PvzId:    reception.PVZID,
-		Status:   oapi.ReceptionStatus(reception.Status),
+
+	if errors.Is(err, domain.ErrPVZNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzIdReceptions404JSONResponse{
+			Message: "ПВЗ не найден",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzIdReceptions400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	response := oapi.GetPvzPvzIdReceptions200JSONResponse{
+		Items: make([]oapi.ReceptionSummary, 0, len(page.Items)),
+		Total: page.Total,
+		Page:  page.Page,
+		Limit: page.Limit,
+	}
+	for _, item := range page.Items {
+		response.Items = append(response.Items, oapi.ReceptionSummary{
+			Reception:     toReception(item.Reception),
+			ProductsCount: item.Products,
+		})
+	}
+
+	return response, nil
+}
+
+//nolint:revive,staticcheck // Method name must comply with generated code naming requirements.
+func (s *Server) GetReceptionsReceptionId(
+	ctx context.Context,
+	request oapi.GetReceptionsReceptionIdRequestObject,
+) (oapi.GetReceptionsReceptionIdResponseObject, error) {
+	details, err := s.receptions.FindDetails(ctx, s.GetCurrentUserFromCtx(ctx), request.ReceptionId)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetReceptionsReceptionId403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrReceptionNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetReceptionsReceptionId404JSONResponse{
+			Message: "Приемка не найдена",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetReceptionsReceptionId400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	products := make([]oapi.Product, 0, len(details.Products))
+	for _, product := range details.Products {
+		products = append(products, toProduct(product))
+	}
+	deleted := make([]oapi.DeletedProduct, 0, len(details.DeletedProducts))
+	for _, product := range details.DeletedProducts {
+		deleted = append(deleted, oapi.DeletedProduct{
+			Product:   toProduct(product.Product),
+			DeletedAt: product.DeletedAt,
+			DeletedBy: product.DeletedBy,
+		})
+	}
+	reopenings := make([]oapi.ReceptionReopening, 0, len(details.Timeline.Reopenings))
+	for _, reopening := range details.Timeline.Reopenings {
+		reopenings = append(reopenings, oapi.ReceptionReopening{
+			Id:         reopening.ID,
+			ReopenedAt: reopening.ReopenedAt,
+			ReopenedBy: reopening.ReopenedBy,
+			Reason:     reopening.Reason,
+			ClosedAt:   reopening.ClosedAt,
+			ClosedBy:   reopening.ClosedBy,
+		})
+	}
+
+	return oapi.GetReceptionsReceptionId200JSONResponse{
+		Reception: toReception(details.Reception),
+		Pvz:       toPVZ(details.PVZ),
+		Timeline: oapi.ReceptionTimeline{
+			OpenedAt:   details.Timeline.OpenedAt,
+			OpenedBy:   details.Timeline.OpenedBy,
+			ClosedAt:   details.Timeline.ClosedAt,
+			ClosedBy:   details.Timeline.ClosedBy,
+			Reopenings: reopenings,
+		},
+		Products:        products,
+		DeletedProducts: deleted,
 	}, nil
 }
+
+//nolint:revive,staticcheck // Method name must comply with generated code naming requirements.
+func (s *Server) PostReceptionsReceptionIdReopen(
+	ctx context.Context,
+	request oapi.PostReceptionsReceptionIdReopenRequestObject,
+) (oapi.PostReceptionsReceptionIdReopenResponseObject, error) {
+	reception, err := s.receptions.Reopen(
+		ctx,
+		s.GetCurrentUserFromCtx(ctx),
+		request.ReceptionId,
+		request.Body.Reason,
+	)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptionsReceptionIdReopen403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrReceptionNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptionsReceptionIdReopen404JSONResponse{
+			Message: "Приемка не найдена",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrReceptionNotReopenable) || errors.Is(err, domain.ErrReceptionInProgress) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptionsReceptionIdReopen409JSONResponse{
+			Message: "Приемка не закрыта или у ПВЗ есть более новая приемка",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostReceptionsReceptionIdReopen400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PostReceptionsReceptionIdReopen200JSONResponse(toReception(reception)), nil
+}
+
+func (s *Server) PostProductsProductIdIssue(
+	ctx context.Context,
+	request oapi.PostProductsProductIdIssueRequestObject,
+) (oapi.PostProductsProductIdIssueResponseObject, error) {
+	product, err := s.receptions.IssueProduct(ctx, s.GetCurrentUserFromCtx(ctx), request.ProductId)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdIssue403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdIssue404JSONResponse{
+			Message: "Товар не найден",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInOrder) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdIssue409JSONResponse{
+			Message: "Товар в заказе, его выдают по коду получения",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInvalidTransition) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdIssue409JSONResponse{
+			Message: "Товар не хранится на ПВЗ",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdIssue400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PostProductsProductIdIssue200JSONResponse(toProduct(product)), nil
+}
+
+func (s *Server) PostProductsProductIdReturn(
+	ctx context.Context,
+	request oapi.PostProductsProductIdReturnRequestObject,
+) (oapi.PostProductsProductIdReturnResponseObject, error) {
+	product, err := s.receptions.ReturnProduct(ctx, s.GetCurrentUserFromCtx(ctx), request.ProductId)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdReturn403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdReturn404JSONResponse{
+			Message: "Товар не найден",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInOrder) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdReturn409JSONResponse{
+			Message: "Товар в заказе, его выдают по коду получения",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrProductInvalidTransition) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdReturn409JSONResponse{
+			Message: "Товар уже выдан или возвращен",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PostProductsProductIdReturn400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PostProductsProductIdReturn200JSONResponse(toProduct(product)), nil
+}
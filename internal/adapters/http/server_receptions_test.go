@@ -10,6 +10,7 @@ import (
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/generated/mocks"
 	oapi "avito_pvz/internal/generated/oapi"
+	"avito_pvz/internal/infra/pointer"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -32,18 +33,67 @@ func TestServer_PostPvzPvzIdCloseLastReception(t *testing.T) {
 	tests := []struct {
 		name         string
 		request      oapi.PostPvzPvzIdCloseLastReceptionRequestObject
-		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository)
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockProductsRepository)
 		check        func(*testing.T, oapi.PostPvzPvzIdCloseLastReceptionResponseObject, error)
 	}{
 		{
 			name:    "Success",
 			request: oapi.PostPvzPvzIdCloseLastReceptionRequestObject{PvzId: pvzID},
-			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				productRepo *mocks.MockProductsRepository,
+			) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, nil)
 					})
+				repo.EXPECT().
+					FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reseption, nil)
+				repo.EXPECT().
+					Close(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				repo.EXPECT().FindManifest(mock.Anything, mock.Anything, reseption.ID).Return(nil, nil)
+				productRepo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, []domain.ReceptionID{reseption.ID}, mock.Anything).
+					Return(nil)
+				productRepo.EXPECT().
+					FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reseption.ID}).
+					Return([]domain.Product{
+						{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+							WeightGrams:      pointer.Ref(1200),
+							DeclaredValue:    pointer.Ref(int64(450000)),
+							InspectionStatus: pointer.Ref(domain.InspectionRejected),
+							InspectionReason: pointer.Ref("Коробка вскрыта"),
+						}},
+						{Type: domain.Clothes},
+					}, nil)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdCloseLastReceptionResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostPvzPvzIdCloseLastReception200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, reseption.ID, *res.Id)
+				assert.Nil(t, res.Discrepancy)
+				assert.Equal(t, oapi.ProductSummary{
+					Products:      2,
+					Rejected:      1,
+					Uninspected:   1,
+					Weight:        1200,
+					DeclaredValue: 450000,
+				}, res.Summary)
+			},
+		},
+		{
+			name:    "Discrepancy",
+			request: oapi.PostPvzPvzIdCloseLastReceptionRequestObject{PvzId: pvzID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				productRepo *mocks.MockProductsRepository,
+			) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -53,27 +103,44 @@ func TestServer_PostPvzPvzIdCloseLastReception(t *testing.T) {
 					FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reseption, nil)
 				repo.EXPECT().
-					Close(mock.Anything, mock.Anything, mock.Anything).
+					Close(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				repo.EXPECT().
+					FindManifest(mock.Anything, mock.Anything, reseption.ID).
+					Return(domain.ReceptionManifest{{Type: domain.Shoes, Count: 3}}, nil)
+				productRepo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(nil)
+				productRepo.EXPECT().
+					FindByReceptionIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{{Type: domain.Shoes}, {Type: domain.Clothes}}, nil)
+				repo.EXPECT().SaveDiscrepancy(mock.Anything, mock.Anything, reseption.ID, mock.Anything).Return(nil)
 			},
 			check: func(t *testing.T, response oapi.PostPvzPvzIdCloseLastReceptionResponseObject, err error) {
 				require.NoError(t, err)
-				require.IsType(
+				res, ok := response.(oapi.PostPvzPvzIdCloseLastReception200JSONResponse)
+				require.True(t, ok)
+				require.NotNil(t, res.Discrepancy)
+				assert.Equal(
 					t,
-					oapi.PostPvzPvzIdCloseLastReception200JSONResponse{},
-					response.(oapi.PostPvzPvzIdCloseLastReception200JSONResponse),
+					[]oapi.ManifestItem{{Type: string(domain.Shoes), Count: 2}},
+					res.Discrepancy.Missing,
+				)
+				assert.Equal(
+					t,
+					[]oapi.ManifestItem{{Type: string(domain.Clothes), Count: 1}},
+					res.Discrepancy.Extra,
 				)
 			},
 		},
 		{
 			name:    "Error",
 			request: oapi.PostPvzPvzIdCloseLastReceptionRequestObject{PvzId: pvzID},
-			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, nil)
-					})
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				_ *mocks.MockProductsRepository,
+			) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -83,7 +150,7 @@ func TestServer_PostPvzPvzIdCloseLastReception(t *testing.T) {
 					FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reseption, nil)
 				repo.EXPECT().
-					Close(mock.Anything, mock.Anything, mock.Anything).
+					Close(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(errors.New("some error"))
 			},
 			check: func(t *testing.T, response oapi.PostPvzPvzIdCloseLastReceptionResponseObject, err error) {
@@ -97,6 +164,31 @@ func TestServer_PostPvzPvzIdCloseLastReception(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:    "Closed concurrently",
+			request: oapi.PostPvzPvzIdCloseLastReceptionRequestObject{PvzId: pvzID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				_ *mocks.MockProductsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reseption, nil)
+				repo.EXPECT().
+					Close(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrReceptionNotFound)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdCloseLastReceptionResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdCloseLastReception409JSONResponse{}, response)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -107,18 +199,32 @@ func TestServer_PostPvzPvzIdCloseLastReception(t *testing.T) {
 			productRepo := mocks.NewMockProductsRepository(t)
 			receptionRepo := mocks.NewMockReceptionsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			analyticsRepo.EXPECT().CloseReception(mock.Anything, mock.Anything, reseption.ID).Return(nil).Maybe()
 
 			if test.prepareMocks != nil {
-				test.prepareMocks(connection, receptionRepo)
+				test.prepareMocks(connection, receptionRepo, productRepo)
 			}
 
 			server := http.NewServer(
 				nil,
-				domain.NewReceptionService(connection, receptionRepo, productRepo, metrics),
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					productRepo,
+					mocks.NewMockPVZsRepository(t),
+					analyticsRepo,
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					metrics,
+				),
+				nil,
+				nil,
 				nil,
 			)
 
-			response, err := server.PostPvzPvzIdCloseLastReception(t.Context(), test.request)
+			response, err := server.PostPvzPvzIdCloseLastReception(fixtureAuthCtx(t, domain.Employee), test.request)
 			test.check(t, response, err)
 		})
 	}
@@ -146,11 +252,6 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 			name:    "Success",
 			request: oapi.PostPvzPvzIdDeleteLastProductRequestObject{PvzId: pvzID},
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, nil)
-					})
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -160,8 +261,8 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 					FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reseption, nil)
 				repoProduct.EXPECT().
-					DeleteLast(mock.Anything, mock.Anything, mock.Anything).
-					Return(nil)
+					DeleteLast(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, nil)
 			},
 			check: func(t *testing.T, response oapi.PostPvzPvzIdDeleteLastProductResponseObject, err error) {
 				require.NoError(t, err)
@@ -176,11 +277,6 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 			name:    "Error",
 			request: oapi.PostPvzPvzIdDeleteLastProductRequestObject{PvzId: pvzID},
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, nil)
-					})
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -190,8 +286,8 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 					FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reseption, nil)
 				repoProduct.EXPECT().
-					DeleteLast(mock.Anything, mock.Anything, mock.Anything).
-					Return(errors.New("some error"))
+					DeleteLast(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, errors.New("some error"))
 			},
 			check: func(t *testing.T, response oapi.PostPvzPvzIdDeleteLastProductResponseObject, err error) {
 				require.NoError(t, err)
@@ -208,6 +304,60 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:    "Last product not received",
+			request: oapi.PostPvzPvzIdDeleteLastProductRequestObject{PvzId: pvzID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repoReception *mocks.MockReceptionsRepository,
+				repoProduct *mocks.MockProductsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reseption, nil)
+				repoProduct.EXPECT().
+					DeleteLast(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, domain.ErrProductInvalidTransition)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdDeleteLastProductResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdDeleteLastProduct409JSONResponse{}, response)
+				res, _ := response.(oapi.PostPvzPvzIdDeleteLastProduct409JSONResponse)
+				assert.Equal(t, "Последний товар уже хранится, выдан или возвращен", res.Message)
+			},
+		},
+		{
+			name:    "Last product in order",
+			request: oapi.PostPvzPvzIdDeleteLastProductRequestObject{PvzId: pvzID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repoReception *mocks.MockReceptionsRepository,
+				repoProduct *mocks.MockProductsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reseption, nil)
+				repoProduct.EXPECT().
+					DeleteLast(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, domain.ErrProductInOrder)
+			},
+			check: func(t *testing.T, response oapi.PostPvzPvzIdDeleteLastProductResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostPvzPvzIdDeleteLastProduct409JSONResponse{}, response)
+				res, _ := response.(oapi.PostPvzPvzIdDeleteLastProduct409JSONResponse)
+				assert.Equal(t, "Последний товар в заказе", res.Message)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -218,6 +368,8 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 			productRepo := mocks.NewMockProductsRepository(t)
 			receptionRepo := mocks.NewMockReceptionsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			analyticsRepo.EXPECT().RemoveProduct(mock.Anything, mock.Anything, domain.Product{}).Return(nil).Maybe()
 
 			if test.prepareMocks != nil {
 				test.prepareMocks(connection, receptionRepo, productRepo)
@@ -225,16 +377,117 @@ func TestServer_PostPvzPvzIdDeleteLastProduct(t *testing.T) {
 
 			server := http.NewServer(
 				nil,
-				domain.NewReceptionService(connection, receptionRepo, productRepo, metrics),
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					productRepo,
+					mocks.NewMockPVZsRepository(t),
+					analyticsRepo,
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					metrics,
+				),
+				nil,
+				nil,
 				nil,
 			)
 
-			response, err := server.PostPvzPvzIdDeleteLastProduct(t.Context(), test.request)
+			response, err := server.PostPvzPvzIdDeleteLastProduct(fixtureAuthCtx(t, domain.Employee), test.request)
 			test.check(t, response, err)
 		})
 	}
 }
 
+func TestServer_GetProducts(t *testing.T) {
+	t.Parallel()
+
+	location := domain.ProductLocation{
+		PVZ:       domain.PVZ{ID: uuid.New(), City: "Москва"},
+		Reception: domain.Reception{ID: uuid.New(), Status: domain.InProgress},
+		Product:   domain.Product{ID: uuid.New(), Type: domain.Shoes, Barcode: pointer.Ref("4600000000017")},
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		barcode      string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository)
+		check        func(oapi.GetProductsResponseObject, error)
+	}{
+		{
+			name:    "Success",
+			role:    domain.Moderator,
+			barcode: "4600000000017",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindByBarcode(mock.Anything, mock.Anything, "4600000000017").
+					Return([]domain.ProductLocation{location}, nil)
+			},
+			check: func(response oapi.GetProductsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetProducts200JSONResponse{}, response)
+				locations := response.(oapi.GetProducts200JSONResponse)
+				require.Len(t, locations, 1)
+				require.Equal(t, location.PVZ.ID, *locations[0].Pvz.Id)
+				require.Equal(t, location.Reception.ID, *locations[0].Reception.Id)
+				require.Equal(t, location.Product.Barcode, locations[0].Product.Barcode)
+			},
+		},
+		{
+			name:    "Empty barcode",
+			role:    domain.Employee,
+			barcode: " ",
+			check: func(response oapi.GetProductsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetProducts400JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, repoProduct)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					mocks.NewMockReceptionsRepository(t),
+					repoProduct,
+					mocks.NewMockPVZsRepository(t),
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.GetProducts(
+				fixtureAuthCtx(t, test.role),
+				oapi.GetProductsRequestObject{Params: oapi.GetProductsParams{Barcode: test.barcode}},
+			)
+			test.check(response, err)
+		})
+	}
+}
+
 func TestServer_PostProducts(t *testing.T) {
 	t.Parallel()
 
@@ -257,14 +510,9 @@ func TestServer_PostProducts(t *testing.T) {
 			name: "Success",
 			request: oapi.PostProductsRequestObject{Body: &oapi.PostProductsJSONRequestBody{
 				PvzId: uuid.New(),
-				Type:  oapi.PostProductsJSONBodyType(oapi.ProductTypeОбувь),
+				Type:  string(domain.Shoes),
 			}},
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, m *mocks.MockMetrics) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, nil)
-					})
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -287,6 +535,183 @@ func TestServer_PostProducts(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "Barcode already in reception",
+			request: oapi.PostProductsRequestObject{Body: &oapi.PostProductsJSONRequestBody{
+				PvzId:   uuid.New(),
+				Type:    string(domain.Shoes),
+				Barcode: pointer.Ref("4600000000017"),
+			}},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reseption, nil)
+				repoProduct.EXPECT().
+					FindInProgressByBarcodes(mock.Anything, mock.Anything, []string{"4600000000017"}).
+					Return(nil, nil)
+				repoProduct.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.Join(errors.New("create failed"), domain.ErrProductDuplicateBarcode))
+			},
+			check: func(response oapi.PostProductsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProducts409JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			analyticsRepo.EXPECT().AddProduct(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, repoReception, repoProduct, metrics)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					repoReception,
+					repoProduct,
+					mocks.NewMockPVZsRepository(t),
+					analyticsRepo,
+					fixtureProductTypes(t),
+					mocks.NewMockOrdersRepository(t),
+					fixtureStorageCells(t),
+					metrics,
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostProducts(fixtureAuthCtx(t, domain.Employee), test.request)
+			test.check(response, err)
+		})
+	}
+}
+
+func TestServer_PostProductsBatch(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	reception := domain.Reception{
+		ID:        uuid.New(),
+		PVZID:     pvzID,
+		Status:    domain.InProgress,
+		CreatedAt: time.Now(),
+	}
+
+	body := &oapi.PostProductsBatchJSONRequestBody{PvzId: pvzID}
+	body.Products = make([]struct {
+		Barcode       *string          `json:"barcode,omitempty"`
+		DeclaredValue *int64           `json:"declaredValue,omitempty"`
+		Dimensions    *oapi.Dimensions `json:"dimensions,omitempty"`
+		Inspection    *oapi.Inspection `json:"inspection,omitempty"`
+		Type          string           `json:"type"`
+		Weight        *int             `json:"weight,omitempty"`
+	}, 2)
+	body.Products[0].Type = string(domain.Shoes)
+	body.Products[0].Weight = pointer.Ref(1200)
+	body.Products[0].Inspection = &oapi.Inspection{Status: oapi.Rejected, Reason: pointer.Ref("Коробка вскрыта")}
+	body.Products[1].Type = string(domain.Clothes)
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockProductsRepository, *mocks.MockMetrics)
+		check        func(oapi.PostProductsBatchResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, m *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				m.EXPECT().IncProducts().Return().Times(2)
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(reception, nil)
+				repoProduct.EXPECT().
+					CreateBatch(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			check: func(response oapi.PostProductsBatchResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsBatch201JSONResponse{}, response)
+				products := response.(oapi.PostProductsBatch201JSONResponse).Products
+				require.Len(t, products, 2)
+				require.Equal(t, string(domain.Shoes), products[0].Type)
+				require.Equal(t, string(domain.Clothes), products[1].Type)
+				require.Equal(t, pointer.Ref(1200), products[0].Weight)
+				require.Equal(t, oapi.Rejected, products[0].Inspection.Status)
+				require.Nil(t, products[1].Inspection)
+			},
+		},
+		{
+			name: "No active reception",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(domain.Reception{}, domain.ErrReceptionNotFound)
+			},
+			check: func(response oapi.PostProductsBatchResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsBatch400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Barcode already in reception",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(reception, nil)
+				repoProduct.EXPECT().
+					CreateBatch(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrProductDuplicateBarcode)
+			},
+			check: func(response oapi.PostProductsBatchResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsBatch409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Moderator not authorized",
+			role: domain.Moderator,
+			check: func(response oapi.PostProductsBatchResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsBatch403JSONResponse{}, response)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -297,6 +722,8 @@ func TestServer_PostProducts(t *testing.T) {
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			repoProduct := mocks.NewMockProductsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			analyticsRepo.EXPECT().AddProducts(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 			if test.prepareMocks != nil {
 				test.prepareMocks(connection, repoReception, repoProduct, metrics)
@@ -304,12 +731,816 @@ func TestServer_PostProducts(t *testing.T) {
 
 			server := http.NewServer(
 				nil,
-				domain.NewReceptionService(connection, repoReception, repoProduct, metrics),
+				domain.NewReceptionService(
+					connection,
+					repoReception,
+					repoProduct,
+					mocks.NewMockPVZsRepository(t),
+					analyticsRepo,
+					fixtureProductTypes(t),
+					mocks.NewMockOrdersRepository(t),
+					fixtureStorageCells(t),
+					metrics,
+				),
+				nil,
+				nil,
 				nil,
 			)
 
-			response, err := server.PostProducts(t.Context(), test.request)
+			response, err := server.PostProductsBatch(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostProductsBatchRequestObject{Body: body},
+			)
 			test.check(response, err)
 		})
 	}
 }
+
+func TestServer_PostReceptions(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name         string
+		manifest     *[]oapi.ManifestItem
+		prepareMocks func(*mocks.MockReceptionsRepository, *mocks.MockMetrics)
+		check        func(*testing.T, oapi.PostReceptionsResponseObject, error)
+	}{
+		{
+			name: "Success",
+			prepareMocks: func(repo *mocks.MockReceptionsRepository, m *mocks.MockMetrics) {
+				repo.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				repo.EXPECT().
+					FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(domain.Reception{ID: uuid.New(), PVZID: pvzID, Status: domain.InProgress}, nil)
+				m.EXPECT().IncReceptions().Return()
+			},
+			check: func(t *testing.T, response oapi.PostReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostReceptions201JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, pvzID, res.PvzId)
+			},
+		},
+		{
+			name: "Reception in progress",
+			prepareMocks: func(repo *mocks.MockReceptionsRepository, _ *mocks.MockMetrics) {
+				repo.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.Join(errors.New("create failed"), domain.ErrReceptionInProgress))
+			},
+			check: func(t *testing.T, response oapi.PostReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostReceptions409JSONResponse{}, response)
+			},
+		},
+		{
+			name:     "With manifest",
+			manifest: &[]oapi.ManifestItem{{Type: string(domain.Shoes), Count: 5}},
+			prepareMocks: func(repo *mocks.MockReceptionsRepository, m *mocks.MockMetrics) {
+				repo.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+				repo.EXPECT().
+					SaveManifest(
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						domain.ReceptionManifest{{Type: domain.Shoes, Count: 5}},
+					).
+					Return(nil)
+				repo.EXPECT().
+					FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(domain.Reception{ID: uuid.New(), PVZID: pvzID, Status: domain.InProgress}, nil)
+				m.EXPECT().IncReceptions().Return()
+			},
+			check: func(t *testing.T, response oapi.PostReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostReceptions201JSONResponse{}, response)
+			},
+		},
+		{
+			name:         "Invalid manifest",
+			manifest:     &[]oapi.ManifestItem{{Type: string(domain.Shoes), Count: 0}},
+			prepareMocks: func(_ *mocks.MockReceptionsRepository, _ *mocks.MockMetrics) {},
+			check: func(t *testing.T, response oapi.PostReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostReceptions400JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, "Неверный ожидаемый состав поставки", res.Message)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			connection.EXPECT().
+				ExecuteTx(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+					return f(ctx, nil)
+				}).
+				Maybe()
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			analyticsRepo.EXPECT().AddReception(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			test.prepareMocks(receptionRepo, metrics)
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					mocks.NewMockProductsRepository(t),
+					mocks.NewMockPVZsRepository(t),
+					analyticsRepo,
+					fixtureProductTypes(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					metrics,
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostReceptions(
+				fixtureAuthCtx(t, domain.Moderator),
+				oapi.PostReceptionsRequestObject{
+					Body: &oapi.PostReceptionsJSONRequestBody{
+						PvzId:    pvzID,
+						Override: pointer.Ref(true),
+						Manifest: test.manifest,
+					},
+				},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_GetPvzPvzIdReceptions(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	employeeID := uuid.New()
+	reception := domain.Reception{
+		ID:        uuid.New(),
+		PVZID:     pvzID,
+		Status:    domain.Close,
+		CreatedAt: time.Now(),
+		CreatedBy: &employeeID,
+	}
+
+	tests := []struct {
+		name         string
+		params       oapi.GetPvzPvzIdReceptionsParams
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository)
+		check        func(*testing.T, oapi.GetPvzPvzIdReceptionsResponseObject, error)
+	}{
+		{
+			name: "Success",
+			params: oapi.GetPvzPvzIdReceptionsParams{
+				Status:    pointer.Ref(oapi.GetPvzPvzIdReceptionsParamsStatusClose),
+				CreatedBy: pointer.Ref(employeeID),
+				Page:      pointer.Ref(2),
+			},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				filter := domain.ReceptionFilter{Status: pointer.Ref(domain.Close), CreatedBy: pointer.Ref(employeeID)}
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil)
+				repo.EXPECT().
+					FindByPVZ(mock.Anything, mock.Anything, pvzID, filter, 2, domain.DefaultLimit).
+					Return([]domain.ReceptionSummary{{Reception: reception, Products: 5}}, nil)
+				repo.EXPECT().CountByPVZ(mock.Anything, mock.Anything, pvzID, filter).Return(11, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvzPvzIdReceptions200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, 11, res.Total)
+				assert.Equal(t, 2, res.Page)
+				require.Len(t, res.Items, 1)
+				assert.Equal(t, reception.ID, *res.Items[0].Reception.Id)
+				assert.Equal(t, 5, res.Items[0].ProductsCount)
+				assert.Equal(t, &employeeID, res.Items[0].Reception.CreatedBy)
+			},
+		},
+		{
+			name: "PVZ not found",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockReceptionsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzRepo.EXPECT().
+					FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{}, errors.Join(errors.New("some error"), domain.ErrPVZNotFound))
+			},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetPvzPvzIdReceptions404JSONResponse{}, response)
+			},
+		},
+		{
+			name:   "Invalid page",
+			params: oapi.GetPvzPvzIdReceptionsParams{Page: pointer.Ref(0)},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdReceptionsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetPvzPvzIdReceptions400JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, receptionRepo, pvzRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					mocks.NewMockProductsRepository(t),
+					pvzRepo,
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.GetPvzPvzIdReceptions(
+				fixtureAuthCtx(t, domain.Employee),
+				oapi.GetPvzPvzIdReceptionsRequestObject{PvzId: pvzID, Params: test.params},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_GetReceptionsReceptionId(t *testing.T) {
+	t.Parallel()
+
+	openedAt := time.Now().Add(-time.Hour)
+	closedAt := time.Now()
+	openedBy, closedBy := uuid.New(), uuid.New()
+	details := domain.ReceptionDetails{
+		Reception: domain.Reception{ID: uuid.New(), PVZID: uuid.New(), Status: domain.Close, CreatedAt: openedAt},
+		Timeline: domain.ReceptionTimeline{
+			OpenedAt: openedAt,
+			OpenedBy: &openedBy,
+			ClosedAt: &closedAt,
+			ClosedBy: &closedBy,
+			Reopenings: []domain.ReceptionReopening{{
+				ID:         uuid.New(),
+				ReopenedAt: openedAt.Add(time.Minute),
+				ReopenedBy: closedBy,
+				Reason:     "закрыли по ошибке",
+				ClosedAt:   &openedAt,
+			}},
+		},
+		Products: []domain.Product{
+			{ID: uuid.New(), Type: domain.Shoes, CreatedBy: &openedBy},
+			{ID: uuid.New(), Type: domain.Clothes},
+		},
+		DeletedProducts: []domain.DeletedProduct{{
+			Product:   domain.Product{ID: uuid.New(), Type: domain.Electronics},
+			DeletedAt: closedAt,
+			DeletedBy: &closedBy,
+		}},
+	}
+	details.PVZ = domain.PVZ{ID: details.Reception.PVZID, City: domain.Kzn}
+
+	tests := []struct {
+		name         string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository)
+		check        func(*testing.T, oapi.GetReceptionsReceptionIdResponseObject, error)
+	}{
+		{
+			name: "Success",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().FindDetails(mock.Anything, mock.Anything, details.Reception.ID).Return(details, nil)
+			},
+			check: func(t *testing.T, response oapi.GetReceptionsReceptionIdResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetReceptionsReceptionId200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, details.Reception.ID, *res.Reception.Id)
+				assert.Equal(t, details.PVZ.ID, *res.Pvz.Id)
+				assert.Equal(t, openedAt, res.Timeline.OpenedAt)
+				assert.Equal(t, &closedAt, res.Timeline.ClosedAt)
+				assert.Equal(t, &openedBy, res.Timeline.OpenedBy)
+				assert.Equal(t, &closedBy, res.Timeline.ClosedBy)
+				require.Len(t, res.Timeline.Reopenings, 1)
+				assert.Equal(t, details.Timeline.Reopenings[0].ID, res.Timeline.Reopenings[0].Id)
+				assert.Equal(t, "закрыли по ошибке", res.Timeline.Reopenings[0].Reason)
+				require.Len(t, res.Products, 2)
+				assert.Equal(t, details.Products[0].ID, *res.Products[0].Id)
+				assert.Equal(t, &openedBy, res.Products[0].CreatedBy)
+				assert.Equal(t, details.Products[1].ID, *res.Products[1].Id)
+				require.Len(t, res.DeletedProducts, 1)
+				assert.Equal(t, details.DeletedProducts[0].ID, *res.DeletedProducts[0].Product.Id)
+				assert.Equal(t, &closedBy, res.DeletedProducts[0].DeletedBy)
+			},
+		},
+		{
+			name: "Reception not found",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindDetails(mock.Anything, mock.Anything, details.Reception.ID).
+					Return(domain.ReceptionDetails{}, errors.Join(errors.New("some error"), domain.ErrReceptionNotFound))
+			},
+			check: func(t *testing.T, response oapi.GetReceptionsReceptionIdResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetReceptionsReceptionId404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Repository error",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindDetails(mock.Anything, mock.Anything, details.Reception.ID).
+					Return(domain.ReceptionDetails{}, errors.New("some error"))
+			},
+			check: func(t *testing.T, response oapi.GetReceptionsReceptionIdResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetReceptionsReceptionId400JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, receptionRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					mocks.NewMockProductsRepository(t),
+					mocks.NewMockPVZsRepository(t),
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.GetReceptionsReceptionId(
+				fixtureAuthCtx(t, domain.Moderator),
+				oapi.GetReceptionsReceptionIdRequestObject{ReceptionId: details.Reception.ID},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PostReceptionsReceptionIdReopen(t *testing.T) {
+	t.Parallel()
+
+	reception := domain.Reception{ID: uuid.New(), PVZID: uuid.New(), Status: domain.Close}
+	findReception := func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+		provider.EXPECT().
+			ExecuteTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+				return f(ctx, nil)
+			})
+		repo.EXPECT().
+			FindByIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+			Return([]domain.Reception{reception}, nil)
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		reason       string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockAnalyticsRepository)
+		check        func(*testing.T, oapi.PostReceptionsReceptionIdReopenResponseObject, error)
+	}{
+		{
+			name:   "Success",
+			role:   domain.Moderator,
+			reason: "закрыли по ошибке",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+			) {
+				findReception(provider, repo)
+				analytics.EXPECT().ReopenReception(mock.Anything, mock.Anything, reception.ID).Return(nil)
+				repo.EXPECT().Reopen(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			check: func(t *testing.T, response oapi.PostReceptionsReceptionIdReopenResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostReceptionsReceptionIdReopen200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, reception.ID, *res.Id)
+				assert.Equal(t, oapi.ReceptionStatusInProgress, res.Status)
+			},
+		},
+		{
+			name:   "Newer reception exists",
+			role:   domain.Moderator,
+			reason: "закрыли по ошибке",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+			) {
+				findReception(provider, repo)
+				analytics.EXPECT().ReopenReception(mock.Anything, mock.Anything, reception.ID).Return(nil)
+				repo.EXPECT().
+					Reopen(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.Join(errors.New("some error"), domain.ErrReceptionNotReopenable))
+			},
+			check: func(t *testing.T, response oapi.PostReceptionsReceptionIdReopenResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostReceptionsReceptionIdReopen409JSONResponse{}, response)
+			},
+		},
+		{
+			name:   "Reception not found",
+			role:   domain.Moderator,
+			reason: "закрыли по ошибке",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				_ *mocks.MockAnalyticsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().FindByIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			check: func(t *testing.T, response oapi.PostReceptionsReceptionIdReopenResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostReceptionsReceptionIdReopen404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Empty reason",
+			role: domain.Moderator,
+			check: func(t *testing.T, response oapi.PostReceptionsReceptionIdReopenResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostReceptionsReceptionIdReopen400JSONResponse{}, response)
+			},
+		},
+		{
+			name:   "Employee",
+			role:   domain.Employee,
+			reason: "закрыли по ошибке",
+			check: func(t *testing.T, response oapi.PostReceptionsReceptionIdReopenResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostReceptionsReceptionIdReopen403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, receptionRepo, analyticsRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					receptionRepo,
+					mocks.NewMockProductsRepository(t),
+					mocks.NewMockPVZsRepository(t),
+					analyticsRepo,
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					mocks.NewMockStorageCellsRepository(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostReceptionsReceptionIdReopen(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostReceptionsReceptionIdReopenRequestObject{
+					ReceptionId: reception.ID,
+					Body:        &oapi.PostReceptionsReceptionIdReopenJSONRequestBody{Reason: test.reason},
+				},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PostProductsProductIdIssue(t *testing.T) {
+	t.Parallel()
+
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository)
+		check        func(*testing.T, oapi.PostProductsProductIdIssueResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product}, nil)
+				issued := product
+				issued.Status = domain.ProductIssued
+				repo.EXPECT().ChangeStatus(mock.Anything, mock.Anything, mock.Anything).Return(issued, nil)
+			},
+			check: func(t *testing.T, response oapi.PostProductsProductIdIssueResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostProductsProductIdIssue200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, product.ID, *res.Id)
+				assert.Equal(t, oapi.Issued, res.Status)
+			},
+		},
+		{
+			name: "Not found",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().FindByIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			check: func(t *testing.T, response oapi.PostProductsProductIdIssueResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsProductIdIssue404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Not stored",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				received := product
+				received.Status = domain.ProductReceived
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{received}, nil)
+			},
+			check: func(t *testing.T, response oapi.PostProductsProductIdIssueResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsProductIdIssue409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Repository error",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("some error"))
+			},
+			check: func(t *testing.T, response oapi.PostProductsProductIdIssueResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsProductIdIssue400JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Moderator",
+			role: domain.Moderator,
+			check: func(t *testing.T, response oapi.PostProductsProductIdIssueResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsProductIdIssue403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, productRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					mocks.NewMockReceptionsRepository(t),
+					productRepo,
+					mocks.NewMockPVZsRepository(t),
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					fixtureNoWaitingOrders(t),
+					fixtureStorageCells(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostProductsProductIdIssue(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostProductsProductIdIssueRequestObject{ProductId: product.ID},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PostProductsProductIdReturn(t *testing.T) {
+	t.Parallel()
+
+	product := domain.Product{
+		ID:          uuid.New(),
+		ReceptionID: uuid.New(),
+		Type:        domain.Shoes,
+		Status:      domain.ProductReceived,
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository)
+		check        func(*testing.T, oapi.PostProductsProductIdReturnResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product}, nil)
+				returned := product
+				returned.Status = domain.ProductReturned
+				repo.EXPECT().ChangeStatus(mock.Anything, mock.Anything, mock.Anything).Return(returned, nil)
+			},
+			check: func(t *testing.T, response oapi.PostProductsProductIdReturnResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PostProductsProductIdReturn200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, oapi.Returned, res.Status)
+			},
+		},
+		{
+			name: "Already returned",
+			role: domain.Employee,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product}, nil)
+				repo.EXPECT().
+					ChangeStatus(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, domain.ErrProductInvalidTransition)
+			},
+			check: func(t *testing.T, response oapi.PostProductsProductIdReturnResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsProductIdReturn409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Moderator",
+			role: domain.Moderator,
+			check: func(t *testing.T, response oapi.PostProductsProductIdReturnResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PostProductsProductIdReturn403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, productRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					mocks.NewMockReceptionsRepository(t),
+					productRepo,
+					mocks.NewMockPVZsRepository(t),
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					fixtureNoWaitingOrders(t),
+					fixtureStorageCells(t),
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PostProductsProductIdReturn(
+				fixtureAuthCtx(t, test.role),
+				oapi.PostProductsProductIdReturnRequestObject{ProductId: product.ID},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+	oapi "avito_pvz/internal/generated/oapi"
+)
+
+func (s *Server) GetPvzPvzIdCells(
+	ctx context.Context,
+	request oapi.GetPvzPvzIdCellsRequestObject,
+) (oapi.GetPvzPvzIdCellsResponseObject, error) {
+	layout, err := s.receptions.FindStorageLayout(ctx, s.GetCurrentUserFromCtx(ctx), request.PvzId)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzIdCells403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrPVZNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzIdCells404JSONResponse{
+			Message: "ПВЗ не найден",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.GetPvzPvzIdCells400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.GetPvzPvzIdCells200JSONResponse(toStorageLayout(layout)), nil
+}
+
+func (s *Server) PutPvzPvzIdCells(
+	ctx context.Context,
+	request oapi.PutPvzPvzIdCellsRequestObject,
+) (oapi.PutPvzPvzIdCellsResponseObject, error) {
+	layout := domain.StorageLayout{
+		PVZID:      request.PvzId,
+		Allocation: domain.CellAllocation(request.Body.Allocation),
+		Cells:      make([]domain.StorageCell, 0, len(request.Body.Cells)),
+	}
+	for _, cell := range request.Body.Cells {
+		layout.Cells = append(layout.Cells, domain.StorageCell{
+			Code: cell.Code,
+			Type: (*domain.ProductType)(cell.Type),
+		})
+	}
+
+	saved, err := s.receptions.SaveStorageLayout(ctx, s.GetCurrentUserFromCtx(ctx), layout)
+	if err == domain.ErrNotAuthorized {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutPvzPvzIdCells403JSONResponse{
+			Message: "Доступ запрещен",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrPVZNotFound) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutPvzPvzIdCells404JSONResponse{
+			Message: "ПВЗ не найден",
+		}, nil
+	}
+
+	if errors.Is(err, domain.ErrStorageCellOccupied) {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutPvzPvzIdCells409JSONResponse{
+			Message: "В удаляемой ячейке лежит товар",
+		}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // generated code expects error in response.
+		return oapi.PutPvzPvzIdCells400JSONResponse{
+			Message: "Неверный запрос",
+		}, nil
+	}
+
+	return oapi.PutPvzPvzIdCells200JSONResponse(toStorageLayout(saved)), nil
+}
+
+func toStorageLayout(layout domain.StorageLayout) oapi.StorageLayout {
+	cells := make([]oapi.StorageCell, 0, len(layout.Cells))
+	for _, cell := range layout.Cells {
+		cells = append(cells, toStorageCell(cell))
+	}
+
+	return oapi.StorageLayout{
+		Allocation: oapi.CellAllocation(layout.Allocation),
+		Cells:      cells,
+	}
+}
+
+func toStorageCell(cell domain.StorageCell) oapi.StorageCell {
+	return oapi.StorageCell{
+		Id:        cell.ID,
+		Code:      cell.Code,
+		Type:      (*string)(cell.Type),
+		ProductId: cell.ProductID,
+	}
+}
+
+func toStorageCellRef(cell *domain.StorageCell) *oapi.StorageCell {
+	if cell == nil {
+		return nil
+	}
+	result := toStorageCell(*cell)
+
+	return &result
+}
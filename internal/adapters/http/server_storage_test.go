@@ -0,0 +1,294 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+
+	"avito_pvz/internal/adapters/http"
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	oapi "avito_pvz/internal/generated/oapi"
+	"avito_pvz/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_GetPvzPvzIdCells(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	layout := domain.StorageLayout{
+		PVZID:      pvzID,
+		Allocation: domain.CellAllocationTypeGrouped,
+		Cells: []domain.StorageCell{
+			{
+				ID:        uuid.New(),
+				PVZID:     pvzID,
+				Code:      "A-1",
+				Type:      pointer.Ref(domain.Shoes),
+				ProductID: pointer.Ref(uuid.New()),
+			},
+			{ID: uuid.New(), PVZID: pvzID, Code: "A-2"},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository, *mocks.MockStorageCellsRepository)
+		check        func(*testing.T, oapi.GetPvzPvzIdCellsResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Employee,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				cells *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil)
+				cells.EXPECT().FindLayout(mock.Anything, mock.Anything, pvzID).Return(layout, nil)
+			},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.GetPvzPvzIdCells200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, oapi.TypeGrouped, res.Allocation)
+				require.Len(t, res.Cells, 2)
+				assert.Equal(t, "обувь", *res.Cells[0].Type)
+				assert.Equal(t, layout.Cells[0].ProductID, res.Cells[0].ProductId)
+				assert.Nil(t, res.Cells[1].ProductId)
+			},
+		},
+		{
+			name: "PVZ not found",
+			role: domain.Moderator,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				_ *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{}, domain.ErrPVZNotFound)
+			},
+			check: func(t *testing.T, response oapi.GetPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetPvzPvzIdCells404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Unknown role",
+			role: domain.UserRole("guest"),
+			check: func(t *testing.T, response oapi.GetPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.GetPvzPvzIdCells403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+			cellRepo := mocks.NewMockStorageCellsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, pvzRepo, cellRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					mocks.NewMockReceptionsRepository(t),
+					mocks.NewMockProductsRepository(t),
+					pvzRepo,
+					mocks.NewMockAnalyticsRepository(t),
+					mocks.NewMockProductTypesRepository(t),
+					mocks.NewMockOrdersRepository(t),
+					cellRepo,
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.GetPvzPvzIdCells(
+				fixtureAuthCtx(t, test.role),
+				oapi.GetPvzPvzIdCellsRequestObject{PvzId: pvzID},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+func TestServer_PutPvzPvzIdCells(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	body := &oapi.PutPvzPvzIdCellsJSONRequestBody{Allocation: oapi.FillFirst}
+	body.Cells = append(body.Cells, struct {
+		Code string  `json:"code"`
+		Type *string `json:"type,omitempty"`
+	}{Code: "A-1", Type: pointer.Ref(string(domain.Shoes))})
+
+	tests := []struct {
+		name         string
+		role         domain.UserRole
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository, *mocks.MockStorageCellsRepository)
+		check        func(*testing.T, oapi.PutPvzPvzIdCellsResponseObject, error)
+	}{
+		{
+			name: "Success",
+			role: domain.Moderator,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				cells *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil)
+				cells.EXPECT().
+					SaveLayout(mock.Anything, mock.Anything, mock.MatchedBy(func(layout domain.StorageLayout) bool {
+						return layout.Allocation == domain.CellAllocationFillFirst &&
+							len(layout.Cells) == 1 && *layout.Cells[0].Type == domain.Shoes
+					})).
+					Return(nil)
+				cells.EXPECT().
+					FindLayout(mock.Anything, mock.Anything, pvzID).
+					Return(domain.StorageLayout{
+						PVZID:      pvzID,
+						Allocation: domain.CellAllocationFillFirst,
+						Cells:      []domain.StorageCell{{ID: uuid.New(), PVZID: pvzID, Code: "A-1", Position: 1}},
+					}, nil)
+			},
+			check: func(t *testing.T, response oapi.PutPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				res, ok := response.(oapi.PutPvzPvzIdCells200JSONResponse)
+				require.True(t, ok)
+				assert.Equal(t, oapi.FillFirst, res.Allocation)
+				require.Len(t, res.Cells, 1)
+				assert.Equal(t, "A-1", res.Cells[0].Code)
+			},
+		},
+		{
+			name: "Occupied cell removed",
+			role: domain.Moderator,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				cells *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil)
+				cells.EXPECT().
+					SaveLayout(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrStorageCellOccupied)
+			},
+			check: func(t *testing.T, response oapi.PutPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PutPvzPvzIdCells409JSONResponse{}, response)
+			},
+		},
+		{
+			name: "PVZ not found",
+			role: domain.Moderator,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				_ *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{}, domain.ErrPVZNotFound)
+			},
+			check: func(t *testing.T, response oapi.PutPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PutPvzPvzIdCells404JSONResponse{}, response)
+			},
+		},
+		{
+			name: "Employee not authorized",
+			role: domain.Employee,
+			check: func(t *testing.T, response oapi.PutPvzPvzIdCellsResponseObject, err error) {
+				require.NoError(t, err)
+				require.IsType(t, oapi.PutPvzPvzIdCells403JSONResponse{}, response)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			connection := mocks.NewMockConnectionProvider(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+			cellRepo := mocks.NewMockStorageCellsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(connection, pvzRepo, cellRepo)
+			}
+
+			server := http.NewServer(
+				nil,
+				domain.NewReceptionService(
+					connection,
+					mocks.NewMockReceptionsRepository(t),
+					mocks.NewMockProductsRepository(t),
+					pvzRepo,
+					mocks.NewMockAnalyticsRepository(t),
+					fixtureProductTypes(t),
+					mocks.NewMockOrdersRepository(t),
+					cellRepo,
+					mocks.NewMockMetrics(t),
+				),
+				nil,
+				nil,
+				nil,
+			)
+
+			response, err := server.PutPvzPvzIdCells(
+				fixtureAuthCtx(t, test.role),
+				oapi.PutPvzPvzIdCellsRequestObject{PvzId: pvzID, Body: body},
+			)
+			test.check(t, response, err)
+		})
+	}
+}
+
+// fixtureStorageCells stands for a PVZ without storage cells.
+func fixtureStorageCells(t *testing.T) *mocks.MockStorageCellsRepository {
+	t.Helper()
+
+	repo := mocks.NewMockStorageCellsRepository(t)
+	repo.EXPECT().Allocate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	repo.EXPECT().Free(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return repo
+}
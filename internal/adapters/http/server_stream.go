@@ -0,0 +1,99 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+
+	"avito_pvz/internal/domain"
+	oapi "avito_pvz/internal/generated/oapi"
+)
+
+const mediaTypeNDJSON = "application/x-ndjson"
+
+func (s *Server) GetPvzStream(
+	ctx context.Context,
+	request oapi.GetPvzStreamRequestObject,
+) (oapi.GetPvzStreamResponseObject, error) {
+	return pvzStreamResponse{
+		ctx:      ctx,
+		pvzs:     s.pvzs,
+		authUser: s.GetCurrentUserFromCtx(ctx),
+		filter: domain.PVZFilter{
+			Period: domain.Period{
+				From:      request.Params.StartDate,
+				To:        request.Params.EndDate,
+				LocalFrom: dateOrNil(request.Params.StartLocalDate),
+				LocalTo:   dateOrNil(request.Params.EndLocalDate),
+			},
+			Cities:             convertEnums[domain.PVZCity](request.Params.City),
+			Status:             convertEnum[domain.PVZStatus](request.Params.Status),
+			ProductTypes:       convertEnums[domain.ProductType](request.Params.ProductType),
+			ProductStatuses:    convertEnums[domain.ProductStatus](request.Params.ProductStatus),
+			ReceptionStatus:    convertEnum[domain.ReceptionStatus](request.Params.ReceptionStatus),
+			ReceptionCreatedBy: request.Params.ReceptionCreatedBy,
+			HasActiveReception: request.Params.HasActiveReception,
+			Sort:               domain.PVZSort(valueOrZero(request.Params.Sort)),
+			Descending:         valueOrZero(request.Params.Order) == oapi.GetPvzStreamParamsOrderDesc,
+		},
+	}, nil
+}
+
+// pvzStreamResponse writes a line per PVZ as it comes off the cursor. Every
+// line is flushed, so a client that stops reading blocks the write and with it
+// the cursor; a client that disconnects cancels ctx, and ExecuteReadOnly
+// cancels the query with it.
+type pvzStreamResponse struct {
+	ctx      context.Context
+	pvzs     domain.PVZsInterface
+	authUser domain.AuthenticatedUser
+	filter   domain.PVZFilter
+}
+
+func (r pvzStreamResponse) VisitGetPvzStreamResponse(w nethttp.ResponseWriter) error {
+	controller := nethttp.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+
+	started := false
+	start := func() {
+		started = true
+		w.Header().Set("Content-Type", mediaTypeNDJSON)
+		w.WriteHeader(nethttp.StatusOK)
+	}
+
+	err := r.pvzs.Stream(r.ctx, r.authUser, r.filter, func(item domain.PVZReceptionsProducts) error {
+		if err := r.ctx.Err(); err != nil {
+			return err
+		}
+		if !started {
+			start()
+		}
+		if err := encoder.Encode(toPVZReceptions(item)); err != nil {
+			return err
+		}
+
+		return controller.Flush()
+	})
+
+	if err == domain.ErrNotAuthorized {
+		return oapi.GetPvzStream403JSONResponse{
+			Message: "Доступ запрещен",
+		}.VisitGetPvzStreamResponse(w)
+	}
+
+	if err != nil && !started {
+		return oapi.GetPvzStream400JSONResponse{
+			Message: "Неверный запрос",
+		}.VisitGetPvzStreamResponse(w)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !started {
+		start()
+	}
+
+	return nil
+}
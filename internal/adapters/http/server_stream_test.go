@@ -0,0 +1,266 @@
+package http_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/adapters/http"
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	oapi "avito_pvz/internal/generated/oapi"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_GetPvzStream(t *testing.T) {
+	t.Parallel()
+
+	items := []domain.PVZReceptionsProducts{
+		{
+			PVZ: domain.PVZ{ID: uuid.New(), City: domain.Msk},
+			Receptions: []domain.ReceptionsProducts{{
+				Reception: domain.Reception{ID: uuid.New(), Status: domain.InProgress},
+				Products:  []domain.Product{{ID: uuid.New(), Type: domain.Clothes}},
+			}},
+		},
+		{PVZ: domain.PVZ{ID: uuid.New(), City: domain.Kzn}},
+	}
+	streamItems := func(
+		_ context.Context,
+		_ domain.Connection,
+		_ domain.PVZFilter,
+		each func(domain.PVZReceptionsProducts) error,
+	) error {
+		for _, item := range items {
+			if err := each(item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	tests := []struct {
+		name         string
+		ctx          func(*testing.T) context.Context
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, *httptest.ResponseRecorder, error)
+	}{
+		{
+			name: "Line per PVZ",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Stream(mock.Anything, mock.Anything, domain.PVZFilter{Sort: domain.SortByRegisteredAt}, mock.Anything).
+					RunAndReturn(streamItems)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 200, recorder.Code)
+				assert.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+				assert.True(t, recorder.Flushed)
+
+				var lines []oapi.PVZReceptions
+				scanner := bufio.NewScanner(recorder.Body)
+				for scanner.Scan() {
+					var line oapi.PVZReceptions
+					require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+					lines = append(lines, line)
+				}
+				require.Len(t, lines, 2)
+				assert.Equal(t, items[0].PVZ.ID, *lines[0].Pvz.Id)
+				require.Len(t, lines[0].Receptions, 1)
+				assert.Len(t, lines[0].Receptions[0].Products, 1)
+				assert.Equal(t, items[1].PVZ.ID, *lines[1].Pvz.Id)
+				assert.Empty(t, lines[1].Receptions)
+			},
+		},
+		{
+			name: "Empty stream",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil)
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 200, recorder.Code)
+				assert.Empty(t, recorder.Body.String())
+			},
+		},
+		{
+			name: "Client disconnected",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithCancel(fixtureAuthCtx(t, domain.Employee))
+				cancel()
+
+				return ctx
+			},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, nil)
+					})
+				repo.EXPECT().
+					Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(
+						ctx context.Context,
+						c domain.Connection,
+						filter domain.PVZFilter,
+						each func(domain.PVZReceptionsProducts) error,
+					) error {
+						err := streamItems(ctx, c, filter, each)
+						assert.ErrorIs(t, err, context.Canceled)
+
+						return err
+					})
+			},
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, _ error) {
+				assert.NotContains(t, recorder.Body.String(), items[0].PVZ.ID.String())
+			},
+		},
+		{
+			name: "Not authorized",
+			ctx:  func(t *testing.T) context.Context { return t.Context() },
+			check: func(t *testing.T, recorder *httptest.ResponseRecorder, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 403, recorder.Code)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := mocks.NewMockConnectionProvider(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+			productRepo := mocks.NewMockProductsRepository(t)
+			receptionRepo := mocks.NewMockReceptionsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(conn, pvzRepo)
+			}
+
+			server := http.NewServer(
+				domain.NewPVZService(conn, pvzRepo, productRepo, receptionRepo, metrics),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			ctx := fixtureAuthCtx(t, domain.Employee)
+			if test.ctx != nil {
+				ctx = test.ctx(t)
+			}
+
+			response, err := server.GetPvzStream(ctx, oapi.GetPvzStreamRequestObject{})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			err = response.VisitGetPvzStreamResponse(recorder)
+			test.check(t, recorder, err)
+		})
+	}
+}
+
+// A client that disconnects mid-stream cancels the ctx of the read, which ends
+// the query instead of letting it run to the end of its result set.
+func TestServer_GetPvzStreamDisconnect(t *testing.T) {
+	t.Parallel()
+
+	item := domain.PVZReceptionsProducts{PVZ: domain.PVZ{ID: uuid.New(), City: domain.Msk}}
+	queryEnded := make(chan error, 1)
+
+	provider := mocks.NewMockConnectionProvider(t)
+	provider.EXPECT().
+		ExecuteReadOnly(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+			return f(ctx, nil)
+		})
+	pvzRepo := mocks.NewMockPVZsRepository(t)
+	pvzRepo.EXPECT().
+		Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(
+			ctx context.Context,
+			_ domain.Connection,
+			_ domain.PVZFilter,
+			each func(domain.PVZReceptionsProducts) error,
+		) error {
+			var err error
+			for err == nil {
+				err = each(item)
+			}
+
+			select {
+			case <-ctx.Done():
+				queryEnded <- ctx.Err()
+			case <-time.After(5 * time.Second):
+				queryEnded <- nil
+			}
+
+			return err
+		})
+
+	server := http.NewServer(
+		domain.NewPVZService(
+			provider,
+			pvzRepo,
+			mocks.NewMockProductsRepository(t),
+			mocks.NewMockReceptionsRepository(t),
+			mocks.NewMockMetrics(t),
+		),
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	authUser, err := domain.AuthenticateByToken(uuid.NewString() + ":" + string(domain.Employee))
+	require.NoError(t, err)
+
+	handler := nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		ctx := context.WithValue(r.Context(), domain.CtxCurUserKey, authUser)
+		response, err := server.GetPvzStream(ctx, oapi.GetPvzStreamRequestObject{})
+		if err == nil {
+			_ = response.VisitGetPvzStreamResponse(w)
+		}
+	})
+	listener := httptest.NewServer(handler)
+	defer listener.Close()
+
+	ctx, disconnect := context.WithCancel(t.Context())
+	defer disconnect()
+	request, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodGet, listener.URL, nil)
+	require.NoError(t, err)
+	response, err := listener.Client().Do(request)
+	require.NoError(t, err)
+
+	line, err := bufio.NewReader(response.Body).ReadBytes('\n')
+	require.NoError(t, err)
+	assert.Contains(t, string(line), item.PVZ.ID.String())
+
+	disconnect()
+	_ = response.Body.Close()
+
+	require.ErrorIs(t, <-queryEnded, context.Canceled)
+}
@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+var _ AnalyticsInterface = (*AnalyticsService)(nil)
+
+var (
+	errAnalytics                     = errors.New("analytics service error")
+	ErrAvitoServiceDailyInvalidRange = errors.Join(
+		errAnalytics,
+		errors.New("invalid date range"),
+	)
+	ErrAvitoServiceDailyFindPVZ = errors.Join(
+		errAnalytics,
+		errors.New("find pvz failed"),
+	)
+	ErrAvitoServiceDaily = errors.Join(
+		errAnalytics,
+		errors.New("daily stats failed"),
+	)
+	ErrAvitoServiceCitiesInvalidRange = errors.Join(
+		errAnalytics,
+		errors.New("invalid report range"),
+	)
+	ErrAvitoServiceCities = errors.Join(
+		errAnalytics,
+		errors.New("cities report failed"),
+	)
+)
+
+type AnalyticsService struct {
+	provider      ConnectionProvider
+	analyticsRepo AnalyticsRepository
+	pvzRepo       PVZsRepository
+}
+
+func NewAnalyticsService(
+	provider ConnectionProvider,
+	analyticsRepo AnalyticsRepository,
+	pvzRepo PVZsRepository,
+) *AnalyticsService {
+	return &AnalyticsService{
+		provider:      provider,
+		analyticsRepo: analyticsRepo,
+		pvzRepo:       pvzRepo,
+	}
+}
+
+// Daily returns one entry per day from from to to inclusive, for a single PVZ
+// or, when pvzID is nil, summed over all of them. Days without receptions or
+// products are returned with zero counters.
+func (s *AnalyticsService) Daily(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID *PVZID,
+	from, to time.Time,
+) ([]DailyStats, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return nil, ErrNotAuthorized
+	}
+
+	from, to = truncateDay(from), truncateDay(to)
+	if to.Before(from) || to.Sub(from) >= MaxAnalyticsDays*24*time.Hour {
+		return nil, ErrAvitoServiceDailyInvalidRange
+	}
+
+	var stats []DailyStats
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		if pvzID != nil {
+			if _, err := s.pvzRepo.FindByID(ctx, c, *pvzID); err != nil {
+				return errors.Join(ErrAvitoServiceDailyFindPVZ, err)
+			}
+		}
+
+		var err error
+		stats, err = s.analyticsRepo.Daily(ctx, c, pvzID, from, to)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceDaily, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fillDays(stats, from, to), nil
+}
+
+// Cities reports every city and the whole fleet for the days from from to to
+// inclusive, each compared with as many days right before them. The earlier
+// window is moved back by whole weeks, one for a window of up to a week, so
+// that weekdays are compared with weekdays.
+func (s *AnalyticsService) Cities(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	from, to time.Time,
+) (CitiesReport, error) {
+	if authUser == nil || authUser.GetUserRole() != Moderator {
+		return CitiesReport{}, ErrNotAuthorized
+	}
+
+	from, to = truncateDay(from), truncateDay(to)
+	if to.Before(from) || to.Sub(from) >= MaxAnalyticsDays*24*time.Hour {
+		return CitiesReport{}, ErrAvitoServiceCitiesInvalidRange
+	}
+
+	days := int(to.Sub(from)/(24*time.Hour)) + 1
+	weeks := (days + 6) / 7
+	report := CitiesReport{
+		From:         from,
+		To:           to,
+		PreviousFrom: from.AddDate(0, 0, -7*weeks),
+		PreviousTo:   to.AddDate(0, 0, -7*weeks),
+	}
+
+	var current, previous []CityStats
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		current, err = s.analyticsRepo.Cities(ctx, c, from, to, BusiestPVZs)
+		if err != nil {
+			return err
+		}
+
+		previous, err = s.analyticsRepo.Cities(ctx, c, report.PreviousFrom, report.PreviousTo, BusiestPVZs)
+
+		return err
+	})
+	if err != nil {
+		return CitiesReport{}, errors.Join(ErrAvitoServiceCities, err)
+	}
+
+	byCity := make(map[PVZCity]*CityReport)
+	cityReport := func(city PVZCity) *CityReport {
+		if byCity[city] == nil {
+			byCity[city] = &CityReport{
+				City:         city,
+				Current:      emptyCityStats(city),
+				PreviousWeek: emptyCityStats(city),
+			}
+		}
+
+		return byCity[city]
+	}
+	for _, stats := range current {
+		cityReport(stats.City).Current = withPerDay(stats, days)
+	}
+	for _, stats := range previous {
+		cityReport(stats.City).PreviousWeek = withPerDay(stats, days)
+	}
+
+	report.Cities = make([]CityReport, 0, len(byCity))
+	for _, city := range byCity {
+		report.Cities = append(report.Cities, *city)
+	}
+	slices.SortFunc(report.Cities, func(a, b CityReport) int {
+		return strings.Compare(string(a.City), string(b.City))
+	})
+
+	report.Fleet = CityReport{
+		Current:      withPerDay(fleetStats(current), days),
+		PreviousWeek: withPerDay(fleetStats(previous), days),
+	}
+
+	return report, nil
+}
+
+func emptyCityStats(city PVZCity) CityStats {
+	return CityStats{City: city, ProductsByType: map[ProductType]int{}, Busiest: []BusyPVZ{}}
+}
+
+func withPerDay(stats CityStats, days int) CityStats {
+	stats.ReceptionsPerDay = float64(stats.Receptions) / float64(days)
+
+	return stats
+}
+
+// fleetStats sums the cities. The busiest PVZs of the fleet are among the
+// busiest of their cities, so ranking the union of the city lists is enough.
+func fleetStats(cities []CityStats) CityStats {
+	fleet := emptyCityStats("")
+	for _, city := range cities {
+		fleet.PVZs += city.PVZs
+		fleet.Receptions += city.Receptions
+		for productType, count := range city.ProductsByType {
+			fleet.ProductsByType[productType] += count
+		}
+		fleet.Busiest = append(fleet.Busiest, city.Busiest...)
+	}
+
+	slices.SortFunc(fleet.Busiest, func(a, b BusyPVZ) int {
+		if a.Products != b.Products {
+			return b.Products - a.Products
+		}
+		if a.Receptions != b.Receptions {
+			return b.Receptions - a.Receptions
+		}
+
+		return bytes.Compare(a.PVZID[:], b.PVZID[:])
+	})
+	if len(fleet.Busiest) > BusiestPVZs {
+		fleet.Busiest = fleet.Busiest[:BusiestPVZs]
+	}
+
+	return fleet
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// fillDays spreads stats over every day of the range, keeping the repository
+// rows and adding empty ones for days without activity.
+func fillDays(stats []DailyStats, from, to time.Time) []DailyStats {
+	byDay := make(map[time.Time]DailyStats, len(stats))
+	for _, day := range stats {
+		byDay[truncateDay(day.Day)] = day
+	}
+
+	result := make([]DailyStats, 0, int(to.Sub(from)/(24*time.Hour))+1)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		stat, ok := byDay[day]
+		if !ok {
+			stat = DailyStats{ProductsByType: map[ProductType]int{}}
+		}
+		if stat.ProductsByType == nil {
+			stat.ProductsByType = map[ProductType]int{}
+		}
+		stat.Day = day
+
+		result = append(result, stat)
+	}
+
+	return result
+}
@@ -0,0 +1,359 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAnalytics_Daily(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	from := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.April, 3, 0, 0, 0, 0, time.UTC)
+	stats := domain.DailyStats{
+		Day:                  time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+		Receptions:           2,
+		ClosedReceptions:     1,
+		AvgReceptionDuration: time.Hour,
+		ProductsByType:       map[domain.ProductType]int{domain.Shoes: 3},
+	}
+
+	readOnly := func(provider *mocks.MockConnectionProvider) {
+		provider.EXPECT().
+			ExecuteReadOnly(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+				return f(ctx, &mocks.MockConnection{})
+			}).
+			Once()
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		pvzID        *domain.PVZID
+		from, to     time.Time
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockAnalyticsRepository, *mocks.MockPVZsRepository)
+		check        func(*testing.T, []domain.DailyStats, error)
+	}{
+		{
+			name:     "Fleet fills empty days",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       to,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				analyticsRepo *mocks.MockAnalyticsRepository,
+				_ *mocks.MockPVZsRepository,
+			) {
+				readOnly(provider)
+				analyticsRepo.EXPECT().Daily(mock.Anything, mock.Anything, (*domain.PVZID)(nil), from, to).
+					Return([]domain.DailyStats{stats}, nil).Once()
+			},
+			check: func(t *testing.T, days []domain.DailyStats, err error) {
+				require.NoError(t, err)
+				require.Len(t, days, 3)
+				require.True(t, from.Equal(days[0].Day))
+				require.Zero(t, days[0].Receptions)
+				require.NotNil(t, days[0].ProductsByType)
+				require.Equal(t, stats, days[1])
+				require.True(t, to.Equal(days[2].Day))
+			},
+		},
+		{
+			name:     "Single PVZ",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    &pvzID,
+			from:     from,
+			to:       from,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				analyticsRepo *mocks.MockAnalyticsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				readOnly(provider)
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{ID: pvzID}, nil).Once()
+				analyticsRepo.EXPECT().Daily(mock.Anything, mock.Anything, &pvzID, from, from).
+					Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, days []domain.DailyStats, err error) {
+				require.NoError(t, err)
+				require.Len(t, days, 1)
+			},
+		},
+		{
+			name:     "PVZ not found",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    &pvzID,
+			from:     from,
+			to:       to,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockAnalyticsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				readOnly(provider)
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{}, domain.ErrPVZNotFound).Once()
+			},
+			check: func(t *testing.T, _ []domain.DailyStats, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceDailyFindPVZ)
+				require.ErrorIs(t, err, domain.ErrPVZNotFound)
+			},
+		},
+		{
+			name:     "DB Error",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       to,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				analyticsRepo *mocks.MockAnalyticsRepository,
+				_ *mocks.MockPVZsRepository,
+			) {
+				readOnly(provider)
+				analyticsRepo.EXPECT().Daily(mock.Anything, mock.Anything, (*domain.PVZID)(nil), from, to).
+					Return(nil, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ []domain.DailyStats, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceDaily)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "To before from",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     to,
+			to:       from,
+			check: func(t *testing.T, _ []domain.DailyStats, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceDailyInvalidRange)
+			},
+		},
+		{
+			name:     "Range too long",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       from.AddDate(0, 0, domain.MaxAnalyticsDays),
+			check: func(t *testing.T, _ []domain.DailyStats, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceDailyInvalidRange)
+			},
+		},
+		{
+			name: "Not authorized",
+			from: from,
+			to:   to,
+			check: func(t *testing.T, _ []domain.DailyStats, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+			pvzRepo := mocks.NewMockPVZsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, analyticsRepo, pvzRepo)
+			}
+
+			days, err := domain.NewAnalyticsService(provider, analyticsRepo, pvzRepo).
+				Daily(t.Context(), test.authUser, test.pvzID, test.from, test.to)
+
+			test.check(t, days, err)
+		})
+	}
+}
+
+func TestServiceAnalytics_Cities(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, time.April, 7, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.April, 13, 0, 0, 0, 0, time.UTC)
+	pvzMsk1, pvzMsk2, pvzKzn := uuid.New(), uuid.New(), uuid.New()
+
+	current := []domain.CityStats{
+		{
+			City:           domain.Kzn,
+			PVZs:           1,
+			Receptions:     7,
+			ProductsByType: map[domain.ProductType]int{domain.Shoes: 5},
+			Busiest:        []domain.BusyPVZ{{PVZID: pvzKzn, Receptions: 7, Products: 5}},
+		},
+		{
+			City:           domain.Msk,
+			PVZs:           2,
+			Receptions:     14,
+			ProductsByType: map[domain.ProductType]int{domain.Shoes: 1, domain.Clothes: 9},
+			Busiest: []domain.BusyPVZ{
+				{PVZID: pvzMsk1, Receptions: 10, Products: 9},
+				{PVZID: pvzMsk2, Receptions: 4, Products: 1},
+			},
+		},
+	}
+	previous := []domain.CityStats{
+		{
+			City:           domain.Msk,
+			PVZs:           1,
+			Receptions:     7,
+			ProductsByType: map[domain.ProductType]int{domain.Clothes: 2},
+			Busiest:        []domain.BusyPVZ{{PVZID: pvzMsk1, Receptions: 7, Products: 2}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		from, to     time.Time
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockAnalyticsRepository)
+		check        func(*testing.T, domain.CitiesReport, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       to,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, analyticsRepo *mocks.MockAnalyticsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				analyticsRepo.EXPECT().Cities(mock.Anything, mock.Anything, from, to, domain.BusiestPVZs).
+					Return(current, nil).Once()
+				analyticsRepo.EXPECT().
+					Cities(mock.Anything, mock.Anything, from.AddDate(0, 0, -7), to.AddDate(0, 0, -7), domain.BusiestPVZs).
+					Return(previous, nil).Once()
+			},
+			check: func(t *testing.T, report domain.CitiesReport, err error) {
+				require.NoError(t, err)
+				require.Len(t, report.Cities, 2)
+
+				kzn := report.Cities[0]
+				require.Equal(t, domain.Kzn, kzn.City)
+				require.InDelta(t, 1.0, kzn.Current.ReceptionsPerDay, 0.001)
+				require.Zero(t, kzn.PreviousWeek.Receptions)
+				require.NotNil(t, kzn.PreviousWeek.ProductsByType)
+
+				msk := report.Cities[1]
+				require.Equal(t, 14, msk.Current.Receptions)
+				require.Equal(t, 7, msk.PreviousWeek.Receptions)
+				require.InDelta(t, 1.0, msk.PreviousWeek.ReceptionsPerDay, 0.001)
+
+				require.Equal(t, 3, report.Fleet.Current.PVZs)
+				require.Equal(t, 21, report.Fleet.Current.Receptions)
+				require.Equal(t, from.AddDate(0, 0, -7), report.PreviousFrom)
+				require.Equal(t, to.AddDate(0, 0, -7), report.PreviousTo)
+				require.Equal(
+					t,
+					map[domain.ProductType]int{domain.Shoes: 6, domain.Clothes: 9},
+					report.Fleet.Current.ProductsByType,
+				)
+				require.Equal(
+					t,
+					[]domain.BusyPVZ{current[1].Busiest[0], current[0].Busiest[0], current[1].Busiest[1]},
+					report.Fleet.Current.Busiest,
+				)
+				require.Equal(t, 7, report.Fleet.PreviousWeek.Receptions)
+			},
+		},
+		{
+			name:     "DB Error",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       to,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, analyticsRepo *mocks.MockAnalyticsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				analyticsRepo.EXPECT().Cities(mock.Anything, mock.Anything, from, to, domain.BusiestPVZs).
+					Return(nil, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.CitiesReport, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCities)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "Month compared with whole weeks earlier",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       from.AddDate(0, 0, 29),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, analyticsRepo *mocks.MockAnalyticsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				analyticsRepo.EXPECT().
+					Cities(mock.Anything, mock.Anything, from, from.AddDate(0, 0, 29), domain.BusiestPVZs).
+					Return(current, nil).Once()
+				analyticsRepo.EXPECT().
+					Cities(mock.Anything, mock.Anything, from.AddDate(0, 0, -35), from.AddDate(0, 0, -6), domain.BusiestPVZs).
+					Return(previous, nil).Once()
+			},
+			check: func(t *testing.T, report domain.CitiesReport, err error) {
+				require.NoError(t, err)
+				require.Equal(t, from.AddDate(0, 0, -35), report.PreviousFrom)
+				require.Equal(t, from.AddDate(0, 0, -6), report.PreviousTo)
+				require.Equal(t, report.From.Weekday(), report.PreviousFrom.Weekday())
+				require.InDelta(t, 14.0/30, report.Cities[1].Current.ReceptionsPerDay, 0.001)
+				require.InDelta(t, 7.0/30, report.Cities[1].PreviousWeek.ReceptionsPerDay, 0.001)
+			},
+		},
+		{
+			name:     "Longer than a year",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			from:     from,
+			to:       from.AddDate(0, 0, domain.MaxAnalyticsDays),
+			check: func(t *testing.T, _ domain.CitiesReport, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCitiesInvalidRange)
+			},
+		},
+		{
+			name:     "Employee",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			from:     from,
+			to:       to,
+			check: func(t *testing.T, _ domain.CitiesReport, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			analyticsRepo := mocks.NewMockAnalyticsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, analyticsRepo)
+			}
+
+			report, err := domain.NewAnalyticsService(provider, analyticsRepo, mocks.NewMockPVZsRepository(t)).
+				Cities(t.Context(), test.authUser, test.from, test.to)
+
+			test.check(t, report, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at the last row of a page ordered by (time, id). Clients get
+// it as an opaque string and pass it back to read the next page.
+type Cursor struct {
+	At time.Time
+	ID uuid.UUID
+}
+
+func (c Cursor) Encode() string {
+	raw := c.At.UTC().Format(time.RFC3339Nano) + "," + c.ID.String()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeCursor(cursor string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return Cursor{}, errors.Join(ErrInvalidCursor, err)
+	}
+
+	at, id, ok := strings.Cut(string(raw), ",")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var result Cursor
+	if result.At, err = time.Parse(time.RFC3339Nano, at); err != nil {
+		return Cursor{}, errors.Join(ErrInvalidCursor, err)
+	}
+	if result.ID, err = uuid.Parse(id); err != nil {
+		return Cursor{}, errors.Join(ErrInvalidCursor, err)
+	}
+
+	return result, nil
+}
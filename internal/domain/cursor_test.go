@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"avito_pvz/internal/domain"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	cursor := domain.Cursor{
+		At: time.Date(2025, time.April, 10, 6, 30, 0, 123456000, time.UTC),
+		ID: uuid.New(),
+	}
+
+	decoded, err := domain.DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	require.True(t, cursor.At.Equal(decoded.At))
+	require.Equal(t, cursor.ID, decoded.ID)
+}
+
+func TestCursor_DecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "Not base64", cursor: "!!!"},
+		{name: "No separator", cursor: "MjAyNS0wNC0xMFQwNjozMDowMFo"},
+		{name: "Bad time", cursor: "eWVzdGVyZGF5LDAxOTVmM2EyLTdjMWUtN2QzYS05YjFlLTJmNmMzYTFiNGQ1ZQ"},
+		{name: "Bad id", cursor: "MjAyNS0wNC0xMFQwNjozMDowMFosbm90LWEtdXVpZA"},
+		{name: "Empty", cursor: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := domain.DecodeCursor(test.cursor)
+			require.ErrorIs(t, err, domain.ErrInvalidCursor)
+		})
+	}
+}
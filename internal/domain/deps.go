@@ -11,6 +11,33 @@ var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrPVZNotFound       = errors.New("PVZ not found")
 	ErrReceptionNotFound = errors.New("reception not found")
+	ErrProductNotFound   = errors.New("product not found")
+	// ErrProductDuplicateBarcode is returned when the reception already has a
+	// product with the same barcode.
+	ErrProductDuplicateBarcode = errors.New("product barcode already in reception")
+	// ErrProductTypeNotFound is returned for a type missing from the catalogue.
+	ErrProductTypeNotFound = errors.New("product type not found")
+	// ErrProductTypeExists is returned when the catalogue already has the code.
+	ErrProductTypeExists = errors.New("product type already exists")
+	// ErrProductInvalidTransition is returned when the product status does not
+	// allow the requested change.
+	ErrProductInvalidTransition = errors.New("invalid product status transition")
+	// ErrOrderNotFound is returned when the PVZ has no waiting order with the
+	// pickup code.
+	ErrOrderNotFound = errors.New("order not found")
+	// ErrPickupCodeTaken is returned when a waiting order of the PVZ already
+	// has the pickup code.
+	ErrPickupCodeTaken = errors.New("pickup code already taken")
+	// ErrProductInOrder is returned when a product already belongs to an order.
+	ErrProductInOrder = errors.New("product already in order")
+	// ErrStorageCellOccupied is returned when a cell holding a product is
+	// removed from the storage layout.
+	ErrStorageCellOccupied = errors.New("storage cell is occupied")
+	// ErrReceptionInProgress is returned when a PVZ already has an open reception.
+	ErrReceptionInProgress = errors.New("reception already in progress")
+	// ErrReceptionNotReopenable is returned when a reception is not closed or
+	// its PVZ has a newer reception.
+	ErrReceptionNotReopenable = errors.New("reception cannot be reopened")
 )
 
 type (
@@ -18,11 +45,18 @@ type (
 		GetContext(context.Context, any, string, ...any) error
 		SelectContext(context.Context, any, string, ...any) error
 		ExecContext(context.Context, string, ...any) (int64, error)
+		// EachContext scans rows one by one into dest and calls each after
+		// every row, so large results are never held in memory at once.
+		EachContext(ctx context.Context, dest any, each func() error, query string, args ...any) error
+		// ExecBatch runs query once per element of args, sending all of them
+		// to the database in a single round trip.
+		ExecBatch(ctx context.Context, query string, args [][]any) error
 	}
 
 	ConnectionProvider interface {
 		Execute(context.Context, func(context.Context, Connection) error) error
 		ExecuteTx(context.Context, func(context.Context, Connection) error) error
+		ExecuteReadOnly(context.Context, func(context.Context, Connection) error) error
 		io.Closer
 	}
 )
@@ -37,26 +71,158 @@ type (
 
 	PVZsRepository interface {
 		Create(context.Context, Connection, PVZ) error
+		FindByID(context.Context, Connection, PVZID) (PVZ, error)
 		FindByIDs(context.Context, Connection, []PVZID) ([]PVZ, error)
 		FindAll(context.Context, Connection) ([]PVZ, error)
+		SearchReceptionsProducts(
+			ctx context.Context,
+			connection Connection,
+			filter PVZFilter,
+			page, limit int,
+			after *Cursor,
+		) ([]PVZReceptionsProducts, error)
+		Count(ctx context.Context, connection Connection, filter PVZFilter) (int, error)
+		FindDetails(context.Context, Connection, PVZID) (PVZDetails, error)
+		Export(ctx context.Context, connection Connection, filter PVZFilter, each func(ExportRow) error) error
+		Stream(
+			ctx context.Context,
+			connection Connection,
+			filter PVZFilter,
+			each func(PVZReceptionsProducts) error,
+		) error
 	}
 
 	ReceptionsRepository interface {
 		Create(context.Context, Connection, Reception) error
 		FindActive(context.Context, Connection, PVZID) (Reception, error)
 		FindByIDs(context.Context, Connection, []ReceptionID) ([]Reception, error)
-		Close(context.Context, Connection, ReceptionID) error
+		Close(ctx context.Context, connection Connection, receptionID ReceptionID, closedBy UserID) error
+		FindByPVZ(
+			ctx context.Context,
+			connection Connection,
+			pvzID PVZID,
+			filter ReceptionFilter,
+			page, limit int,
+		) ([]ReceptionSummary, error)
+		CountByPVZ(ctx context.Context, connection Connection, pvzID PVZID, filter ReceptionFilter) (int, error)
+		// CloseStale closes the in-progress receptions whose last product (or
+		// opening, when there are none) is older than idleSince and returns
+		// them. Receptions locked by another transaction are skipped.
+		CloseStale(ctx context.Context, connection Connection, idleSince time.Time) ([]Reception, error)
+		// Reopen puts the closed reception back in progress and records the
+		// reopening. It fails with ErrReceptionNotReopenable when the reception
+		// is not closed or its PVZ has a newer reception.
+		Reopen(context.Context, Connection, ReceptionReopening) error
+		FindDetails(context.Context, Connection, ReceptionID) (ReceptionDetails, error)
+		SaveManifest(context.Context, Connection, ReceptionID, ReceptionManifest) error
+		// FindManifest returns an empty manifest for a reception opened without one.
+		FindManifest(context.Context, Connection, ReceptionID) (ReceptionManifest, error)
+		// SaveDiscrepancy replaces the discrepancy report kept for the reception.
+		SaveDiscrepancy(context.Context, Connection, ReceptionID, DiscrepancyReport) error
 	}
 
 	ProductsRepository interface {
 		Create(context.Context, Connection, Product) error
-		DeleteLast(context.Context, Connection, ReceptionID) error
-		Search(
+		// CreateBatch inserts the products in a single round trip.
+		CreateBatch(context.Context, Connection, []Product) error
+		// DeleteLast removes the newest product of the reception and keeps it
+		// among the deleted products with deletedBy. It fails with ErrProductInOrder
+		// for a product in an order and with ErrProductInvalidTransition for one
+		// already past received.
+		DeleteLast(
+			ctx context.Context,
+			connection Connection,
+			receptionID ReceptionID,
+			deletedBy UserID,
+		) (Product, error)
+		FindByIDs(context.Context, Connection, []ProductID) ([]Product, error)
+		FindByReceptionIDs(context.Context, Connection, []ReceptionID) ([]Product, error)
+		// ChangeStatus moves the product from transition.From to transition.To
+		// and records the transition. It fails with ErrProductInvalidTransition
+		// when the product is no longer in transition.From.
+		ChangeStatus(context.Context, Connection, ProductTransition) (Product, error)
+		// StoreReceived moves the received products of the receptions to
+		// storage, the ones inspected as rejected are returned instead, and
+		// records the transitions made by storedBy.
+		StoreReceived(
+			ctx context.Context,
+			connection Connection,
+			receptionIDs []ReceptionID,
+			storedBy *UserID,
+		) error
+		// FindInProgressByBarcodes returns the products with any of the
+		// barcodes that belong to receptions in progress.
+		FindInProgressByBarcodes(context.Context, Connection, []string) ([]Product, error)
+		// FindByBarcode returns the products with the barcode across all PVZs,
+		// newest first.
+		FindByBarcode(context.Context, Connection, string) ([]ProductLocation, error)
+	}
+)
+
+type (
+	OrdersRepository interface {
+		// Create saves the order with its products. It fails with
+		// ErrPickupCodeTaken when a waiting order of the PVZ has the same code
+		// and with ErrProductInOrder when a product already has an order.
+		Create(context.Context, Connection, Order, []ProductID) error
+		// FindWaiting returns the order of the PVZ with the pickup code that
+		// is not picked up yet and locks it until the transaction ends.
+		FindWaiting(ctx context.Context, connection Connection, pvzID PVZID, code string) (Order, error)
+		// FindWaitingByProduct returns the order holding the product that is
+		// not picked up yet and locks it until the transaction ends. It fails
+		// with ErrOrderNotFound when there is no such order.
+		FindWaitingByProduct(context.Context, Connection, ProductID) (Order, error)
+		FindProducts(context.Context, Connection, OrderID) ([]Product, error)
+		// MarkIssued records the pickup. It fails with ErrOrderNotFound when
+		// the order was picked up already.
+		MarkIssued(ctx context.Context, connection Connection, orderID OrderID, issuedBy UserID) error
+	}
+
+	StorageCellsRepository interface {
+		// FindLayout returns the layout of the PVZ, a PVZ without one gets
+		// fill-first allocation and no cells.
+		FindLayout(context.Context, Connection, PVZID) (StorageLayout, error)
+		// SaveLayout replaces the layout of the PVZ. Cells are matched by
+		// code, so the products stay in the cells kept. It fails with
+		// ErrStorageCellOccupied when a removed cell holds a product.
+		SaveLayout(context.Context, Connection, StorageLayout) error
+		// Allocate puts the product into a free cell of the PVZ picked by the
+		// allocation of its layout and returns the cell, or nil when no cell
+		// is free.
+		Allocate(
 			ctx context.Context,
 			connection Connection,
-			from, to *time.Time,
-			page, limit *int,
-		) ([]Product, error)
+			pvzID PVZID,
+			product Product,
+		) (*StorageCell, error)
+		// Free empties the cells holding the products.
+		Free(context.Context, Connection, []ProductID) error
+	}
+
+	ProductTypesRepository interface {
+		FindAll(ctx context.Context, connection Connection, includeInactive bool) ([]ProductTypeEntry, error)
+		// FindByCodes returns the entries of the codes found in the catalogue,
+		// active or not.
+		FindByCodes(context.Context, Connection, []ProductType) ([]ProductTypeEntry, error)
+		Create(context.Context, Connection, ProductTypeEntry) error
+		// Update replaces the names and the active flag of the entry.
+		Update(context.Context, Connection, ProductTypeEntry) error
+	}
+
+	// AnalyticsRepository keeps the daily rollups up to date and reads reports.
+	// Its Add/Close/Remove methods must run in the transaction that changed the
+	// reception or product.
+	AnalyticsRepository interface {
+		AddReception(context.Context, Connection, ReceptionID) error
+		CloseReception(context.Context, Connection, ReceptionID) error
+		// ReopenReception takes back the close counted by CloseReception, it
+		// must run while the reception is still closed.
+		ReopenReception(context.Context, Connection, ReceptionID) error
+		AddProduct(context.Context, Connection, ProductID) error
+		AddProducts(context.Context, Connection, []ProductID) error
+		RemoveProduct(context.Context, Connection, Product) error
+		Daily(ctx context.Context, connection Connection, pvzID *PVZID, from, to time.Time) ([]DailyStats, error)
+		Cities(ctx context.Context, connection Connection, from, to time.Time, busiest int) ([]CityStats, error)
 	}
 )
 
@@ -66,5 +232,6 @@ type (
 		IncReceptions()
 		IncProducts()
 		IncUsers()
+		IncStaleReceptionsClosed()
 	}
 )
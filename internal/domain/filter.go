@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	errFilter                       = errors.New("pvz filter error")
+	ErrFilterInvalidCity            = errors.Join(errFilter, errors.New("invalid city"))
+	ErrFilterInvalidStatus          = errors.Join(errFilter, errors.New("invalid pvz status"))
+	ErrFilterInvalidProductType     = errors.Join(errFilter, errors.New("invalid product type"))
+	ErrFilterInvalidProductStatus   = errors.Join(errFilter, errors.New("invalid product status"))
+	ErrFilterInvalidReceptionStatus = errors.Join(errFilter, errors.New("invalid reception status"))
+	ErrFilterInvalidSort            = errors.Join(errFilter, errors.New("invalid sort"))
+)
+
+func (f PVZFilter) WithDefaults() PVZFilter {
+	if f.Sort == "" {
+		f.Sort = SortByRegisteredAt
+	}
+
+	return f
+}
+
+func (f PVZFilter) Validate() error {
+	for _, city := range f.Cities {
+		if !slices.Contains([]PVZCity{Msk, SPb, Kzn}, city) {
+			return errors.Join(ErrFilterInvalidCity, errors.New(string(city)))
+		}
+	}
+	if f.Status != nil && *f.Status != PVZOpen && *f.Status != PVZClosed {
+		return errors.Join(ErrFilterInvalidStatus, errors.New(string(*f.Status)))
+	}
+	for _, productType := range f.ProductTypes {
+		// Types missing from the catalogue match no products.
+		if productType == "" {
+			return ErrFilterInvalidProductType
+		}
+	}
+	for _, productStatus := range f.ProductStatuses {
+		if !productStatus.Valid() {
+			return errors.Join(ErrFilterInvalidProductStatus, errors.New(string(productStatus)))
+		}
+	}
+	if f.ReceptionStatus != nil && *f.ReceptionStatus != InProgress && *f.ReceptionStatus != Close {
+		return errors.Join(ErrFilterInvalidReceptionStatus, errors.New(string(*f.ReceptionStatus)))
+	}
+	if !slices.Contains([]PVZSort{SortByRegisteredAt, SortByLastReceptionAt, SortByProductCount}, f.Sort) {
+		return errors.Join(ErrFilterInvalidSort, errors.New(string(f.Sort)))
+	}
+
+	return nil
+}
+
+func (f ReceptionFilter) Validate() error {
+	if f.Status != nil && *f.Status != InProgress && *f.Status != Close {
+		return errors.Join(ErrFilterInvalidReceptionStatus, errors.New(string(*f.Status)))
+	}
+
+	return nil
+}
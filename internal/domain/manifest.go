@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+var (
+	errManifest                   = errors.New("reception manifest error")
+	ErrManifestInvalidProductType = errors.Join(errManifest, errors.New("invalid product type"))
+	ErrManifestDuplicateType      = errors.Join(errManifest, errors.New("duplicate product type"))
+	ErrManifestInvalidCount       = errors.Join(errManifest, errors.New("invalid count"))
+)
+
+func (m ReceptionManifest) Validate() error {
+	seen := make(map[ProductType]bool, len(m))
+	for _, item := range m {
+		if item.Type == "" {
+			return ErrManifestInvalidProductType
+		}
+		if seen[item.Type] {
+			return errors.Join(ErrManifestDuplicateType, errors.New(string(item.Type)))
+		}
+		seen[item.Type] = true
+		if item.Count <= 0 {
+			return errors.Join(ErrManifestInvalidCount, errors.New(strconv.Itoa(item.Count)))
+		}
+	}
+
+	return nil
+}
+
+// Types lists the product types of the manifest in its order.
+func (m ReceptionManifest) Types() []ProductType {
+	productTypes := make([]ProductType, 0, len(m))
+	for _, item := range m {
+		productTypes = append(productTypes, item.Type)
+	}
+
+	return productTypes
+}
+
+// Compare counts the received products by type against the manifest.
+func (m ReceptionManifest) Compare(products []Product) DiscrepancyReport {
+	balance := make(map[ProductType]int, len(m))
+	for _, item := range m {
+		balance[item.Type] += item.Count
+	}
+	for _, product := range products {
+		balance[product.Type]--
+	}
+
+	report := DiscrepancyReport{Missing: []ManifestItem{}, Extra: []ManifestItem{}}
+	for _, productType := range slices.Sorted(maps.Keys(balance)) {
+		switch count := balance[productType]; {
+		case count > 0:
+			report.Missing = append(report.Missing, ManifestItem{Type: productType, Count: count})
+		case count < 0:
+			report.Extra = append(report.Extra, ManifestItem{Type: productType, Count: -count})
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"testing"
+
+	"avito_pvz/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionManifest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		manifest domain.ReceptionManifest
+		err      error
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name: "Valid",
+			manifest: domain.ReceptionManifest{
+				{Type: domain.Shoes, Count: 3},
+				{Type: domain.Electronics, Count: 1},
+			},
+		},
+		{
+			name:     "Empty type",
+			manifest: domain.ReceptionManifest{{Type: "", Count: 1}},
+			err:      domain.ErrManifestInvalidProductType,
+		},
+		{
+			name:     "Duplicate type",
+			manifest: domain.ReceptionManifest{{Type: domain.Shoes, Count: 1}, {Type: domain.Shoes, Count: 2}},
+			err:      domain.ErrManifestDuplicateType,
+		},
+		{
+			name:     "Zero count",
+			manifest: domain.ReceptionManifest{{Type: domain.Clothes}},
+			err:      domain.ErrManifestInvalidCount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.manifest.Validate()
+			if test.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestReceptionManifest_Compare(t *testing.T) {
+	t.Parallel()
+
+	manifest := domain.ReceptionManifest{
+		{Type: domain.Shoes, Count: 2},
+		{Type: domain.Electronics, Count: 1},
+	}
+
+	tests := []struct {
+		name     string
+		products []domain.Product
+		report   domain.DiscrepancyReport
+	}{
+		{
+			name:     "Match",
+			products: []domain.Product{{Type: domain.Shoes}, {Type: domain.Electronics}, {Type: domain.Shoes}},
+			report:   domain.DiscrepancyReport{Missing: []domain.ManifestItem{}, Extra: []domain.ManifestItem{}},
+		},
+		{
+			name: "Nothing received",
+			report: domain.DiscrepancyReport{
+				Missing: []domain.ManifestItem{{Type: domain.Shoes, Count: 2}, {Type: domain.Electronics, Count: 1}},
+				Extra:   []domain.ManifestItem{},
+			},
+		},
+		{
+			name: "Missing and extra",
+			products: []domain.Product{
+				{Type: domain.Shoes},
+				{Type: domain.Electronics},
+				{Type: domain.Electronics},
+				{Type: domain.Clothes},
+			},
+			report: domain.DiscrepancyReport{
+				Missing: []domain.ManifestItem{{Type: domain.Shoes, Count: 1}},
+				Extra:   []domain.ManifestItem{{Type: domain.Clothes, Count: 1}, {Type: domain.Electronics, Count: 1}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.report, manifest.Compare(test.products))
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// PickupCodeLength is the number of digits of a pickup code.
+	PickupCodeLength = 6
+	// pickupCodeAttempts bounds how many codes are generated for an order
+	// before giving up on finding one not taken at the PVZ.
+	pickupCodeAttempts = 5
+)
+
+var (
+	errOrder                         = errors.New("orders service error")
+	ErrAvitoServiceOrderInvalidPVZID = errors.Join(errOrder, errors.New("invalid pvz id"))
+	errAvitoServiceCreateOrder       = errors.Join(
+		errOrder,
+		errors.New("create order failed"),
+	)
+	ErrAvitoServiceCreateOrderInvalidProducts = errors.Join(
+		errAvitoServiceCreateOrder,
+		errors.New("invalid products"),
+	)
+	ErrAvitoServiceCreateOrderFindProducts = errors.Join(
+		errAvitoServiceCreateOrder,
+		errors.New("find products failed"),
+	)
+	// ErrAvitoServiceCreateOrderUnavailableProduct is returned for products
+	// issued or returned already.
+	ErrAvitoServiceCreateOrderUnavailableProduct = errors.Join(
+		errAvitoServiceCreateOrder,
+		errors.New("product has left the pvz"),
+	)
+	ErrAvitoServiceCreateOrder = errors.Join(
+		errAvitoServiceCreateOrder,
+		errors.New("create order failed"),
+	)
+	errAvitoServicePickup = errors.Join(
+		errOrder,
+		errors.New("pickup failed"),
+	)
+	ErrAvitoServicePickupInvalidCode = errors.Join(
+		errAvitoServicePickup,
+		errors.New("invalid pickup code"),
+	)
+	ErrAvitoServicePickupFind = errors.Join(
+		errAvitoServicePickup,
+		errors.New("find order failed"),
+	)
+	// ErrAvitoServicePickupNotStored is returned when some products of the
+	// order are not in storage: their reception is still open or they were
+	// returned to the sender.
+	ErrAvitoServicePickupNotStored = errors.Join(
+		errAvitoServicePickup,
+		errors.New("products not in storage"),
+	)
+	ErrAvitoServicePickup = errors.Join(
+		errAvitoServicePickup,
+		errors.New("pickup failed"),
+	)
+)
+
+// NewPickupCode generates a random numeric pickup code.
+func NewPickupCode() (string, error) {
+	limit := big.NewInt(1)
+	for range PickupCodeLength {
+		limit.Mul(limit, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	code := n.String()
+
+	return strings.Repeat("0", PickupCodeLength-len(code)) + code, nil
+}
+
+// validPickupCode tells whether the code has the form NewPickupCode gives.
+func validPickupCode(code string) bool {
+	if len(code) != PickupCodeLength {
+		return false
+	}
+	for _, digit := range code {
+		if digit < '0' || digit > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CreateOrder groups products received at the PVZ into a customer order and
+// gives it a pickup code. Products still in an open reception can be ordered,
+// the order is picked up once all of them are stored.
+func (s *ReceptionService) CreateOrder(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID PVZID,
+	productIDs []ProductID,
+) (OrderProducts, error) {
+	if authUser == nil || authUser.GetUserRole() != Employee {
+		return OrderProducts{}, ErrNotAuthorized
+	}
+
+	if err := validID(pvzID); err != nil {
+		return OrderProducts{}, errors.Join(ErrAvitoServiceOrderInvalidPVZID, err)
+	}
+	if len(productIDs) == 0 || len(productIDs) > MaxProductBatch {
+		return OrderProducts{}, errors.Join(
+			ErrAvitoServiceCreateOrderInvalidProducts,
+			errors.New(strconv.Itoa(len(productIDs))+" products"),
+		)
+	}
+	sorted := slices.Clone(productIDs)
+	slices.SortFunc(sorted, func(a, b ProductID) int { return strings.Compare(a.String(), b.String()) })
+	if len(slices.Compact(sorted)) != len(productIDs) {
+		return OrderProducts{}, errors.Join(ErrAvitoServiceCreateOrderInvalidProducts, errors.New("repeated product"))
+	}
+
+	createdBy := authUser.GetUserID()
+	order := OrderProducts{
+		Order: Order{
+			ID:        uuid.New(),
+			PVZID:     pvzID,
+			CreatedAt: time.Now(),
+			CreatedBy: &createdBy,
+		},
+	}
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		products, err := s.findPVZProducts(ctx, c, pvzID, productIDs)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCreateOrderFindProducts, err)
+		}
+		for _, product := range products {
+			if product.Status != ProductReceived && product.Status != ProductStored {
+				return errors.Join(
+					ErrAvitoServiceCreateOrderUnavailableProduct,
+					errors.New(product.ID.String()+" is "+string(product.Status)),
+				)
+			}
+			// A rejected product goes back to the sender when its reception
+			// is closed.
+			if product.Rejected() {
+				return errors.Join(
+					ErrAvitoServiceCreateOrderUnavailableProduct,
+					errors.New(product.ID.String()+" is "+string(InspectionRejected)),
+				)
+			}
+		}
+		order.Products = products
+
+		for attempt := 1; ; attempt++ {
+			order.Order.PickupCode, err = NewPickupCode()
+			if err != nil {
+				return errors.Join(ErrAvitoServiceCreateOrder, err)
+			}
+
+			err = s.orderRepo.Create(ctx, c, order.Order, productIDs)
+			if errors.Is(err, ErrPickupCodeTaken) && attempt < pickupCodeAttempts {
+				continue
+			}
+			if err != nil {
+				return errors.Join(ErrAvitoServiceCreateOrder, err)
+			}
+
+			return nil
+		}
+	})
+	if err != nil {
+		return OrderProducts{}, err
+	}
+
+	return order, nil
+}
+
+// findPVZProducts loads the products in the order of the IDs and fails with
+// ErrProductNotFound when one of them was not received at the PVZ.
+func (s *ReceptionService) findPVZProducts(
+	ctx context.Context,
+	c Connection,
+	pvzID PVZID,
+	productIDs []ProductID,
+) ([]Product, error) {
+	found, err := s.productRepo.FindByIDs(ctx, c, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[ProductID]Product, len(found))
+	var receptionIDs []ReceptionID
+	for _, product := range found {
+		byID[product.ID] = product
+		if !slices.Contains(receptionIDs, product.ReceptionID) {
+			receptionIDs = append(receptionIDs, product.ReceptionID)
+		}
+	}
+
+	receptions, err := s.receptionRepo.FindByIDs(ctx, c, receptionIDs)
+	if err != nil {
+		return nil, err
+	}
+	atPVZ := make(map[ReceptionID]bool, len(receptions))
+	for _, reception := range receptions {
+		atPVZ[reception.ID] = reception.PVZID == pvzID
+	}
+
+	products := make([]Product, 0, len(productIDs))
+	for _, productID := range productIDs {
+		product, ok := byID[productID]
+		if !ok || !atPVZ[product.ReceptionID] {
+			return nil, errors.Join(ErrProductNotFound, errors.New(productID.String()))
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// Pickup checks the pickup code of a waiting order of the PVZ and issues all
+// of its products to the customer. Every product must be in storage.
+func (s *ReceptionService) Pickup(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID PVZID,
+	code string,
+) (OrderProducts, error) {
+	if authUser == nil || authUser.GetUserRole() != Employee {
+		return OrderProducts{}, ErrNotAuthorized
+	}
+
+	if err := validID(pvzID); err != nil {
+		return OrderProducts{}, errors.Join(ErrAvitoServiceOrderInvalidPVZID, err)
+	}
+	code = strings.TrimSpace(code)
+	if !validPickupCode(code) {
+		return OrderProducts{}, ErrAvitoServicePickupInvalidCode
+	}
+
+	var order OrderProducts
+	issuedBy := authUser.GetUserID()
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		order.Order, err = s.orderRepo.FindWaiting(ctx, c, pvzID, code)
+		if err != nil {
+			return errors.Join(ErrAvitoServicePickupFind, err)
+		}
+		products, err := s.orderRepo.FindProducts(ctx, c, order.Order.ID)
+		if err != nil {
+			return errors.Join(ErrAvitoServicePickupFind, err)
+		}
+
+		for _, product := range products {
+			if product.Status != ProductStored {
+				return errors.Join(
+					ErrAvitoServicePickupNotStored,
+					ErrProductInvalidTransition,
+					errors.New(product.ID.String()+" is "+string(product.Status)),
+				)
+			}
+		}
+
+		order.Products = make([]Product, 0, len(products))
+		issuedIDs := make([]ProductID, 0, len(products))
+		for _, product := range products {
+			issued, err := s.productRepo.ChangeStatus(ctx, c, ProductTransition{
+				ProductID: product.ID,
+				From:      ProductStored,
+				To:        ProductIssued,
+				ChangedBy: &issuedBy,
+			})
+			if err != nil {
+				return errors.Join(ErrAvitoServicePickup, err)
+			}
+			order.Products = append(order.Products, issued)
+			issuedIDs = append(issuedIDs, product.ID)
+		}
+		if err := s.cellRepo.Free(ctx, c, issuedIDs); err != nil {
+			return errors.Join(ErrAvitoServicePickup, err)
+		}
+
+		if err := s.orderRepo.MarkIssued(ctx, c, order.Order.ID, issuedBy); err != nil {
+			return errors.Join(ErrAvitoServicePickup, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return OrderProducts{}, err
+	}
+
+	issuedAt := time.Now()
+	order.Order.IssuedAt = &issuedAt
+	order.Order.IssuedBy = &issuedBy
+
+	return order, nil
+}
@@ -0,0 +1,544 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPickupCode(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		code, err := domain.NewPickupCode()
+		require.NoError(t, err)
+		require.Regexp(t, `^[0-9]{6}$`, code)
+	}
+}
+
+func TestServiceReception_CreateOrder(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := domain.Reception{ID: uuid.New(), PVZID: pvzID}
+	stored := domain.Product{
+		ID:          uuid.New(),
+		ReceptionID: reception.ID,
+		Type:        domain.Shoes,
+		Status:      domain.ProductStored,
+	}
+	received := domain.Product{
+		ID:          uuid.New(),
+		ReceptionID: reception.ID,
+		Type:        domain.Clothes,
+		Status:      domain.ProductReceived,
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		productIDs   []domain.ProductID
+		prepareMocks func(
+			*mocks.MockConnectionProvider,
+			*mocks.MockReceptionsRepository,
+			*mocks.MockProductsRepository,
+			*mocks.MockOrdersRepository,
+		)
+		check func(*testing.T, domain.OrderProducts, error)
+	}{
+		{
+			name:       "Success",
+			authUser:   fixtureAuthUser(t, domain.Employee),
+			productIDs: []domain.ProductID{received.ID, stored.ID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, []domain.ProductID{received.ID, stored.ID}).
+					Return([]domain.Product{stored, received}, nil).
+					Once()
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+					Return([]domain.Reception{reception}, nil).
+					Once()
+				orders.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything, []domain.ProductID{received.ID, stored.ID}).
+					Return(domain.ErrPickupCodeTaken).
+					Once()
+				orders.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.MatchedBy(func(order domain.Order) bool {
+						return order.PVZID == pvzID && len(order.PickupCode) == domain.PickupCodeLength
+					}), mock.Anything).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, got domain.OrderProducts, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pvzID, got.Order.PVZID)
+				require.Len(t, got.Order.PickupCode, domain.PickupCodeLength)
+				require.Nil(t, got.Order.IssuedAt)
+				require.Equal(t, []domain.Product{received, stored}, got.Products)
+			},
+		},
+		{
+			name:       "Product of another PVZ",
+			authUser:   fixtureAuthUser(t, domain.Employee),
+			productIDs: []domain.ProductID{stored.ID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				_ *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{stored}, nil).
+					Once()
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{{ID: reception.ID, PVZID: uuid.New()}}, nil).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateOrderFindProducts)
+				require.ErrorIs(t, err, domain.ErrProductNotFound)
+			},
+		},
+		{
+			name:       "Product issued",
+			authUser:   fixtureAuthUser(t, domain.Employee),
+			productIDs: []domain.ProductID{stored.ID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				_ *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				issued := stored
+				issued.Status = domain.ProductIssued
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{issued}, nil).
+					Once()
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{reception}, nil).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateOrderUnavailableProduct)
+			},
+		},
+		{
+			name:       "Product rejected",
+			authUser:   fixtureAuthUser(t, domain.Employee),
+			productIDs: []domain.ProductID{received.ID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				_ *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				rejected := received
+				rejected.InspectionStatus = pointer.Ref(domain.InspectionRejected)
+				rejected.InspectionReason = pointer.Ref("Коробка вскрыта")
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{rejected}, nil).
+					Once()
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{reception}, nil).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateOrderUnavailableProduct)
+			},
+		},
+		{
+			name:       "Product in another order",
+			authUser:   fixtureAuthUser(t, domain.Employee),
+			productIDs: []domain.ProductID{stored.ID},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				receptions *mocks.MockReceptionsRepository,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				products.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{stored}, nil).
+					Once()
+				receptions.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{reception}, nil).
+					Once()
+				orders.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrProductInOrder).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateOrder)
+				require.ErrorIs(t, err, domain.ErrProductInOrder)
+			},
+		},
+		{
+			name:       "Repeated product",
+			authUser:   fixtureAuthUser(t, domain.Employee),
+			productIDs: []domain.ProductID{stored.ID, stored.ID},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateOrderInvalidProducts)
+			},
+		},
+		{
+			name:     "No products",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateOrderInvalidProducts)
+			},
+		},
+		{
+			name:       "Moderator not authorized",
+			authUser:   fixtureAuthUser(t, domain.Moderator),
+			productIDs: []domain.ProductID{stored.ID},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoOrder := mocks.NewMockOrdersRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoReception, repoProduct, repoOrder)
+			}
+
+			got, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				repoOrder,
+				mocks.NewMockStorageCellsRepository(t),
+				mocks.NewMockMetrics(t),
+			).CreateOrder(t.Context(), test.authUser, pvzID, test.productIDs)
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestServiceReception_Pickup(t *testing.T) {
+	t.Parallel()
+
+	employee := fixtureAuthUser(t, domain.Employee)
+	pvzID := uuid.New()
+	order := domain.Order{ID: uuid.New(), PVZID: pvzID, PickupCode: "012345"}
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		code         string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository, *mocks.MockOrdersRepository)
+		check        func(*testing.T, domain.OrderProducts, error)
+	}{
+		{
+			name:     "Success",
+			authUser: employee,
+			code:     " 012345 ",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				orders.EXPECT().FindWaiting(mock.Anything, mock.Anything, pvzID, "012345").Return(order, nil).Once()
+				orders.EXPECT().
+					FindProducts(mock.Anything, mock.Anything, order.ID).
+					Return([]domain.Product{product}, nil).
+					Once()
+				issued := product
+				issued.Status = domain.ProductIssued
+				products.EXPECT().
+					ChangeStatus(mock.Anything, mock.Anything, domain.ProductTransition{
+						ProductID: product.ID,
+						From:      domain.ProductStored,
+						To:        domain.ProductIssued,
+						ChangedBy: pointer.Ref(employee.GetUserID()),
+					}).
+					Return(issued, nil).
+					Once()
+				orders.EXPECT().
+					MarkIssued(mock.Anything, mock.Anything, order.ID, employee.GetUserID()).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, got domain.OrderProducts, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, got.Order.IssuedAt)
+				require.Equal(t, pointer.Ref(employee.GetUserID()), got.Order.IssuedBy)
+				require.Len(t, got.Products, 1)
+				require.Equal(t, domain.ProductIssued, got.Products[0].Status)
+			},
+		},
+		{
+			name:     "Product not stored",
+			authUser: employee,
+			code:     order.PickupCode,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				received := product
+				received.Status = domain.ProductReceived
+				orders.EXPECT().
+					FindWaiting(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(order, nil).
+					Once()
+				orders.EXPECT().
+					FindProducts(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product, received}, nil).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServicePickupNotStored)
+				require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+			},
+		},
+		{
+			name:     "Order not found",
+			authUser: employee,
+			code:     order.PickupCode,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				orders.EXPECT().
+					FindWaiting(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Order{}, domain.ErrOrderNotFound).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServicePickupFind)
+				require.ErrorIs(t, err, domain.ErrOrderNotFound)
+			},
+		},
+		{
+			name:     "Mark issued error",
+			authUser: employee,
+			code:     order.PickupCode,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				products *mocks.MockProductsRepository,
+				orders *mocks.MockOrdersRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				orders.EXPECT().
+					FindWaiting(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(order, nil).
+					Once()
+				orders.EXPECT().FindProducts(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+				orders.EXPECT().
+					MarkIssued(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServicePickup)
+			},
+		},
+		{
+			name:     "Invalid code",
+			authUser: employee,
+			code:     "12a456",
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServicePickupInvalidCode)
+			},
+		},
+		{
+			name: "Not authorized",
+			code: order.PickupCode,
+			check: func(t *testing.T, _ domain.OrderProducts, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoOrder := mocks.NewMockOrdersRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoProduct, repoOrder)
+			}
+
+			got, err := domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				repoOrder,
+				fixtureStorageCells(t),
+				mocks.NewMockMetrics(t),
+			).Pickup(t.Context(), test.authUser, pvzID, test.code)
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestServiceReception_ChangeStatusOfOrderedProduct(t *testing.T) {
+	t.Parallel()
+
+	employee := fixtureAuthUser(t, domain.Employee)
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	tests := []struct {
+		name   string
+		change func(*domain.ReceptionService) (domain.Product, error)
+	}{
+		{
+			name: "Issue",
+			change: func(service *domain.ReceptionService) (domain.Product, error) {
+				return service.IssueProduct(t.Context(), employee, product.ID)
+			},
+		},
+		{
+			name: "Return",
+			change: func(service *domain.ReceptionService) (domain.Product, error) {
+				return service.ReturnProduct(t.Context(), employee, product.ID)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			provider.EXPECT().
+				ExecuteTx(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+					return f(ctx, &mocks.MockConnection{})
+				}).
+				Once()
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoProduct.EXPECT().
+				FindByIDs(mock.Anything, mock.Anything, []domain.ProductID{product.ID}).
+				Return([]domain.Product{product}, nil).
+				Once()
+			repoOrders := mocks.NewMockOrdersRepository(t)
+			repoOrders.EXPECT().
+				FindWaitingByProduct(mock.Anything, mock.Anything, product.ID).
+				Return(domain.Order{ID: uuid.New(), PickupCode: "123456"}, nil).
+				Once()
+
+			_, err := test.change(domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				repoOrders,
+				mocks.NewMockStorageCellsRepository(t),
+				mocks.NewMockMetrics(t),
+			))
+			require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatus)
+			require.ErrorIs(t, err, domain.ErrProductInOrder)
+		})
+	}
+}
+
+// fixtureNoWaitingOrders stands for products that belong to no waiting order.
+func fixtureNoWaitingOrders(t *testing.T) *mocks.MockOrdersRepository {
+	t.Helper()
+
+	repo := mocks.NewMockOrdersRepository(t)
+	repo.EXPECT().
+		FindWaitingByProduct(mock.Anything, mock.Anything, mock.Anything).
+		Return(domain.Order{}, domain.ErrOrderNotFound).
+		Maybe()
+
+	return repo
+}
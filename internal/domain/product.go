@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxBarcodeLength bounds the barcode or order number of a product.
+	MaxBarcodeLength = 64
+	// MaxInspectionReasonLength bounds the explanation of an inspection.
+	MaxInspectionReasonLength = 500
+)
+
+var (
+	errProductDraft             = errors.New("product draft error")
+	ErrProductDraftInvalidType  = errors.Join(errProductDraft, errors.New("invalid product type"))
+	ErrProductDraftEmptyBarcode = errors.Join(errProductDraft, errors.New("empty barcode"))
+	ErrProductDraftLongBarcode  = errors.Join(errProductDraft, errors.New("barcode too long"))
+
+	ErrProductDraftInvalidWeight        = errors.Join(errProductDraft, errors.New("invalid weight"))
+	ErrProductDraftInvalidDimensions    = errors.Join(errProductDraft, errors.New("invalid dimensions"))
+	ErrProductDraftInvalidDeclaredValue = errors.Join(errProductDraft, errors.New("invalid declared value"))
+	ErrProductDraftInvalidInspection    = errors.Join(errProductDraft, errors.New("invalid inspection status"))
+	ErrProductDraftNoInspectionReason   = errors.Join(errProductDraft, errors.New("rejection without reason"))
+	ErrProductDraftLongInspectionReason = errors.Join(errProductDraft, errors.New("inspection reason too long"))
+)
+
+// Normalize validates the draft and trims the spaces a scanner may add around
+// the barcode. Whether the type is in the catalogue is checked by the service.
+func (d ProductDraft) Normalize() (ProductDraft, error) {
+	if d.Type == "" {
+		return d, ErrProductDraftInvalidType
+	}
+
+	attributes, err := d.ProductAttributes.Normalize()
+	if err != nil {
+		return d, err
+	}
+	d.ProductAttributes = attributes
+
+	if d.Barcode == nil {
+		return d, nil
+	}
+
+	barcode := strings.TrimSpace(*d.Barcode)
+	if barcode == "" {
+		return d, ErrProductDraftEmptyBarcode
+	}
+	if utf8.RuneCountInString(barcode) > MaxBarcodeLength {
+		return d, errors.Join(ErrProductDraftLongBarcode, errors.New(barcode))
+	}
+	d.Barcode = &barcode
+
+	return d, nil
+}
+
+// Normalize validates the attributes and trims the inspection reason. A
+// rejected product needs a reason, an accepted one cannot have any.
+func (a ProductAttributes) Normalize() (ProductAttributes, error) {
+	if a.WeightGrams != nil && *a.WeightGrams <= 0 {
+		return a, ErrProductDraftInvalidWeight
+	}
+
+	dimensions := []*int{a.LengthCM, a.WidthCM, a.HeightCM}
+	set := 0
+	for _, dimension := range dimensions {
+		if dimension == nil {
+			continue
+		}
+		if *dimension <= 0 {
+			return a, ErrProductDraftInvalidDimensions
+		}
+		set++
+	}
+	if set != 0 && set != len(dimensions) {
+		return a, errors.Join(ErrProductDraftInvalidDimensions, errors.New("partial dimensions"))
+	}
+
+	if a.DeclaredValue != nil && *a.DeclaredValue < 0 {
+		return a, ErrProductDraftInvalidDeclaredValue
+	}
+
+	if a.InspectionReason != nil {
+		reason := strings.TrimSpace(*a.InspectionReason)
+		if utf8.RuneCountInString(reason) > MaxInspectionReasonLength {
+			return a, ErrProductDraftLongInspectionReason
+		}
+		a.InspectionReason = &reason
+		if reason == "" {
+			a.InspectionReason = nil
+		}
+	}
+
+	switch {
+	case a.InspectionStatus == nil && a.InspectionReason != nil:
+		return a, errors.Join(ErrProductDraftInvalidInspection, errors.New("reason without status"))
+	case a.InspectionStatus == nil:
+	case *a.InspectionStatus == InspectionRejected && a.InspectionReason == nil:
+		return a, ErrProductDraftNoInspectionReason
+	case *a.InspectionStatus == InspectionAccepted && a.InspectionReason != nil:
+		return a, errors.Join(ErrProductDraftInvalidInspection, errors.New("reason for accepted product"))
+	case *a.InspectionStatus != InspectionAccepted &&
+		*a.InspectionStatus != InspectionDamaged &&
+		*a.InspectionStatus != InspectionRejected:
+		return a, errors.Join(ErrProductDraftInvalidInspection, errors.New(string(*a.InspectionStatus)))
+	}
+
+	return a, nil
+}
+
+// Rejected reports whether the product was inspected as rejected, such a
+// product is returned to the sender instead of being stored.
+func (a ProductAttributes) Rejected() bool {
+	return a.InspectionStatus != nil && *a.InspectionStatus == InspectionRejected
+}
+
+// VolumeCM3 is the volume of the product, ok is false without dimensions.
+func (a ProductAttributes) VolumeCM3() (int64, bool) {
+	if a.LengthCM == nil || a.WidthCM == nil || a.HeightCM == nil {
+		return 0, false
+	}
+
+	return int64(*a.LengthCM) * int64(*a.WidthCM) * int64(*a.HeightCM), true
+}
+
+// SummarizeProducts counts the products by inspection status and adds up
+// their weight, volume and declared value.
+func SummarizeProducts(products []Product) ProductSummary {
+	summary := ProductSummary{
+		Products:    len(products),
+		Inspections: make(map[InspectionStatus]int),
+	}
+	for _, product := range products {
+		if product.InspectionStatus != nil {
+			summary.Inspections[*product.InspectionStatus]++
+		} else {
+			summary.Uninspected++
+		}
+		if product.WeightGrams != nil {
+			summary.WeightGrams += int64(*product.WeightGrams)
+		}
+		if volume, ok := product.VolumeCM3(); ok {
+			summary.VolumeCM3 += volume
+		}
+		if product.DeclaredValue != nil {
+			summary.DeclaredValue += *product.DeclaredValue
+		}
+	}
+
+	return summary
+}
+
+// productTransitions lists the statuses every status can change to. Returned
+// products include stored ones nobody picked up.
+var productTransitions = map[ProductStatus][]ProductStatus{
+	ProductReceived: {ProductStored, ProductReturned},
+	ProductStored:   {ProductIssued, ProductReturned},
+}
+
+// CanBecome tells whether a product can move from s to the status. Issued and
+// returned products have left the PVZ and cannot change any more.
+func (s ProductStatus) CanBecome(status ProductStatus) bool {
+	return slices.Contains(productTransitions[s], status)
+}
+
+// Valid tells whether s is a known product status.
+func (s ProductStatus) Valid() bool {
+	return slices.Contains([]ProductStatus{ProductReceived, ProductStored, ProductIssued, ProductReturned}, s)
+}
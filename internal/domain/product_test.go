@@ -0,0 +1,193 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/infra/pointer"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductDraft_Normalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		draft domain.ProductDraft
+		want  domain.ProductDraft
+		err   error
+	}{
+		{
+			name:  "Without barcode",
+			draft: domain.ProductDraft{Type: domain.Shoes},
+			want:  domain.ProductDraft{Type: domain.Shoes},
+		},
+		{
+			name:  "Barcode trimmed",
+			draft: domain.ProductDraft{Type: domain.Clothes, Barcode: pointer.Ref(" 4600000000017\n")},
+			want:  domain.ProductDraft{Type: domain.Clothes, Barcode: pointer.Ref("4600000000017")},
+		},
+		{
+			name: "Attributes",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				WeightGrams:      pointer.Ref(1200),
+				LengthCM:         pointer.Ref(30),
+				WidthCM:          pointer.Ref(20),
+				HeightCM:         pointer.Ref(10),
+				DeclaredValue:    pointer.Ref(int64(0)),
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+				InspectionReason: pointer.Ref(" Коробка вскрыта "),
+			}},
+			want: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				WeightGrams:      pointer.Ref(1200),
+				LengthCM:         pointer.Ref(30),
+				WidthCM:          pointer.Ref(20),
+				HeightCM:         pointer.Ref(10),
+				DeclaredValue:    pointer.Ref(int64(0)),
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+				InspectionReason: pointer.Ref("Коробка вскрыта"),
+			}},
+		},
+		{
+			name: "Damaged without reason",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionDamaged),
+				InspectionReason: pointer.Ref(" "),
+			}},
+			want: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionDamaged),
+			}},
+		},
+		{
+			name: "Zero weight",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				WeightGrams: pointer.Ref(0),
+			}},
+			err: domain.ErrProductDraftInvalidWeight,
+		},
+		{
+			name: "Partial dimensions",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				LengthCM: pointer.Ref(30),
+				WidthCM:  pointer.Ref(20),
+			}},
+			err: domain.ErrProductDraftInvalidDimensions,
+		},
+		{
+			name: "Negative dimension",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				LengthCM: pointer.Ref(30),
+				WidthCM:  pointer.Ref(-20),
+				HeightCM: pointer.Ref(10),
+			}},
+			err: domain.ErrProductDraftInvalidDimensions,
+		},
+		{
+			name: "Negative declared value",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				DeclaredValue: pointer.Ref(int64(-1)),
+			}},
+			err: domain.ErrProductDraftInvalidDeclaredValue,
+		},
+		{
+			name: "Rejected without reason",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+			}},
+			err: domain.ErrProductDraftNoInspectionReason,
+		},
+		{
+			name: "Accepted with reason",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionAccepted),
+				InspectionReason: pointer.Ref("Все в порядке"),
+			}},
+			err: domain.ErrProductDraftInvalidInspection,
+		},
+		{
+			name: "Reason without status",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionReason: pointer.Ref("Помята упаковка"),
+			}},
+			err: domain.ErrProductDraftInvalidInspection,
+		},
+		{
+			name: "Unknown inspection status",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionStatus("lost")),
+			}},
+			err: domain.ErrProductDraftInvalidInspection,
+		},
+		{
+			name: "Long inspection reason",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+				InspectionReason: pointer.Ref(strings.Repeat("я", domain.MaxInspectionReasonLength+1)),
+			}},
+			err: domain.ErrProductDraftLongInspectionReason,
+		},
+		{
+			name:  "Empty type",
+			draft: domain.ProductDraft{Type: ""},
+			err:   domain.ErrProductDraftInvalidType,
+		},
+		{
+			name:  "Empty barcode",
+			draft: domain.ProductDraft{Type: domain.Shoes, Barcode: pointer.Ref(" ")},
+			err:   domain.ErrProductDraftEmptyBarcode,
+		},
+		{
+			name: "Long barcode",
+			draft: domain.ProductDraft{
+				Type:    domain.Shoes,
+				Barcode: pointer.Ref(strings.Repeat("7", domain.MaxBarcodeLength+1)),
+			},
+			err: domain.ErrProductDraftLongBarcode,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			draft, err := test.draft.Normalize()
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, draft)
+		})
+	}
+}
+
+func TestProductStatus_CanBecome(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from domain.ProductStatus
+		to   domain.ProductStatus
+		want bool
+	}{
+		{from: domain.ProductReceived, to: domain.ProductStored, want: true},
+		{from: domain.ProductReceived, to: domain.ProductReturned, want: true},
+		{from: domain.ProductReceived, to: domain.ProductIssued},
+		{from: domain.ProductStored, to: domain.ProductIssued, want: true},
+		{from: domain.ProductStored, to: domain.ProductReturned, want: true},
+		{from: domain.ProductStored, to: domain.ProductReceived},
+		{from: domain.ProductIssued, to: domain.ProductReturned},
+		{from: domain.ProductReturned, to: domain.ProductStored},
+		{from: "lost", to: domain.ProductStored},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.from)+" to "+string(test.to), func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.want, test.from.CanBecome(test.to))
+		})
+	}
+}
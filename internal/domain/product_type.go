@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var _ ProductTypesInterface = (*ProductTypeService)(nil)
+
+// MaxProductTypeCodeLength bounds the code of a catalogue entry.
+const MaxProductTypeCodeLength = 64
+
+var languageCode = regexp.MustCompile(`^[a-z]{2}$`)
+
+var (
+	errProductTypeEntry             = errors.New("product type entry error")
+	ErrProductTypeEntryInvalidCode  = errors.Join(errProductTypeEntry, errors.New("invalid code"))
+	ErrProductTypeEntryNoNames      = errors.Join(errProductTypeEntry, errors.New("no names"))
+	ErrProductTypeEntryInvalidLang  = errors.Join(errProductTypeEntry, errors.New("invalid language code"))
+	ErrProductTypeEntryEmptyName    = errors.Join(errProductTypeEntry, errors.New("empty name"))
+	ErrProductTypeUnavailable       = errors.New("product type is not in the catalogue or inactive")
+	errProductTypes                 = errors.New("product types service error")
+	ErrAvitoServiceFindProductTypes = errors.Join(
+		errProductTypes,
+		errors.New("find all failed"),
+	)
+	errAvitoServiceCreateProductType = errors.Join(
+		errProductTypes,
+		errors.New("create failed"),
+	)
+	ErrAvitoServiceCreateProductTypeInvalid = errors.Join(
+		errAvitoServiceCreateProductType,
+		errors.New("invalid entry"),
+	)
+	ErrAvitoServiceCreateProductType = errors.Join(
+		errAvitoServiceCreateProductType,
+		errors.New("create failed"),
+	)
+	errAvitoServiceUpdateProductType = errors.Join(
+		errProductTypes,
+		errors.New("update failed"),
+	)
+	ErrAvitoServiceUpdateProductTypeInvalid = errors.Join(
+		errAvitoServiceUpdateProductType,
+		errors.New("invalid entry"),
+	)
+	ErrAvitoServiceUpdateProductType = errors.Join(
+		errAvitoServiceUpdateProductType,
+		errors.New("update failed"),
+	)
+)
+
+// Normalize validates the entry and trims its code and names. Names are keyed
+// by two letter lowercase language codes such as "ru" or "en".
+func (e ProductTypeEntry) Normalize() (ProductTypeEntry, error) {
+	code := strings.TrimSpace(string(e.Code))
+	if code == "" || utf8.RuneCountInString(code) > MaxProductTypeCodeLength {
+		return e, errors.Join(ErrProductTypeEntryInvalidCode, errors.New(code))
+	}
+	if len(e.Names) == 0 {
+		return e, ErrProductTypeEntryNoNames
+	}
+
+	names := make(map[string]string, len(e.Names))
+	for lang, name := range e.Names {
+		if !languageCode.MatchString(lang) {
+			return e, errors.Join(ErrProductTypeEntryInvalidLang, errors.New(lang))
+		}
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return e, errors.Join(ErrProductTypeEntryEmptyName, errors.New(lang))
+		}
+		names[lang] = name
+	}
+
+	return ProductTypeEntry{Code: ProductType(code), Names: names, Active: e.Active}, nil
+}
+
+// checkProductTypes makes sure every type is in the catalogue and active.
+func checkProductTypes(
+	ctx context.Context,
+	c Connection,
+	repo ProductTypesRepository,
+	productTypes []ProductType,
+) error {
+	entries, err := repo.FindByCodes(ctx, c, productTypes)
+	if err != nil {
+		return err
+	}
+
+	active := make(map[ProductType]bool, len(entries))
+	for _, entry := range entries {
+		active[entry.Code] = entry.Active
+	}
+	for _, productType := range productTypes {
+		if !active[productType] {
+			return errors.Join(ErrProductTypeUnavailable, errors.New(string(productType)))
+		}
+	}
+
+	return nil
+}
+
+// ProductTypeService manages the catalogue of product types.
+type ProductTypeService struct {
+	provider        ConnectionProvider
+	productTypeRepo ProductTypesRepository
+}
+
+func NewProductTypeService(provider ConnectionProvider, productTypeRepo ProductTypesRepository) *ProductTypeService {
+	return &ProductTypeService{
+		provider:        provider,
+		productTypeRepo: productTypeRepo,
+	}
+}
+
+// FindAll lists the catalogue ordered by code, only active types unless
+// includeInactive is set.
+func (s *ProductTypeService) FindAll(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	includeInactive bool,
+) ([]ProductTypeEntry, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return nil, ErrNotAuthorized
+	}
+
+	var entries []ProductTypeEntry
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		entries, err = s.productTypeRepo.FindAll(ctx, c, includeInactive)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAvitoServiceFindProductTypes, err)
+	}
+
+	return entries, nil
+}
+
+func (s *ProductTypeService) Create(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	entry ProductTypeEntry,
+) (ProductTypeEntry, error) {
+	if authUser == nil || authUser.GetUserRole() != Moderator {
+		return ProductTypeEntry{}, ErrNotAuthorized
+	}
+
+	entry, err := entry.Normalize()
+	if err != nil {
+		return ProductTypeEntry{}, errors.Join(ErrAvitoServiceCreateProductTypeInvalid, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		return s.productTypeRepo.Create(ctx, c, entry)
+	})
+	if err != nil {
+		return ProductTypeEntry{}, errors.Join(ErrAvitoServiceCreateProductType, err)
+	}
+
+	return entry, nil
+}
+
+// Update replaces the names of the entry and activates or deactivates it. The
+// code cannot be changed since products keep referring to it.
+func (s *ProductTypeService) Update(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	entry ProductTypeEntry,
+) (ProductTypeEntry, error) {
+	if authUser == nil || authUser.GetUserRole() != Moderator {
+		return ProductTypeEntry{}, ErrNotAuthorized
+	}
+
+	entry, err := entry.Normalize()
+	if err != nil {
+		return ProductTypeEntry{}, errors.Join(ErrAvitoServiceUpdateProductTypeInvalid, err)
+	}
+
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		return s.productTypeRepo.Update(ctx, c, entry)
+	})
+	if err != nil {
+		return ProductTypeEntry{}, errors.Join(ErrAvitoServiceUpdateProductType, err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,313 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductTypeEntry_Normalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		entry domain.ProductTypeEntry
+		want  domain.ProductTypeEntry
+		err   error
+	}{
+		{
+			name: "Trimmed",
+			entry: domain.ProductTypeEntry{
+				Code:  " мебель ",
+				Names: map[string]string{"ru": " Мебель", "en": "Furniture "},
+			},
+			want: domain.ProductTypeEntry{Code: "мебель", Names: map[string]string{"ru": "Мебель", "en": "Furniture"}},
+		},
+		{
+			name:  "Empty code",
+			entry: domain.ProductTypeEntry{Code: " ", Names: map[string]string{"ru": "Мебель"}},
+			err:   domain.ErrProductTypeEntryInvalidCode,
+		},
+		{
+			name: "Long code",
+			entry: domain.ProductTypeEntry{
+				Code:  domain.ProductType(strings.Repeat("м", domain.MaxProductTypeCodeLength+1)),
+				Names: map[string]string{"ru": "Мебель"},
+			},
+			err: domain.ErrProductTypeEntryInvalidCode,
+		},
+		{
+			name:  "No names",
+			entry: domain.ProductTypeEntry{Code: "мебель"},
+			err:   domain.ErrProductTypeEntryNoNames,
+		},
+		{
+			name:  "Invalid language",
+			entry: domain.ProductTypeEntry{Code: "мебель", Names: map[string]string{"RU": "Мебель"}},
+			err:   domain.ErrProductTypeEntryInvalidLang,
+		},
+		{
+			name:  "Empty name",
+			entry: domain.ProductTypeEntry{Code: "мебель", Names: map[string]string{"ru": " "}},
+			err:   domain.ErrProductTypeEntryEmptyName,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			entry, err := test.entry.Normalize()
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, entry)
+		})
+	}
+}
+
+func TestServiceProductType_FindAll(t *testing.T) {
+	t.Parallel()
+
+	entries := []domain.ProductTypeEntry{{Code: domain.Shoes, Names: map[string]string{"ru": "Обувь"}, Active: true}}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductTypesRepository)
+		check        func(*testing.T, []domain.ProductTypeEntry, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindAll(mock.Anything, mock.Anything, true).Return(entries, nil).Once()
+			},
+			check: func(t *testing.T, got []domain.ProductTypeEntry, err error) {
+				require.NoError(t, err)
+				require.Equal(t, entries, got)
+			},
+		},
+		{
+			name:     "Repository error",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindAll(mock.Anything, mock.Anything, true).Return(nil, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ []domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindProductTypes)
+			},
+		},
+		{
+			name: "Not authorized",
+			check: func(t *testing.T, _ []domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repo := mocks.NewMockProductTypesRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repo)
+			}
+
+			got, err := domain.NewProductTypeService(provider, repo).FindAll(t.Context(), test.authUser, true)
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestServiceProductType_Create(t *testing.T) {
+	t.Parallel()
+
+	entry := domain.ProductTypeEntry{Code: "мебель", Names: map[string]string{"ru": "Мебель"}, Active: true}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		entry        domain.ProductTypeEntry
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductTypesRepository)
+		check        func(*testing.T, domain.ProductTypeEntry, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			entry:    entry,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything, entry).Return(nil).Once()
+			},
+			check: func(t *testing.T, got domain.ProductTypeEntry, err error) {
+				require.NoError(t, err)
+				require.Equal(t, entry, got)
+			},
+		},
+		{
+			name:     "Code already exists",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			entry:    entry,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything, entry).Return(domain.ErrProductTypeExists).Once()
+			},
+			check: func(t *testing.T, _ domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductType)
+				require.ErrorIs(t, err, domain.ErrProductTypeExists)
+			},
+		},
+		{
+			name:     "Invalid entry",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			entry:    domain.ProductTypeEntry{Code: "мебель"},
+			check: func(t *testing.T, _ domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductTypeInvalid)
+				require.ErrorIs(t, err, domain.ErrProductTypeEntryNoNames)
+			},
+		},
+		{
+			name:     "Employee not authorized",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			entry:    entry,
+			check: func(t *testing.T, _ domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repo := mocks.NewMockProductTypesRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repo)
+			}
+
+			got, err := domain.NewProductTypeService(provider, repo).Create(t.Context(), test.authUser, test.entry)
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestServiceProductType_Update(t *testing.T) {
+	t.Parallel()
+
+	entry := domain.ProductTypeEntry{Code: domain.Shoes, Names: map[string]string{"ru": "Обувь"}}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		entry        domain.ProductTypeEntry
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductTypesRepository)
+		check        func(*testing.T, domain.ProductTypeEntry, error)
+	}{
+		{
+			name:     "Deactivate",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			entry:    entry,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().Update(mock.Anything, mock.Anything, entry).Return(nil).Once()
+			},
+			check: func(t *testing.T, got domain.ProductTypeEntry, err error) {
+				require.NoError(t, err)
+				require.False(t, got.Active)
+			},
+		},
+		{
+			name:     "Type not found",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			entry:    entry,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductTypesRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().Update(mock.Anything, mock.Anything, entry).Return(domain.ErrProductTypeNotFound).Once()
+			},
+			check: func(t *testing.T, _ domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceUpdateProductType)
+				require.ErrorIs(t, err, domain.ErrProductTypeNotFound)
+			},
+		},
+		{
+			name:     "Invalid entry",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			entry:    domain.ProductTypeEntry{Code: domain.Shoes, Names: map[string]string{"ru": ""}},
+			check: func(t *testing.T, _ domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceUpdateProductTypeInvalid)
+			},
+		},
+		{
+			name:     "Employee not authorized",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			entry:    entry,
+			check: func(t *testing.T, _ domain.ProductTypeEntry, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repo := mocks.NewMockProductTypesRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repo)
+			}
+
+			got, err := domain.NewProductTypeService(provider, repo).Update(t.Context(), test.authUser, test.entry)
+			test.check(t, got, err)
+		})
+	}
+}
@@ -16,25 +16,57 @@ var (
 		errPVZ,
 		errors.New("create pvz failed"),
 	)
+	ErrAvitoServiceCreatePVZInvalidSchedule = errors.Join(
+		ErrAvitoServiceCreatePVZ,
+		errors.New("invalid schedule"),
+	)
 	ErrAvitoServiceFindAllPVZ = errors.Join(
 		errPVZ,
 		errors.New("find all last pvz failed"),
 	)
+	ErrAvitoServiceFindPVZDetails = errors.Join(
+		errPVZ,
+		errors.New("find pvz details failed"),
+	)
 	errAvitoServiceFindPVZReceptionProducts = errors.Join(
 		errPVZ,
 		errors.New("search failed"),
 	)
-	ErrAvitoServiceFindPVZReceptionProductsSearchProducts = errors.Join(
+	ErrAvitoServiceFindPVZReceptionProductsInvalidPage = errors.Join(
+		errAvitoServiceFindPVZReceptionProducts,
+		errors.New("invalid page or limit"),
+	)
+	ErrAvitoServiceFindPVZReceptionProductsInvalidFilter = errors.Join(
 		errAvitoServiceFindPVZReceptionProducts,
-		errors.New("search products failed"),
+		errors.New("invalid filter"),
 	)
 	ErrAvitoServiceFindPVZReceptionProductsSearchPVZs = errors.Join(
 		errAvitoServiceFindPVZReceptionProducts,
 		errors.New("search pvzs failed"),
 	)
-	ErrAvitoServiceFindPVZReceptionProductsSearchReceptions = errors.Join(
-		errAvitoServiceFindPVZReceptionProducts,
-		errors.New("search reseptions failed"),
+	errAvitoServiceExport = errors.Join(
+		errPVZ,
+		errors.New("export failed"),
+	)
+	ErrAvitoServiceExportInvalidFilter = errors.Join(
+		errAvitoServiceExport,
+		errors.New("invalid filter"),
+	)
+	ErrAvitoServiceExport = errors.Join(
+		errAvitoServiceExport,
+		errors.New("read rows failed"),
+	)
+	errAvitoServiceStream = errors.Join(
+		errPVZ,
+		errors.New("stream failed"),
+	)
+	ErrAvitoServiceStreamInvalidFilter = errors.Join(
+		errAvitoServiceStream,
+		errors.New("invalid filter"),
+	)
+	ErrAvitoServiceStream = errors.Join(
+		errAvitoServiceStream,
+		errors.New("read pvzs failed"),
 	)
 )
 
@@ -66,17 +98,24 @@ func (s *PVZService) Create(
 	ctx context.Context,
 	authUser AuthenticatedUser,
 	pvzCity PVZCity,
+	schedule PVZSchedule,
 ) (PVZ, error) {
 	if authUser == nil || authUser.GetUserRole() != Moderator {
 		return PVZ{}, ErrNotAuthorized
 	}
 
+	schedule = schedule.WithDefaults()
+	if err := schedule.Validate(); err != nil {
+		return PVZ{}, errors.Join(ErrAvitoServiceCreatePVZInvalidSchedule, err)
+	}
+
 	var pvz PVZ
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
 		pvz = PVZ{
 			ID:           uuid.New(),
 			City:         pvzCity,
 			RegisteredAt: time.Now(),
+			PVZSchedule:  schedule,
 		}
 
 		return s.pvzRepo.Create(ctx, c, pvz)
@@ -103,90 +142,180 @@ func (s *PVZService) FindAll(ctx context.Context) ([]PVZ, error) {
 	return pvzsAll, nil
 }
 
+func (s *PVZService) FindDetails(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID PVZID,
+) (PVZDetails, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return PVZDetails{}, ErrNotAuthorized
+	}
+
+	var details PVZDetails
+	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		var findError error
+		details, findError = s.pvzRepo.FindDetails(ctx, c, pvzID)
+		return findError
+	})
+	if err != nil {
+		return details, errors.Join(ErrAvitoServiceFindPVZDetails, err)
+	}
+
+	return details, nil
+}
+
+// FindPVZReceptionProducts pages through PVZs matching the filter and returns
+// each of them with its receptions and products. Pages are addressed either by
+// number or, when sorted by registration, by a cursor from the previous
+// response; the cursor of the next page is returned when the page is full.
 func (s *PVZService) FindPVZReceptionProducts(
 	ctx context.Context,
 	authUser AuthenticatedUser,
-	from *time.Time,
-	to *time.Time,
+	filter PVZFilter,
 	page *int,
 	limit *int,
-) ([]PVZReceptionsProducts, error) {
-	var result []PVZReceptionsProducts
+	cursor *string,
+) (PVZPage, error) {
+	var result PVZPage
 
-	if authUser == nil || authUser.GetUserRole() != Moderator ||
-		authUser.GetUserRole() != Employee {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
 		return result, ErrNotAuthorized
 	}
 
-	var products []Product
-	var searchError error
-	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		products, searchError = s.productRepo.Search(ctx, c, from, to, page, limit)
+	pageNumber, pageSize := DefaultPage, DefaultLimit
+	if page != nil {
+		pageNumber = *page
+	}
+	if limit != nil {
+		pageSize = *limit
+	}
+
+	filter = filter.WithDefaults()
+	if err := filter.Validate(); err != nil {
+		return result, errors.Join(ErrAvitoServiceFindPVZReceptionProductsInvalidFilter, err)
+	}
+
+	keyset := filter.Sort == SortByRegisteredAt
+	if pageNumber < 1 || pageSize < 1 || pageSize > MaxLimit || cursor != nil && (page != nil || !keyset) {
+		return result, ErrAvitoServiceFindPVZReceptionProductsInvalidPage
+	}
+
+	var after *Cursor
+	if cursor != nil {
+		decoded, err := DecodeCursor(*cursor)
+		if err != nil {
+			return result, errors.Join(ErrAvitoServiceFindPVZReceptionProductsInvalidPage, err)
+		}
+		after = &decoded
+	}
+
+	result = PVZPage{Limit: pageSize, Filter: filter}
+	if after == nil {
+		result.Page = pageNumber
+	}
+
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		var searchError error
+		result.Items, searchError = s.pvzRepo.SearchReceptionsProducts(ctx, c, filter, pageNumber, pageSize, after)
+		if searchError != nil {
+			return searchError
+		}
+
+		result.Total, searchError = s.pvzRepo.Count(ctx, c, filter)
 		return searchError
 	})
 	if err != nil {
-		return result, errors.Join(err, ErrAvitoServiceFindPVZReceptionProductsSearchProducts)
+		return PVZPage{}, errors.Join(err, ErrAvitoServiceFindPVZReceptionProductsSearchPVZs)
 	}
 
-	var receptionIDs []PVZID
-	for _, product := range products {
-		receptionIDs = append(receptionIDs, product.ReceptionID)
+	if keyset && len(result.Items) == pageSize {
+		last := result.Items[len(result.Items)-1].PVZ
+		encoded := Cursor{At: last.RegisteredAt, ID: last.ID}.Encode()
+		result.NextCursor = &encoded
+	}
+
+	return result, nil
+}
+
+// Export calls each for every product of the PVZs and receptions matching the
+// filter, in the order of the PVZ list. Rows are read from a single snapshot
+// and handed over as soon as they are scanned. An error of each stops the
+// export and is returned as is.
+func (s *PVZService) Export(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	filter PVZFilter,
+	each func(ExportRow) error,
+) error {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return ErrNotAuthorized
+	}
+
+	filter = filter.WithDefaults()
+	if err := filter.Validate(); err != nil {
+		return errors.Join(ErrAvitoServiceExportInvalidFilter, err)
+	}
+
+	eachErr, err := readEach(ctx, s.provider, filter, s.pvzRepo.Export, each)
+	if eachErr != nil {
+		return eachErr
 	}
-	var receptions []Reception
-	var findReceptionError error
-	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		receptions, findReceptionError = s.receptionRepo.FindByIDs(ctx, c, receptionIDs)
-		return findReceptionError
-	})
 	if err != nil {
-		return result, errors.Join(err, ErrAvitoServiceFindPVZReceptionProductsSearchReceptions)
+		return errors.Join(ErrAvitoServiceExport, err)
 	}
 
-	var pvzIDs []PVZID
-	for _, reception := range receptions {
-		pvzIDs = append(pvzIDs, reception.PVZID)
+	return nil
+}
+
+// Stream calls each for every PVZ matching the filter with its receptions and
+// products, in the order of the PVZ list. Like Export it reads a single
+// snapshot, a PVZ at a time, and returns an error of each as is.
+func (s *PVZService) Stream(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	filter PVZFilter,
+	each func(PVZReceptionsProducts) error,
+) error {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return ErrNotAuthorized
 	}
 
-	var pvzs []PVZ
-	var findPVZError error
-	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		pvzs, findPVZError = s.pvzRepo.FindByIDs(ctx, c, pvzIDs)
-		return findPVZError
-	})
+	filter = filter.WithDefaults()
+	if err := filter.Validate(); err != nil {
+		return errors.Join(ErrAvitoServiceStreamInvalidFilter, err)
+	}
+
+	eachErr, err := readEach(ctx, s.provider, filter, s.pvzRepo.Stream, each)
+	if eachErr != nil {
+		return eachErr
+	}
 	if err != nil {
-		return result, errors.Join(err, ErrAvitoServiceFindPVZReceptionProductsSearchPVZs)
+		return errors.Join(ErrAvitoServiceStream, err)
 	}
 
-	return Builder(products, receptions, pvzs), nil
+	return nil
 }
 
-func Builder(products []Product, receptions []Reception, pvzs []PVZ) []PVZReceptionsProducts {
-	productsToReceptionsByID := make(map[ReceptionID][]Product)
-	for _, product := range products {
-		productsToReceptionsByID[product.ReceptionID] = append(
-			productsToReceptionsByID[product.ReceptionID],
-			product,
-		)
-	}
-
-	receptionsToPVZsByID := make(map[PVZID][]ReceptionsProducts)
-	for _, reception := range receptions {
-		receptionsToPVZsByID[reception.PVZID] = append(
-			receptionsToPVZsByID[reception.PVZID],
-			ReceptionsProducts{
-				Reception: reception,
-				Products:  productsToReceptionsByID[reception.ID],
-			},
-		)
-	}
-
-	var result []PVZReceptionsProducts
-	for _, pvz := range pvzs {
-		result = append(result, PVZReceptionsProducts{
-			PVZ:        pvz,
-			Receptions: receptionsToPVZsByID[pvz.ID],
+// readEach runs read in a read-only transaction, telling an error of each
+// apart from a failure of read itself.
+func readEach[T any](
+	ctx context.Context,
+	provider ConnectionProvider,
+	filter PVZFilter,
+	read func(context.Context, Connection, PVZFilter, func(T) error) error,
+	each func(T) error,
+) (eachErr, err error) {
+	err = provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		return read(ctx, c, filter, func(item T) error {
+			eachErr = each(item)
+
+			return eachErr
 		})
-	}
+	})
 
-	return result
+	return eachErr, err
 }
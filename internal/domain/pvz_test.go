@@ -3,7 +3,6 @@ package domain_test
 import (
 	"context"
 	"errors"
-	"slices"
 	"testing"
 	"time"
 
@@ -28,7 +27,7 @@ func TestServicePVZ_Create(t *testing.T) {
 	}{
 		{
 			name:     "Success",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Moderator),
 			pvzCity:  domain.Kzn,
 			prepareMocks: func(_ *mocks.MockConnection, repo *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
 				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
@@ -43,7 +42,7 @@ func TestServicePVZ_Create(t *testing.T) {
 		},
 		{
 			name:     "DB Error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Moderator),
 			pvzCity:  domain.Kzn,
 			prepareMocks: func(_ *mocks.MockConnection, repo *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
 				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
@@ -80,7 +79,7 @@ func TestServicePVZ_Create(t *testing.T) {
 				Once()
 
 			pvz, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
-				Create(t.Context(), test.authUser, test.pvzCity)
+				Create(t.Context(), test.authUser, test.pvzCity, domain.PVZSchedule{})
 
 			test.check(t, pvz, err)
 		})
@@ -152,296 +151,69 @@ func TestServicePVZ_FindAll(t *testing.T) {
 	}
 }
 
-func TestServicePVZ_FindPVZReceptionProducts(t *testing.T) {
+func TestServicePVZ_FindDetails(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
-	pvzID1, pvzID2 := uuid.New(), uuid.New()
-	receptionID1, receptionID2 := uuid.New(), uuid.New()
-	productID1, productID2 := uuid.New(), uuid.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
 
 	tests := []struct {
 		name         string
 		authUser     domain.AuthenticatedUser
-		from, to     *time.Time
-		page, limit  *int
-		prepareMocks func(*mocks.MockConnection, *mocks.MockProductsRepository, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository)
-		check        func(*testing.T, []domain.PVZReceptionsProducts, error)
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, domain.PVZDetails, error)
 	}{
 		{
-			name:     "Success one product, one reception, one pvz",
-			authUser: nil,
-			from:     &now,
-			to:       &now,
-			page:     pointer.Ref(1),
-			limit:    pointer.Ref(10),
-			prepareMocks: func(
-				_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				receptionRepo *mocks.MockReceptionsRepository,
-				pvzRepo *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{
-					{
-						ID:          productID1,
-						ReceptionID: receptionID1,
-						Type:        "electronics",
-						CreatedAt:   now,
-					},
-				}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, nil).
-					Once()
-
-				receptions := []domain.Reception{
-					{ID: receptionID1, PVZID: pvzID1, Status: domain.InProgress, CreatedAt: now},
-				}
-				receptionRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(receptions, nil).
-					Once()
-
-				pvzs := []domain.PVZ{
-					{ID: pvzID1, City: domain.Kzn, RegisteredAt: now},
-				}
-				pvzRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(pvzs, nil).
-					Once()
-			},
-			check: func(t *testing.T, result []domain.PVZReceptionsProducts, err error) {
-				require.NoError(t, err)
-				require.Len(t, result, 1)
-				require.Equal(t, pvzID1, result[0].PVZ.ID)
-				require.Len(t, result[0].Receptions, 1)
-				require.Equal(t, receptionID1, result[0].Receptions[0].Reception.ID)
-				require.Len(t, result[0].Receptions[0].Products, 1)
-				require.Equal(t, productID1, result[0].Receptions[0].Products[0].ID)
-			},
-		},
-		{
-			name:     "Success two products, one reception, one pvz",
-			authUser: nil,
-			from:     &now,
-			to:       &now,
-			page:     pointer.Ref(1),
-			limit:    pointer.Ref(10),
-			prepareMocks: func(
-				_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				receptionRepo *mocks.MockReceptionsRepository,
-				pvzRepo *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{
-					{
-						ID:          productID1,
-						ReceptionID: receptionID1,
-						Type:        "электроника",
-						CreatedAt:   now,
-					},
-					{
-						ID:          productID2,
-						ReceptionID: receptionID1,
-						Type:        "одежда",
-						CreatedAt:   now.Add(-2 * time.Hour),
-					},
-				}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, nil).
-					Once()
-
-				receptions := []domain.Reception{
-					{ID: receptionID1, PVZID: pvzID1, Status: domain.InProgress, CreatedAt: now},
-				}
-				receptionRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(receptions, nil).
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
 					Once()
-
-				pvzs := []domain.PVZ{
-					{ID: pvzID1, City: domain.Kzn, RegisteredAt: now},
-				}
-				pvzRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(pvzs, nil).
+				repo.EXPECT().FindDetails(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZDetails{
+						PVZ: domain.PVZ{ID: pvzID, City: domain.Kzn},
+						ActiveReception: &domain.ReceptionsProducts{
+							Reception: domain.Reception{ID: receptionID, PVZID: pvzID, Status: domain.InProgress},
+						},
+						ReceptionsTotal: 3,
+						ProductsByType:  map[domain.ProductType]int{domain.Shoes: 2},
+					}, nil).
 					Once()
 			},
-			check: func(t *testing.T, result []domain.PVZReceptionsProducts, err error) {
+			check: func(t *testing.T, details domain.PVZDetails, err error) {
 				require.NoError(t, err)
-				require.Len(t, result, 1)
-				require.Equal(t, pvzID1, result[0].PVZ.ID)
-				require.Len(t, result[0].Receptions, 1)
-				require.Equal(t, receptionID1, result[0].Receptions[0].Reception.ID)
-
-				products := result[0].Receptions[0].Products
-				slices.SortFunc(products, func(p1, p2 domain.Product) int {
-					return p1.CreatedAt.Compare(p2.CreatedAt)
-				})
-
-				require.Len(t, products, 2)
-				require.Equal(t, now.Add(-2*time.Hour), products[0].CreatedAt)
-				require.Equal(t, productID1, products[1].ID)
+				require.Equal(t, pvzID, details.PVZ.ID)
+				require.Equal(t, receptionID, details.ActiveReception.Reception.ID)
+				require.Equal(t, 3, details.ReceptionsTotal)
+				require.Equal(t, 2, details.ProductsByType[domain.Shoes])
 			},
 		},
 		{
-			name:     "Success two products, two reception, one pvz",
-			authUser: nil,
-			from:     &now,
-			to:       &now,
-			page:     pointer.Ref(1),
-			limit:    pointer.Ref(10),
-			prepareMocks: func(
-				_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				receptionRepo *mocks.MockReceptionsRepository,
-				pvzRepo *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{
-					{
-						ID:          productID2,
-						ReceptionID: receptionID1,
-						Type:        "одежда",
-						CreatedAt:   now.Add(-2 * time.Hour),
-					},
-					{
-						ID:          productID1,
-						ReceptionID: receptionID1,
-						Type:        "электроника",
-						CreatedAt:   now,
-					},
-				}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, nil).
+			name:     "Not found",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
 					Once()
-
-				receptions := []domain.Reception{
-					{
-						ID:        receptionID1,
-						PVZID:     pvzID1,
-						Status:    domain.Close,
-						CreatedAt: now.Add(-2 * time.Hour),
-					},
-					{ID: receptionID2, PVZID: pvzID1, Status: domain.InProgress, CreatedAt: now},
-				}
-				receptionRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(receptions, nil).
-					Once()
-
-				pvzs := []domain.PVZ{
-					{ID: pvzID1, City: domain.Kzn, RegisteredAt: now},
-				}
-				pvzRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(pvzs, nil).
+				repo.EXPECT().FindDetails(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZDetails{}, domain.ErrPVZNotFound).
 					Once()
 			},
-			check: func(t *testing.T, result []domain.PVZReceptionsProducts, err error) {
-				require.NoError(t, err)
-				require.Len(t, result, 1)
-				require.Equal(t, pvzID1, result[0].PVZ.ID)
-
-				receptions := result[0].Receptions
-				slices.SortFunc(receptions, func(r1, r2 domain.ReceptionsProducts) int {
-					return r1.Reception.CreatedAt.Compare(r2.Reception.CreatedAt)
-				})
-				require.Len(t, receptions, 2)
-				require.Equal(t, domain.Close, receptions[0].Reception.Status)
-				require.Equal(t, receptionID2, receptions[1].Reception.ID)
-
-				products := result[0].Receptions[0].Products
-				slices.SortFunc(products, func(p1, p2 domain.Product) int {
-					return p1.CreatedAt.Compare(p2.CreatedAt)
-				})
-				require.Len(t, products, 2)
-				require.Equal(t, now.Add(-2*time.Hour), products[0].CreatedAt)
-				require.Equal(t, productID1, products[1].ID)
+			check: func(t *testing.T, _ domain.PVZDetails, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZDetails)
+				require.ErrorIs(t, err, domain.ErrPVZNotFound)
 			},
 		},
 		{
-			name:     "Success two products, two reception, two pvz",
+			name:     "Not authorized",
 			authUser: nil,
-			from:     &now,
-			to:       &now,
-			page:     pointer.Ref(1),
-			limit:    pointer.Ref(10),
-			prepareMocks: func(
-				_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				receptionRepo *mocks.MockReceptionsRepository,
-				pvzRepo *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{
-					{
-						ID:          productID2,
-						ReceptionID: receptionID1,
-						Type:        "одежда",
-						CreatedAt:   now.Add(-2 * time.Hour),
-					},
-					{
-						ID:          productID1,
-						ReceptionID: receptionID1,
-						Type:        "электроника",
-						CreatedAt:   now,
-					},
-				}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, nil).
-					Once()
-
-				receptions := []domain.Reception{
-					{
-						ID:        receptionID1,
-						PVZID:     pvzID1,
-						Status:    domain.Close,
-						CreatedAt: now.Add(-2 * time.Hour),
-					},
-					{ID: receptionID2, PVZID: pvzID1, Status: domain.InProgress, CreatedAt: now},
-				}
-				receptionRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(receptions, nil).
-					Once()
-
-				pvzs := []domain.PVZ{
-					{ID: pvzID1, City: domain.Kzn, RegisteredAt: now.Add(-2 * time.Hour)},
-					{ID: pvzID2, City: domain.Msk, RegisteredAt: now},
-				}
-				pvzRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(pvzs, nil).
-					Once()
-			},
-			check: func(t *testing.T, result []domain.PVZReceptionsProducts, err error) {
-				require.NoError(t, err)
-
-				pvzs := result
-				slices.SortFunc(pvzs, func(pvz1, pvz2 domain.PVZReceptionsProducts) int {
-					return pvz1.PVZ.RegisteredAt.Compare(pvz2.PVZ.RegisteredAt)
-				})
-				require.Len(t, result, 2)
-				require.Equal(t, now.Add(-2*time.Hour), result[0].PVZ.RegisteredAt)
-				require.Equal(t, pvzID2, result[1].PVZ.ID)
-
-				receptions := result[0].Receptions
-				slices.SortFunc(receptions, func(r1, r2 domain.ReceptionsProducts) int {
-					return r1.Reception.CreatedAt.Compare(r2.Reception.CreatedAt)
-				})
-				require.Len(t, receptions, 2)
-				require.Equal(t, domain.Close, receptions[0].Reception.Status)
-				require.Equal(t, receptionID2, receptions[1].Reception.ID)
-
-				products := result[0].Receptions[0].Products
-				slices.SortFunc(products, func(p1, p2 domain.Product) int {
-					return p1.CreatedAt.Compare(p2.CreatedAt)
-				})
-				require.Len(t, products, 2)
-				require.Equal(t, now.Add(-2*time.Hour), products[0].CreatedAt)
-				require.Equal(t, productID1, products[1].ID)
+			check: func(t *testing.T, _ domain.PVZDetails, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
 			},
 		},
 	}
@@ -450,7 +222,6 @@ func TestServicePVZ_FindPVZReceptionProducts(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			connection := mocks.NewMockConnection(t)
 			provider := mocks.NewMockConnectionProvider(t)
 
 			repoPVZ := mocks.NewMockPVZsRepository(t)
@@ -458,57 +229,302 @@ func TestServicePVZ_FindPVZReceptionProducts(t *testing.T) {
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
 
-			test.prepareMocks(connection, repoProduct, repoReception, repoPVZ)
-
-			provider.EXPECT().Execute(mock.Anything, mock.Anything).
-				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-					return f(ctx, connection)
-				}).
-				Times(3)
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoPVZ)
+			}
 
-			result, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
-				FindPVZReceptionProducts(t.Context(), test.authUser, test.from, test.to, test.page, test.limit)
-			test.check(t, result, err)
+			details, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
+				FindDetails(t.Context(), test.authUser, pvzID)
+			test.check(t, details, err)
 		})
 	}
 }
 
-func TestServicePVZ_FindPVZReceptionProducts_ErrProducts(t *testing.T) {
+func TestServicePVZ_FindPVZReceptionProducts(t *testing.T) {
 	t.Parallel()
 
 	now := time.Now()
+	pvzID1, pvzID2 := uuid.New(), uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+
+	tree := []domain.PVZReceptionsProducts{
+		{
+			PVZ: domain.PVZ{ID: pvzID1, City: domain.Kzn, RegisteredAt: now},
+			Receptions: []domain.ReceptionsProducts{
+				{
+					Reception: domain.Reception{
+						ID:        receptionID,
+						PVZID:     pvzID1,
+						Status:    domain.InProgress,
+						CreatedAt: now,
+					},
+					Products: []domain.Product{
+						{ID: productID, ReceptionID: receptionID, Type: domain.Electronics, CreatedAt: now},
+					},
+				},
+			},
+		},
+	}
 
 	tests := []struct {
 		name         string
-		authUser     domain.AuthenticatedUser
-		from, to     *time.Time
+		filter       domain.PVZFilter
 		page, limit  *int
-		prepareMocks func(*mocks.MockConnection, *mocks.MockProductsRepository, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository)
-		check        func(*testing.T, []domain.PVZReceptionsProducts, error)
+		cursor       *string
+		prepareMocks func(*mocks.MockPVZsRepository)
+		check        func(*testing.T, domain.PVZPage, error)
 	}{
 		{
-			name:     "DB Products Error",
-			authUser: nil,
-			from:     &now,
-			to:       &now,
-			page:     pointer.Ref(1),
-			limit:    pointer.Ref(10),
-			prepareMocks: func(
-				_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				_ *mocks.MockReceptionsRepository,
-				_ *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, errors.New("some error")).
-					Once()
-			},
-			check: func(t *testing.T, _ []domain.PVZReceptionsProducts, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "some error")
-				require.Contains(t, err.Error(), "search products failed")
+			name:   "Success full page",
+			filter: domain.PVZFilter{Period: domain.Period{From: &now, To: &now}},
+			page:   pointer.Ref(1),
+			limit:  pointer.Ref(1),
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Period: domain.Period{From: &now, To: &now}, Sort: domain.SortByRegisteredAt},
+						1,
+						1,
+						(*domain.Cursor)(nil),
+					).
+					Return(tree, nil).
+					Once()
+				pvzRepo.EXPECT().
+					Count(mock.Anything, mock.Anything, mock.Anything).
+					Return(len(tree), nil).
+					Once()
+			},
+			check: func(t *testing.T, page domain.PVZPage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, tree, page.Items)
+				require.Equal(t, len(tree), page.Total)
+				require.Equal(t, 1, page.Page)
+				require.Equal(t, 1, page.Limit)
+
+				require.NotNil(t, page.NextCursor)
+				cursor, err := domain.DecodeCursor(*page.NextCursor)
+				require.NoError(t, err)
+				require.Equal(t, pvzID1, cursor.ID)
+				require.True(t, now.Equal(cursor.At))
+			},
+		},
+		{
+			name: "Success default page",
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt},
+						domain.DefaultPage,
+						domain.DefaultLimit,
+						(*domain.Cursor)(nil),
+					).
+					Return(tree, nil).
+					Once()
+				pvzRepo.EXPECT().
+					Count(mock.Anything, mock.Anything, mock.Anything).
+					Return(len(tree), nil).
+					Once()
+			},
+			check: func(t *testing.T, page domain.PVZPage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, tree, page.Items)
+				require.Nil(t, page.NextCursor)
+			},
+		},
+		{
+			name: "Success no pvzs",
+			page: pointer.Ref(3),
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt},
+						3,
+						domain.DefaultLimit,
+						(*domain.Cursor)(nil),
+					).
+					Return(nil, nil).
+					Once()
+				pvzRepo.EXPECT().
+					Count(mock.Anything, mock.Anything, mock.Anything).
+					Return(0, nil).
+					Once()
+			},
+			check: func(t *testing.T, page domain.PVZPage, err error) {
+				require.NoError(t, err)
+				require.Empty(t, page.Items)
+				require.Nil(t, page.NextCursor)
+			},
+		},
+		{
+			name:   "Success cursor",
+			cursor: pointer.Ref(domain.Cursor{At: now, ID: pvzID1}.Encode()),
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByRegisteredAt},
+						domain.DefaultPage,
+						domain.DefaultLimit,
+						mock.MatchedBy(func(after *domain.Cursor) bool {
+							return after != nil && after.ID == pvzID1 && after.At.Equal(now)
+						}),
+					).
+					Return([]domain.PVZReceptionsProducts{{PVZ: domain.PVZ{ID: pvzID2}}}, nil).
+					Once()
+				pvzRepo.EXPECT().
+					Count(mock.Anything, mock.Anything, mock.Anything).
+					Return(1, nil).
+					Once()
+			},
+			check: func(t *testing.T, page domain.PVZPage, err error) {
+				require.NoError(t, err)
+				require.Nil(t, page.NextCursor)
+				require.Zero(t, page.Page)
+				require.Len(t, page.Items, 1)
+				require.Equal(t, pvzID2, page.Items[0].PVZ.ID)
+			},
+		},
+		{
+			name:  "Zero page",
+			page:  pointer.Ref(0),
+			limit: pointer.Ref(10),
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidPage)
+			},
+		},
+		{
+			name:  "Limit too big",
+			limit: pointer.Ref(domain.MaxLimit + 1),
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidPage)
+			},
+		},
+		{
+			name:   "Page with cursor",
+			page:   pointer.Ref(1),
+			cursor: pointer.Ref(domain.Cursor{At: now, ID: pvzID1}.Encode()),
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidPage)
+			},
+		},
+		{
+			name:   "Invalid cursor",
+			cursor: pointer.Ref("not a cursor"),
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidPage)
+				require.ErrorIs(t, err, domain.ErrInvalidCursor)
+			},
+		},
+		{
+			name:   "Full page sorted by product count has no cursor",
+			filter: domain.PVZFilter{Sort: domain.SortByProductCount, Descending: true},
+			limit:  pointer.Ref(1),
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Sort: domain.SortByProductCount, Descending: true},
+						domain.DefaultPage,
+						1,
+						(*domain.Cursor)(nil),
+					).
+					Return(tree, nil).
+					Once()
+				pvzRepo.EXPECT().
+					Count(mock.Anything, mock.Anything, mock.Anything).
+					Return(len(tree), nil).
+					Once()
+			},
+			check: func(t *testing.T, page domain.PVZPage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, tree, page.Items)
+				require.Nil(t, page.NextCursor)
+			},
+		},
+		{
+			name:   "Cursor with sort by last reception",
+			filter: domain.PVZFilter{Sort: domain.SortByLastReceptionAt},
+			cursor: pointer.Ref(domain.Cursor{At: now, ID: pvzID1}.Encode()),
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidPage)
+			},
+		},
+		{
+			name:   "Invalid city",
+			filter: domain.PVZFilter{Cities: []domain.PVZCity{"Владивосток"}},
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidFilter)
+				require.ErrorIs(t, err, domain.ErrFilterInvalidCity)
+			},
+		},
+		{
+			name:   "Invalid product status",
+			filter: domain.PVZFilter{ProductStatuses: []domain.ProductStatus{"lost"}},
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidFilter)
+				require.ErrorIs(t, err, domain.ErrFilterInvalidProductStatus)
+			},
+		},
+		{
+			name:   "Invalid sort",
+			filter: domain.PVZFilter{Sort: "city"},
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsInvalidFilter)
+				require.ErrorIs(t, err, domain.ErrFilterInvalidSort)
+			},
+		},
+		{
+			name: "DB Count Error",
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+					).
+					Return(tree, nil).
+					Once()
+				pvzRepo.EXPECT().
+					Count(mock.Anything, mock.Anything, mock.Anything).
+					Return(0, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, page domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsSearchPVZs)
+				require.ErrorContains(t, err, "some error")
+				require.Empty(t, page.Items)
+			},
+		},
+		{
+			name: "DB Error",
+			prepareMocks: func(pvzRepo *mocks.MockPVZsRepository) {
+				pvzRepo.EXPECT().
+					SearchReceptionsProducts(
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+						mock.Anything,
+					).
+					Return(nil, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.PVZPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindPVZReceptionProductsSearchPVZs)
+				require.ErrorContains(t, err, "some error")
 			},
 		},
 	}
@@ -517,7 +533,6 @@ func TestServicePVZ_FindPVZReceptionProducts_ErrProducts(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			connection := mocks.NewMockConnection(t)
 			provider := mocks.NewMockConnectionProvider(t)
 
 			repoPVZ := mocks.NewMockPVZsRepository(t)
@@ -525,63 +540,147 @@ func TestServicePVZ_FindPVZReceptionProducts_ErrProducts(t *testing.T) {
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
 
-			test.prepareMocks(connection, repoProduct, repoReception, repoPVZ)
+			if test.prepareMocks != nil {
+				test.prepareMocks(repoPVZ)
 
-			provider.EXPECT().Execute(mock.Anything, mock.Anything).
-				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-					return f(ctx, connection)
-				}).
-				Once()
+				provider.EXPECT().ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+			}
 
-			result, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
-				FindPVZReceptionProducts(t.Context(), test.authUser, test.from, test.to, test.page, test.limit)
-			test.check(t, result, err)
+			page, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
+				FindPVZReceptionProducts(
+					t.Context(),
+					fixtureAuthUser(t, domain.Employee),
+					test.filter,
+					test.page,
+					test.limit,
+					test.cursor,
+				)
+			test.check(t, page, err)
 		})
 	}
 }
 
-func TestServicePVZ_FindPVZReceptionProducts_ErrReceptions(t *testing.T) {
+func TestServicePVZ_FindPVZReceptionProducts_NotAuthorized(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
-	pvzID := uuid.New()
-	receptionID := uuid.New()
-	productID1 := uuid.New()
+	_, err := domain.NewPVZService(
+		mocks.NewMockConnectionProvider(t),
+		mocks.NewMockPVZsRepository(t),
+		mocks.NewMockProductsRepository(t),
+		mocks.NewMockReceptionsRepository(t),
+		mocks.NewMockMetrics(t),
+	).FindPVZReceptionProducts(t.Context(), nil, domain.PVZFilter{}, nil, nil, nil)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+}
+
+func TestServicePVZ_Export(t *testing.T) {
+	t.Parallel()
+
+	row := domain.ExportRow{PVZ: domain.PVZ{ID: uuid.New()}, Product: domain.Product{ID: uuid.New()}}
+	stop := errors.New("client gone")
 
 	tests := []struct {
 		name         string
-		authUser     domain.AuthenticatedUser
-		from, to     *time.Time
-		page, limit  *int
-		prepareMocks func(*mocks.MockConnection, *mocks.MockProductsRepository, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository)
-		check        func(*testing.T, []domain.PVZReceptionsProducts, error)
+		filter       domain.PVZFilter
+		each         func(domain.ExportRow) error
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, error)
 	}{
 		{
-			prepareMocks: func(_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				receptionRepo *mocks.MockReceptionsRepository,
-				_ *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{
-					{ID: productID1, ReceptionID: receptionID, Type: "electronics", CreatedAt: now},
+			name: "Success",
+			filter: domain.PVZFilter{
+				Cities: []domain.PVZCity{domain.Kzn},
+			},
+			each: func(got domain.ExportRow) error {
+				if got != row {
+					return errors.New("unexpected row")
 				}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, nil).
-					Once()
 
-				receptions := []domain.Reception{
-					{ID: receptionID, PVZID: pvzID, Status: domain.InProgress, CreatedAt: now},
-				}
-				receptionRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(receptions, errors.New("some error")).
+				return nil
+			},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					Export(
+						mock.Anything,
+						mock.Anything,
+						domain.PVZFilter{Cities: []domain.PVZCity{domain.Kzn}, Sort: domain.SortByRegisteredAt},
+						mock.Anything,
+					).
+					RunAndReturn(func(
+						_ context.Context,
+						_ domain.Connection,
+						_ domain.PVZFilter,
+						each func(domain.ExportRow) error,
+					) error {
+						return each(row)
+					}).
 					Once()
 			},
-			check: func(t *testing.T, _ []domain.PVZReceptionsProducts, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "some error")
-				require.Contains(t, err.Error(), "search reseptions failed")
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "Invalid filter",
+			filter: domain.PVZFilter{Sort: "city"},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceExportInvalidFilter)
+				require.ErrorIs(t, err, domain.ErrFilterInvalidSort)
+			},
+		},
+		{
+			name: "Each error is returned as is",
+			each: func(domain.ExportRow) error { return stop },
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					Export(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(
+						_ context.Context,
+						_ domain.Connection,
+						_ domain.PVZFilter,
+						each func(domain.ExportRow) error,
+					) error {
+						return errors.Join(errors.New("export failed"), each(row))
+					}).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.Equal(t, stop, err)
+			},
+		},
+		{
+			name: "Repository error",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					Export(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceExport)
+				require.ErrorContains(t, err, "some error")
 			},
 		},
 	}
@@ -590,79 +689,102 @@ func TestServicePVZ_FindPVZReceptionProducts_ErrReceptions(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			connection := mocks.NewMockConnection(t)
 			provider := mocks.NewMockConnectionProvider(t)
-
 			repoPVZ := mocks.NewMockPVZsRepository(t)
-			repoProduct := mocks.NewMockProductsRepository(t)
-			repoReception := mocks.NewMockReceptionsRepository(t)
-			metrics := mocks.NewMockMetrics(t)
-
-			test.prepareMocks(connection, repoProduct, repoReception, repoPVZ)
 
-			provider.EXPECT().Execute(mock.Anything, mock.Anything).
-				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-					return f(ctx, connection)
-				}).
-				Times(2)
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoPVZ)
+			}
 
-			result, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
-				FindPVZReceptionProducts(t.Context(), test.authUser, test.from, test.to, test.page, test.limit)
-			test.check(t, result, err)
+			err := domain.NewPVZService(
+				provider,
+				repoPVZ,
+				mocks.NewMockProductsRepository(t),
+				mocks.NewMockReceptionsRepository(t),
+				mocks.NewMockMetrics(t),
+			).Export(t.Context(), fixtureAuthUser(t, domain.Moderator), test.filter, test.each)
+			test.check(t, err)
 		})
 	}
 }
 
-func TestServicePVZ_FindPVZReceptionProducts_ErrPVZs(t *testing.T) {
+func TestServicePVZ_Export_NotAuthorized(t *testing.T) {
 	t.Parallel()
 
-	now := time.Now()
-	pvzID := uuid.New()
-	receptionID := uuid.New()
-	productID1 := uuid.New()
+	err := domain.NewPVZService(
+		mocks.NewMockConnectionProvider(t),
+		mocks.NewMockPVZsRepository(t),
+		mocks.NewMockProductsRepository(t),
+		mocks.NewMockReceptionsRepository(t),
+		mocks.NewMockMetrics(t),
+	).Export(t.Context(), nil, domain.PVZFilter{}, nil)
+	require.ErrorIs(t, err, domain.ErrNotAuthorized)
+}
+
+func TestServicePVZ_Stream(t *testing.T) {
+	t.Parallel()
+
+	item := domain.PVZReceptionsProducts{PVZ: domain.PVZ{ID: uuid.New()}}
+	stop := errors.New("client gone")
 
 	tests := []struct {
 		name         string
-		authUser     domain.AuthenticatedUser
-		from, to     *time.Time
-		page, limit  *int
-		prepareMocks func(*mocks.MockConnection, *mocks.MockProductsRepository, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository)
-		check        func(*testing.T, []domain.PVZReceptionsProducts, error)
+		filter       domain.PVZFilter
+		each         func(domain.PVZReceptionsProducts) error
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository)
+		check        func(*testing.T, error)
 	}{
 		{
-			prepareMocks: func(_ *mocks.MockConnection,
-				productRepo *mocks.MockProductsRepository,
-				receptionRepo *mocks.MockReceptionsRepository,
-				pvzRepo *mocks.MockPVZsRepository,
-			) {
-				products := []domain.Product{
-					{ID: productID1, ReceptionID: receptionID, Type: "electronics", CreatedAt: now},
-				}
-				productRepo.EXPECT().
-					Search(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-					Return(products, nil).
+			name: "Each error is returned as is",
+			each: func(domain.PVZReceptionsProducts) error { return stop },
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
 					Once()
-
-				receptions := []domain.Reception{
-					{ID: receptionID, PVZID: pvzID, Status: domain.InProgress, CreatedAt: now},
-				}
-				receptionRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(receptions, nil).
+				repo.EXPECT().
+					Stream(mock.Anything, mock.Anything, domain.PVZFilter{Sort: domain.SortByRegisteredAt}, mock.Anything).
+					RunAndReturn(func(
+						_ context.Context,
+						_ domain.Connection,
+						_ domain.PVZFilter,
+						each func(domain.PVZReceptionsProducts) error,
+					) error {
+						return errors.Join(errors.New("stream failed"), each(item))
+					}).
 					Once()
-
-				pvzs := []domain.PVZ{
-					{ID: pvzID, City: domain.Kzn, RegisteredAt: now},
-				}
-				pvzRepo.EXPECT().
-					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
-					Return(pvzs, errors.New("some error")).
+			},
+			check: func(t *testing.T, err error) {
+				require.Equal(t, stop, err)
+			},
+		},
+		{
+			name:   "Invalid filter",
+			filter: domain.PVZFilter{Cities: []domain.PVZCity{"Новосибирск"}},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceStreamInvalidFilter)
+				require.ErrorIs(t, err, domain.ErrFilterInvalidCity)
+			},
+		},
+		{
+			name: "Repository error",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockPVZsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					Stream(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(context.Canceled).
 					Once()
 			},
-			check: func(t *testing.T, _ []domain.PVZReceptionsProducts, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "some error")
-				require.Contains(t, err.Error(), "search pvzs failed")
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceStream)
+				require.ErrorIs(t, err, context.Canceled)
 			},
 		},
 	}
@@ -671,25 +793,21 @@ func TestServicePVZ_FindPVZReceptionProducts_ErrPVZs(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			connection := mocks.NewMockConnection(t)
 			provider := mocks.NewMockConnectionProvider(t)
-
 			repoPVZ := mocks.NewMockPVZsRepository(t)
-			repoProduct := mocks.NewMockProductsRepository(t)
-			repoReception := mocks.NewMockReceptionsRepository(t)
-			metrics := mocks.NewMockMetrics(t)
-
-			test.prepareMocks(connection, repoProduct, repoReception, repoPVZ)
 
-			provider.EXPECT().Execute(mock.Anything, mock.Anything).
-				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-					return f(ctx, connection)
-				}).
-				Times(3)
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoPVZ)
+			}
 
-			result, err := domain.NewPVZService(provider, repoPVZ, repoProduct, repoReception, metrics).
-				FindPVZReceptionProducts(t.Context(), test.authUser, test.from, test.to, test.page, test.limit)
-			test.check(t, result, err)
+			err := domain.NewPVZService(
+				provider,
+				repoPVZ,
+				mocks.NewMockProductsRepository(t),
+				mocks.NewMockReceptionsRepository(t),
+				mocks.NewMockMetrics(t),
+			).Stream(t.Context(), fixtureAuthUser(t, domain.Employee), test.filter, test.each)
+			test.check(t, err)
 		})
 	}
 }
@@ -3,6 +3,9 @@ package domain
 import (
 	"context"
 	"errors"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +31,18 @@ var (
 		errAvitoServiceCreateReception,
 		errors.New("find active failed"),
 	)
+	ErrAvitoServiceCreateReceptionFindPVZ = errors.Join(
+		errAvitoServiceCreateReception,
+		errors.New("find pvz failed"),
+	)
+	ErrAvitoServiceCreateReceptionOutsideWorkingHours = errors.Join(
+		errAvitoServiceCreateReception,
+		errors.New("pvz is closed"),
+	)
+	ErrAvitoServiceCreateReceptionInvalidManifest = errors.Join(
+		errAvitoServiceCreateReception,
+		errors.New("invalid manifest"),
+	)
 	errAvitoServiceCloseReception           = errors.Join(errReception, errors.New("close failed"))
 	ErrAvitoServiceCloseReceptionFindActive = errors.Join(
 		errAvitoServiceCloseReception,
@@ -37,6 +52,65 @@ var (
 		errAvitoServiceCloseReception,
 		errors.New("close failed"),
 	)
+	ErrAvitoServiceCloseReceptionReconcile = errors.Join(
+		errAvitoServiceCloseReception,
+		errors.New("reconcile manifest failed"),
+	)
+	ErrAvitoServiceCloseReceptionSummarize = errors.Join(
+		errAvitoServiceCloseReception,
+		errors.New("summarize products failed"),
+	)
+
+	errAvitoServiceFindReceptions = errors.Join(
+		errReception,
+		errors.New("find receptions failed"),
+	)
+	ErrAvitoServiceFindReceptionsInvalidFilter = errors.Join(
+		errAvitoServiceFindReceptions,
+		errors.New("invalid filter"),
+	)
+	ErrAvitoServiceFindReceptionsInvalidPage = errors.Join(
+		errAvitoServiceFindReceptions,
+		errors.New("invalid page"),
+	)
+	ErrAvitoServiceFindReceptionsFindPVZ = errors.Join(
+		errAvitoServiceFindReceptions,
+		errors.New("find pvz failed"),
+	)
+	ErrAvitoServiceFindReceptions = errors.Join(
+		errAvitoServiceFindReceptions,
+		errors.New("search receptions failed"),
+	)
+	ErrAvitoServiceFindReceptionDetails = errors.Join(
+		errReception,
+		errors.New("find details failed"),
+	)
+
+	errAvitoServiceReopenReception = errors.Join(
+		errReception,
+		errors.New("reopen failed"),
+	)
+	ErrAvitoServiceReopenReceptionEmptyReason = errors.Join(
+		errAvitoServiceReopenReception,
+		errors.New("empty reason"),
+	)
+	ErrAvitoServiceReopenReceptionFind = errors.Join(
+		errAvitoServiceReopenReception,
+		errors.New("find failed"),
+	)
+	ErrAvitoServiceReopenReception = errors.Join(
+		errAvitoServiceReopenReception,
+		errors.New("reopen failed"),
+	)
+
+	ErrAvitoServiceCloseStaleInvalidIdle = errors.Join(
+		errReception,
+		errors.New("invalid stale reception idle time"),
+	)
+	ErrAvitoServiceCloseStale = errors.Join(
+		errReception,
+		errors.New("close stale failed"),
+	)
 
 	errProduct                         = errors.New("products service error")
 	ErrAvitoServiceProductInvalidPVZID = errors.Join(errProduct, errors.New("invalid pvz id"))
@@ -48,10 +122,38 @@ var (
 		errAvitoServiceCreateProduct,
 		errors.New("create product failed"),
 	)
+	ErrAvitoServiceCreateProductAllocateCell = errors.Join(
+		errAvitoServiceCreateProduct,
+		errors.New("allocate storage cell failed"),
+	)
 	ErrAvitoServiceCreateProductFindActive = errors.Join(
 		errAvitoServiceCreateProduct,
 		errors.New("find active failed"),
 	)
+	ErrAvitoServiceCreateProductsInvalidBatch = errors.Join(
+		errAvitoServiceCreateProduct,
+		errors.New("invalid batch"),
+	)
+	ErrAvitoServiceCreateProductInvalidDraft = errors.Join(
+		errAvitoServiceCreateProduct,
+		errors.New("invalid product"),
+	)
+	ErrAvitoServiceCreateProductFindDuplicates = errors.Join(
+		errAvitoServiceCreateProduct,
+		errors.New("find duplicates failed"),
+	)
+	errAvitoServiceFindProductsByBarcode = errors.Join(
+		errProduct,
+		errors.New("find by barcode failed"),
+	)
+	ErrAvitoServiceFindProductsByBarcodeEmpty = errors.Join(
+		errAvitoServiceFindProductsByBarcode,
+		errors.New("empty barcode"),
+	)
+	ErrAvitoServiceFindProductsByBarcode = errors.Join(
+		errAvitoServiceFindProductsByBarcode,
+		errors.New("find by barcode failed"),
+	)
 	errAvitoServiceDeleteProduct = errors.Join(
 		errProduct,
 		errors.New("delete product failed"),
@@ -64,12 +166,33 @@ var (
 		errAvitoServiceDeleteProduct,
 		errors.New("find active failed"),
 	)
+	errAvitoServiceChangeProductStatus = errors.Join(
+		errProduct,
+		errors.New("change status failed"),
+	)
+	ErrAvitoServiceChangeProductStatusInvalidID = errors.Join(
+		errAvitoServiceChangeProductStatus,
+		errors.New("invalid product id"),
+	)
+	ErrAvitoServiceChangeProductStatusFind = errors.Join(
+		errAvitoServiceChangeProductStatus,
+		errors.New("find failed"),
+	)
+	ErrAvitoServiceChangeProductStatus = errors.Join(
+		errAvitoServiceChangeProductStatus,
+		errors.New("change status failed"),
+	)
 )
 
 type ReceptionService struct {
 	provider      ConnectionProvider
 	receptionRepo ReceptionsRepository
 	productRepo   ProductsRepository
+	pvzRepo       PVZsRepository
+	analyticsRepo AnalyticsRepository
+	typeRepo      ProductTypesRepository
+	orderRepo     OrdersRepository
+	cellRepo      StorageCellsRepository
 	metrics       Metrics
 }
 
@@ -77,55 +200,130 @@ func NewReceptionService(
 	provider ConnectionProvider,
 	receptionRepo ReceptionsRepository,
 	productRepo ProductsRepository,
+	pvzRepo PVZsRepository,
+	analyticsRepo AnalyticsRepository,
+	typeRepo ProductTypesRepository,
+	orderRepo OrdersRepository,
+	cellRepo StorageCellsRepository,
 	metrics Metrics,
 ) *ReceptionService {
 	return &ReceptionService{
 		provider:      provider,
 		receptionRepo: receptionRepo,
 		productRepo:   productRepo,
+		pvzRepo:       pvzRepo,
+		analyticsRepo: analyticsRepo,
+		typeRepo:      typeRepo,
+		orderRepo:     orderRepo,
+		cellRepo:      cellRepo,
 		metrics:       metrics,
 	}
 }
 
-func validPVZID(id PVZID) error {
+// validID checks an identifier of any entity, all of them are UUIDs.
+func validID(id uuid.UUID) error {
 	if id == uuid.Nil || uuid.Validate(id.String()) != nil {
 		return errors.New("uuid is not valid")
 	}
 	return nil
 }
 
+// canOpenReception allows employees to open receptions during working hours
+// and moderators to force one open outside of them.
+func canOpenReception(authUser AuthenticatedUser, override bool) bool {
+	if authUser == nil {
+		return false
+	}
+
+	switch authUser.GetUserRole() {
+	case Employee:
+		return !override
+	case Moderator:
+		return override
+	default:
+		return false
+	}
+}
+
 func (s *ReceptionService) Create(
 	ctx context.Context,
 	authUser AuthenticatedUser,
 	pvzID PVZID,
+	override bool,
+	manifest ReceptionManifest,
 ) (Reception, error) {
-	if authUser == nil || authUser.GetUserRole() != Employee {
+	if !canOpenReception(authUser, override) {
 		return Reception{}, ErrNotAuthorized
 	}
 
 	var reception Reception
 
-	errValidID := validPVZID(pvzID)
+	if err := manifest.Validate(); err != nil {
+		return reception, errors.Join(ErrAvitoServiceCreateReceptionInvalidManifest, err)
+	}
+
+	errValidID := validID(pvzID)
 	if errValidID != nil {
 		return reception, errors.Join(errValidID, ErrAvitoServiceReceptionInvalidPVZID)
 	}
 
+	if !override {
+		var pvz PVZ
+		var errFindPVZ error
+		err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+			pvz, errFindPVZ = s.pvzRepo.FindByID(ctx, c, pvzID)
+			return errFindPVZ
+		})
+		if err != nil {
+			return reception, errors.Join(ErrAvitoServiceCreateReceptionFindPVZ, err)
+		}
+
+		open, err := pvz.IsOpenAt(time.Now())
+		if err != nil {
+			return reception, errors.Join(ErrAvitoServiceCreateReceptionFindPVZ, err)
+		}
+		if !open {
+			return reception, ErrAvitoServiceCreateReceptionOutsideWorkingHours
+		}
+	}
+
+	// The repository fails with ErrReceptionInProgress when another reception
+	// of the PVZ is open, including one opened by a concurrent transaction.
+	openedBy := authUser.GetUserID()
 	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if len(manifest) > 0 {
+			if err := checkProductTypes(ctx, c, s.typeRepo, manifest.Types()); err != nil {
+				return errors.Join(ErrAvitoServiceCreateReceptionInvalidManifest, err)
+			}
+		}
+
 		reception = Reception{
-			ID:    uuid.New(),
-			PVZID: pvzID,
+			ID:        uuid.New(),
+			PVZID:     pvzID,
+			CreatedBy: &openedBy,
 		}
-		return s.receptionRepo.Create(ctx, c, reception)
-	})
-	if err != nil {
-		return reception, errors.Join(ErrAvitoServiceCreateReception, err)
-	}
-	err = s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
+		if err := s.receptionRepo.Create(ctx, c, reception); err != nil {
+			return errors.Join(ErrAvitoServiceCreateReception, err)
+		}
+		if err := s.analyticsRepo.AddReception(ctx, c, reception.ID); err != nil {
+			return errors.Join(ErrAvitoServiceCreateReception, err)
+		}
+		if len(manifest) > 0 {
+			if err := s.receptionRepo.SaveManifest(ctx, c, reception.ID, manifest); err != nil {
+				return errors.Join(ErrAvitoServiceCreateReception, err)
+			}
+		}
+
+		var err error
 		reception, err = s.receptionRepo.FindActive(ctx, c, pvzID)
-		return err
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCreateReceptionFindActive, err)
+		}
+
+		return nil
 	})
 	if err != nil {
-		return reception, errors.Join(ErrAvitoServiceCreateReceptionFindActive, err)
+		return reception, err
 	}
 
 	s.metrics.IncReceptions()
@@ -137,74 +335,379 @@ func (s *ReceptionService) Close(
 	ctx context.Context,
 	authUser AuthenticatedUser,
 	pvzID PVZID,
-) (Reception, error) {
+) (ClosedReception, error) {
 	if authUser == nil || authUser.GetUserRole() != Employee {
-		return Reception{}, ErrNotAuthorized
+		return ClosedReception{}, ErrNotAuthorized
 	}
 
-	var reception Reception
+	var closed ClosedReception
 
-	errValidID := validPVZID(pvzID)
+	errValidID := validID(pvzID)
 	if errValidID != nil {
-		return reception, errors.Join(errValidID, ErrAvitoServiceReceptionInvalidPVZID)
+		return closed, errors.Join(errValidID, ErrAvitoServiceReceptionInvalidPVZID)
 	}
 
-	var errFindActive error
-	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		reception, errFindActive = s.receptionRepo.FindActive(ctx, c, pvzID)
-		return errFindActive
+	// The active reception is locked for the transaction, a concurrent close
+	// finds no active reception and the repository fails with
+	// ErrReceptionNotFound on a reception that is no longer in progress.
+	closedBy := authUser.GetUserID()
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		closed.Reception, err = s.receptionRepo.FindActive(ctx, c, pvzID)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCloseReceptionFindActive, err)
+		}
+
+		if err := s.receptionRepo.Close(ctx, c, closed.ID, closedBy); err != nil {
+			return err
+		}
+		if err := s.analyticsRepo.CloseReception(ctx, c, closed.ID); err != nil {
+			return err
+		}
+		if err := s.productRepo.StoreReceived(ctx, c, []ReceptionID{closed.ID}, &closedBy); err != nil {
+			return err
+		}
+
+		products, err := s.productRepo.FindByReceptionIDs(ctx, c, []ReceptionID{closed.ID})
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCloseReceptionSummarize, err)
+		}
+		if err := s.freeReturnedCells(ctx, c, products); err != nil {
+			return err
+		}
+		closed.Summary = SummarizeProducts(products)
+
+		report, ok, err := s.reconcile(ctx, c, closed.ID, products)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCloseReceptionReconcile, err)
+		}
+		if ok {
+			closed.Discrepancy = &report
+		}
+
+		return nil
 	})
 	if err != nil {
-		return reception, errors.Join(ErrAvitoServiceCloseReceptionFindActive, err)
+		return closed, errors.Join(ErrAvitoServiceCloseReception, err)
 	}
-	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
-		return s.receptionRepo.Close(ctx, c, reception.ID)
+	closed.ClosedBy = &closedBy
+
+	return closed, nil
+}
+
+// freeReturnedCells frees the cells of the returned products of closed
+// receptions: a product inspected as rejected is returned on close and leaves
+// the PVZ.
+func (s *ReceptionService) freeReturnedCells(ctx context.Context, c Connection, products []Product) error {
+	var returnedIDs []ProductID
+	for _, product := range products {
+		if product.Status == ProductReturned {
+			returnedIDs = append(returnedIDs, product.ID)
+		}
+	}
+	if len(returnedIDs) == 0 {
+		return nil
+	}
+
+	return s.cellRepo.Free(ctx, c, returnedIDs)
+}
+
+// reconcile compares the products of a reception being closed with its
+// manifest and keeps the report. ok is false when there is no manifest.
+func (s *ReceptionService) reconcile(
+	ctx context.Context,
+	c Connection,
+	receptionID ReceptionID,
+	products []Product,
+) (DiscrepancyReport, bool, error) {
+	manifest, err := s.receptionRepo.FindManifest(ctx, c, receptionID)
+	if err != nil {
+		return DiscrepancyReport{}, false, err
+	}
+	if len(manifest) == 0 {
+		return DiscrepancyReport{}, false, nil
+	}
+
+	report := manifest.Compare(products)
+	if err := s.receptionRepo.SaveDiscrepancy(ctx, c, receptionID, report); err != nil {
+		return DiscrepancyReport{}, false, err
+	}
+
+	return report, true, nil
+}
+
+// Reopen lets a moderator put a closed reception back in progress when it is
+// the latest reception of its PVZ. The reason is kept with the reopening.
+func (s *ReceptionService) Reopen(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	receptionID ReceptionID,
+	reason string,
+) (Reception, error) {
+	if authUser == nil || authUser.GetUserRole() != Moderator {
+		return Reception{}, ErrNotAuthorized
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return Reception{}, ErrAvitoServiceReopenReceptionEmptyReason
+	}
+
+	var reception Reception
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		found, err := s.receptionRepo.FindByIDs(ctx, c, []ReceptionID{receptionID})
+		if err != nil {
+			return errors.Join(ErrAvitoServiceReopenReceptionFind, err)
+		}
+		if len(found) == 0 {
+			return errors.Join(ErrAvitoServiceReopenReceptionFind, ErrReceptionNotFound)
+		}
+		reception = found[0]
+
+		if err := s.analyticsRepo.ReopenReception(ctx, c, receptionID); err != nil {
+			return errors.Join(ErrAvitoServiceReopenReception, err)
+		}
+
+		err = s.receptionRepo.Reopen(ctx, c, ReceptionReopening{
+			ID:          uuid.New(),
+			ReceptionID: receptionID,
+			ReopenedBy:  authUser.GetUserID(),
+			Reason:      reason,
+		})
+		if err != nil {
+			return errors.Join(ErrAvitoServiceReopenReception, err)
+		}
+
+		return nil
 	})
 	if err != nil {
-		return reception, errors.Join(ErrAvitoServiceCloseReception, err)
+		return Reception{}, err
 	}
 
+	reception.Status = InProgress
+	reception.ClosedBy = nil
+
 	return reception, nil
 }
 
+// CloseStale closes the receptions left in progress with no product added for
+// idleFor. It is run by a background job and is safe to run on several
+// replicas at once: every reception is closed by one of them only. As on a
+// manual close, the received products go to storage and receptions opened
+// with a manifest get their discrepancy report.
+func (s *ReceptionService) CloseStale(ctx context.Context, idleFor time.Duration) ([]Reception, error) {
+	if idleFor <= 0 {
+		return nil, ErrAvitoServiceCloseStaleInvalidIdle
+	}
+
+	var closed []Reception
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		closed, err = s.receptionRepo.CloseStale(ctx, c, time.Now().Add(-idleFor))
+		if err != nil {
+			return err
+		}
+
+		if len(closed) == 0 {
+			return nil
+		}
+
+		receptionIDs := make([]ReceptionID, 0, len(closed))
+		for _, reception := range closed {
+			receptionIDs = append(receptionIDs, reception.ID)
+		}
+		if err := s.productRepo.StoreReceived(ctx, c, receptionIDs, nil); err != nil {
+			return err
+		}
+		products, err := s.productRepo.FindByReceptionIDs(ctx, c, receptionIDs)
+		if err != nil {
+			return err
+		}
+		if err := s.freeReturnedCells(ctx, c, products); err != nil {
+			return err
+		}
+		byReception := make(map[ReceptionID][]Product, len(closed))
+		for _, product := range products {
+			byReception[product.ReceptionID] = append(byReception[product.ReceptionID], product)
+		}
+
+		for _, reception := range closed {
+			if err := s.analyticsRepo.CloseReception(ctx, c, reception.ID); err != nil {
+				return err
+			}
+			if _, _, err := s.reconcile(ctx, c, reception.ID, byReception[reception.ID]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAvitoServiceCloseStale, err)
+	}
+
+	for range closed {
+		s.metrics.IncStaleReceptionsClosed()
+	}
+
+	return closed, nil
+}
+
+// FindByPVZ pages through the receptions of a PVZ matching the filter, newest
+// first, with the number of products of each.
+func (s *ReceptionService) FindByPVZ(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID PVZID,
+	filter ReceptionFilter,
+	page, limit *int,
+) (ReceptionPage, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return ReceptionPage{}, ErrNotAuthorized
+	}
+
+	if err := filter.Validate(); err != nil {
+		return ReceptionPage{}, errors.Join(ErrAvitoServiceFindReceptionsInvalidFilter, err)
+	}
+
+	result := ReceptionPage{Page: DefaultPage, Limit: DefaultLimit}
+	if page != nil {
+		result.Page = *page
+	}
+	if limit != nil {
+		result.Limit = *limit
+	}
+	if result.Page < 1 || result.Limit < 1 || result.Limit > MaxLimit {
+		return ReceptionPage{}, ErrAvitoServiceFindReceptionsInvalidPage
+	}
+
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		if _, err := s.pvzRepo.FindByID(ctx, c, pvzID); err != nil {
+			return errors.Join(ErrAvitoServiceFindReceptionsFindPVZ, err)
+		}
+
+		var err error
+		result.Items, err = s.receptionRepo.FindByPVZ(ctx, c, pvzID, filter, result.Page, result.Limit)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceFindReceptions, err)
+		}
+
+		result.Total, err = s.receptionRepo.CountByPVZ(ctx, c, pvzID, filter)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceFindReceptions, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return ReceptionPage{}, err
+	}
+
+	return result, nil
+}
+
+// FindDetails returns a reception with its PVZ, timeline and products for
+// support staff looking into a dispute.
+func (s *ReceptionService) FindDetails(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	receptionID ReceptionID,
+) (ReceptionDetails, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return ReceptionDetails{}, ErrNotAuthorized
+	}
+
+	var details ReceptionDetails
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		details, err = s.receptionRepo.FindDetails(ctx, c, receptionID)
+
+		return err
+	})
+	if err != nil {
+		return ReceptionDetails{}, errors.Join(ErrAvitoServiceFindReceptionDetails, err)
+	}
+
+	return details, nil
+}
+
+// CreateProduct adds the product to the active reception of the PVZ. A
+// barcode already in the reception is rejected, the same barcode in other
+// receptions in progress is returned as duplicates.
 func (s *ReceptionService) CreateProduct(
 	ctx context.Context,
 	authUser AuthenticatedUser,
 	pvzID PVZID,
-	productType ProductType,
-) (Product, error) {
+	draft ProductDraft,
+) (AddedProduct, error) {
 	if authUser == nil || authUser.GetUserRole() != Employee {
-		return Product{}, ErrNotAuthorized
+		return AddedProduct{}, ErrNotAuthorized
 	}
 
-	var product Product
-	errValidID := validPVZID(pvzID)
+	var product AddedProduct
+	errValidID := validID(pvzID)
 	if errValidID != nil {
 		return product, errors.Join(errValidID, ErrAvitoServiceProductInvalidPVZID)
 	}
-
-	var errFindActive error
-	var reception Reception
-
-	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		reception, errFindActive = s.receptionRepo.FindActive(ctx, c, pvzID)
-		return errFindActive
-	})
+	draft, err := draft.Normalize()
 	if err != nil {
-		return product, errors.Join(ErrAvitoServiceCreateProductFindActive, err)
+		return product, errors.Join(ErrAvitoServiceCreateProductInvalidDraft, err)
 	}
+
+	createdBy := authUser.GetUserID()
+	// The active reception is locked in the transaction of the insert, so a
+	// close waits for the product and its cell instead of missing them.
 	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
-		product = Product{
-			ID:          uuid.New(),
-			ReceptionID: reception.ID,
-			Type:        productType,
-			CreatedAt:   time.Now(),
+		if err := checkProductTypes(ctx, c, s.typeRepo, []ProductType{draft.Type}); err != nil {
+			return errors.Join(ErrAvitoServiceCreateProductInvalidDraft, err)
 		}
 
-		return s.productRepo.Create(ctx, c, product)
+		reception, err := s.receptionRepo.FindActive(ctx, c, pvzID)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCreateProductFindActive, err)
+		}
+
+		duplicates, err := s.findDuplicates(ctx, c, reception.ID, []ProductDraft{draft})
+		if err != nil {
+			return err
+		}
+
+		product = AddedProduct{
+			Product: Product{
+				ID:                uuid.New(),
+				ReceptionID:       reception.ID,
+				Type:              draft.Type,
+				CreatedAt:         time.Now(),
+				CreatedBy:         &createdBy,
+				Barcode:           draft.Barcode,
+				Status:            ProductReceived,
+				ProductAttributes: draft.ProductAttributes,
+			},
+		}
+		if draft.Barcode != nil {
+			product.Duplicates = duplicates[*draft.Barcode]
+		}
+
+		if err := s.productRepo.Create(ctx, c, product.Product); err != nil {
+			return errors.Join(ErrAvitoServiceCreateProduct, err)
+		}
+		if err := s.analyticsRepo.AddProduct(ctx, c, product.ID); err != nil {
+			return errors.Join(ErrAvitoServiceCreateProduct, err)
+		}
+		// A rejected product is returned on close, it takes no cell.
+		if !product.Rejected() {
+			product.Cell, err = s.cellRepo.Allocate(ctx, c, pvzID, product.Product)
+			if err != nil {
+				return errors.Join(ErrAvitoServiceCreateProductAllocateCell, err)
+			}
+		}
+
+		return nil
 	})
 	if err != nil {
-		return product, errors.Join(ErrAvitoServiceCreateProduct, err)
+		return product, err
 	}
 
 	s.metrics.IncProducts()
@@ -212,6 +715,190 @@ func (s *ReceptionService) CreateProduct(
 	return product, nil
 }
 
+// CreateProducts adds the products to the active reception of the PVZ in one
+// transaction: either all of them are added or none. Barcodes are checked the
+// same way CreateProduct checks them, repeated barcodes in the batch are
+// rejected too.
+func (s *ReceptionService) CreateProducts(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID PVZID,
+	drafts []ProductDraft,
+) ([]AddedProduct, error) {
+	if authUser == nil || authUser.GetUserRole() != Employee {
+		return nil, ErrNotAuthorized
+	}
+
+	errValidID := validID(pvzID)
+	if errValidID != nil {
+		return nil, errors.Join(errValidID, ErrAvitoServiceProductInvalidPVZID)
+	}
+	if len(drafts) == 0 || len(drafts) > MaxProductBatch {
+		return nil, errors.Join(
+			ErrAvitoServiceCreateProductsInvalidBatch,
+			errors.New(strconv.Itoa(len(drafts))+" products"),
+		)
+	}
+	drafts = slices.Clone(drafts)
+	productTypes := make([]ProductType, 0, len(drafts))
+	barcodes := make(map[string]bool, len(drafts))
+	for i, draft := range drafts {
+		draft, err := draft.Normalize()
+		if err != nil {
+			return nil, errors.Join(ErrAvitoServiceCreateProductsInvalidBatch, err)
+		}
+		if draft.Barcode != nil {
+			if barcodes[*draft.Barcode] {
+				return nil, errors.Join(
+					ErrAvitoServiceCreateProductsInvalidBatch,
+					ErrProductDuplicateBarcode,
+					errors.New(*draft.Barcode),
+				)
+			}
+			barcodes[*draft.Barcode] = true
+		}
+		drafts[i] = draft
+		productTypes = append(productTypes, draft.Type)
+	}
+
+	createdBy := authUser.GetUserID()
+	var products []AddedProduct
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if err := checkProductTypes(ctx, c, s.typeRepo, productTypes); err != nil {
+			return errors.Join(ErrAvitoServiceCreateProductsInvalidBatch, err)
+		}
+
+		reception, err := s.receptionRepo.FindActive(ctx, c, pvzID)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceCreateProductFindActive, err)
+		}
+
+		duplicates, err := s.findDuplicates(ctx, c, reception.ID, drafts)
+		if err != nil {
+			return err
+		}
+
+		// Products are a microsecond apart so that they keep the order of the
+		// request wherever products are ordered by creation time.
+		createdAt := time.Now()
+		products = make([]AddedProduct, 0, len(drafts))
+		created := make([]Product, 0, len(drafts))
+		productIDs := make([]ProductID, 0, len(drafts))
+		for i, draft := range drafts {
+			product := AddedProduct{
+				Product: Product{
+					ID:                uuid.New(),
+					ReceptionID:       reception.ID,
+					Type:              draft.Type,
+					CreatedAt:         createdAt.Add(time.Duration(i) * time.Microsecond),
+					CreatedBy:         &createdBy,
+					Barcode:           draft.Barcode,
+					Status:            ProductReceived,
+					ProductAttributes: draft.ProductAttributes,
+				},
+			}
+			if draft.Barcode != nil {
+				product.Duplicates = duplicates[*draft.Barcode]
+			}
+			products = append(products, product)
+			created = append(created, product.Product)
+			productIDs = append(productIDs, product.ID)
+		}
+
+		if err := s.productRepo.CreateBatch(ctx, c, created); err != nil {
+			return errors.Join(ErrAvitoServiceCreateProduct, err)
+		}
+		if err := s.analyticsRepo.AddProducts(ctx, c, productIDs); err != nil {
+			return errors.Join(ErrAvitoServiceCreateProduct, err)
+		}
+		for i := range products {
+			if products[i].Rejected() {
+				continue
+			}
+			products[i].Cell, err = s.cellRepo.Allocate(ctx, c, pvzID, products[i].Product)
+			if err != nil {
+				return errors.Join(ErrAvitoServiceCreateProductAllocateCell, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for range products {
+		s.metrics.IncProducts()
+	}
+
+	return products, nil
+}
+
+// findDuplicates groups by barcode the products in receptions in progress,
+// other than receptionID, that carry the barcodes of the drafts.
+func (s *ReceptionService) findDuplicates(
+	ctx context.Context,
+	c Connection,
+	receptionID ReceptionID,
+	drafts []ProductDraft,
+) (map[string][]Product, error) {
+	duplicates := make(map[string][]Product)
+
+	var barcodes []string
+	for _, draft := range drafts {
+		if draft.Barcode != nil {
+			barcodes = append(barcodes, *draft.Barcode)
+		}
+	}
+	if len(barcodes) == 0 {
+		return duplicates, nil
+	}
+
+	products, err := s.productRepo.FindInProgressByBarcodes(ctx, c, barcodes)
+	if err != nil {
+		return nil, errors.Join(ErrAvitoServiceCreateProductFindDuplicates, err)
+	}
+	for _, product := range products {
+		if product.ReceptionID == receptionID || product.Barcode == nil {
+			continue
+		}
+		duplicates[*product.Barcode] = append(duplicates[*product.Barcode], product)
+	}
+
+	return duplicates, nil
+}
+
+// FindProductsByBarcode looks the barcode up across all PVZs, newest product
+// first.
+func (s *ReceptionService) FindProductsByBarcode(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	barcode string,
+) ([]ProductLocation, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return nil, ErrNotAuthorized
+	}
+
+	barcode = strings.TrimSpace(barcode)
+	if barcode == "" {
+		return nil, ErrAvitoServiceFindProductsByBarcodeEmpty
+	}
+
+	var locations []ProductLocation
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		var err error
+		locations, err = s.productRepo.FindByBarcode(ctx, c, barcode)
+
+		return err
+	})
+	if err != nil {
+		return nil, errors.Join(ErrAvitoServiceFindProductsByBarcode, err)
+	}
+
+	return locations, nil
+}
+
 func (s *ReceptionService) DeleteLastProduct(
 	ctx context.Context,
 	authUser AuthenticatedUser,
@@ -221,27 +908,121 @@ func (s *ReceptionService) DeleteLastProduct(
 		return ErrNotAuthorized
 	}
 
-	errValidID := validPVZID(pvzID)
+	errValidID := validID(pvzID)
 	if errValidID != nil {
 		return errors.Join(errValidID, ErrAvitoServiceProductInvalidPVZID)
 	}
 
-	var errFindActive error
-	var reception Reception
+	// The active reception is locked in the transaction of the delete, so a
+	// close waits for it instead of counting a product that is gone.
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		reception, err := s.receptionRepo.FindActive(ctx, c, pvzID)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceDeleteProductFindActive, err)
+		}
+
+		product, err := s.productRepo.DeleteLast(ctx, c, reception.ID, authUser.GetUserID())
+		if err != nil {
+			return err
+		}
 
-	err := s.provider.Execute(ctx, func(ctx context.Context, c Connection) error {
-		reception, errFindActive = s.receptionRepo.FindActive(ctx, c, pvzID)
-		return errFindActive
+		return s.analyticsRepo.RemoveProduct(ctx, c, product)
 	})
+	if errors.Is(err, ErrAvitoServiceDeleteProductFindActive) {
+		return err
+	}
 	if err != nil {
-		return errors.Join(ErrAvitoServiceDeleteProductFindActive, err)
+		return errors.Join(ErrAvitoServiceDeleteProduct, err)
 	}
+	return nil
+}
 
-	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
-		return s.productRepo.DeleteLast(ctx, c, reception.ID)
+// IssueProduct hands a stored product over to the customer.
+func (s *ReceptionService) IssueProduct(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	productID ProductID,
+) (Product, error) {
+	return s.changeProductStatus(ctx, authUser, productID, ProductIssued)
+}
+
+// ReturnProduct sends a received or stored product back to the sender.
+func (s *ReceptionService) ReturnProduct(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	productID ProductID,
+) (Product, error) {
+	return s.changeProductStatus(ctx, authUser, productID, ProductReturned)
+}
+
+// changeProductStatus moves the product to the status on behalf of an
+// employee and records the transition. It fails with
+// ErrProductInvalidTransition when the current status does not allow it and
+// with ErrProductInOrder when the product belongs to a waiting order.
+func (s *ReceptionService) changeProductStatus(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	productID ProductID,
+	status ProductStatus,
+) (Product, error) {
+	if authUser == nil || authUser.GetUserRole() != Employee {
+		return Product{}, ErrNotAuthorized
+	}
+
+	if err := validID(productID); err != nil {
+		return Product{}, errors.Join(ErrAvitoServiceChangeProductStatusInvalidID, err)
+	}
+
+	var product Product
+	changedBy := authUser.GetUserID()
+	err := s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		found, err := s.productRepo.FindByIDs(ctx, c, []ProductID{productID})
+		if err != nil {
+			return errors.Join(ErrAvitoServiceChangeProductStatusFind, err)
+		}
+		if len(found) == 0 {
+			return errors.Join(ErrAvitoServiceChangeProductStatusFind, ErrProductNotFound)
+		}
+		if !found[0].Status.CanBecome(status) {
+			return errors.Join(
+				ErrAvitoServiceChangeProductStatus,
+				ErrProductInvalidTransition,
+				errors.New(string(found[0].Status)+" to "+string(status)),
+			)
+		}
+		// A product of a waiting order leaves the PVZ only with the order,
+		// otherwise the order could never be picked up.
+		order, err := s.orderRepo.FindWaitingByProduct(ctx, c, productID)
+		if err == nil {
+			return errors.Join(
+				ErrAvitoServiceChangeProductStatus,
+				ErrProductInOrder,
+				errors.New("order "+order.ID.String()),
+			)
+		}
+		if !errors.Is(err, ErrOrderNotFound) {
+			return errors.Join(ErrAvitoServiceChangeProductStatusFind, err)
+		}
+
+		product, err = s.productRepo.ChangeStatus(ctx, c, ProductTransition{
+			ProductID: productID,
+			From:      found[0].Status,
+			To:        status,
+			ChangedBy: &changedBy,
+		})
+		if err != nil {
+			return errors.Join(ErrAvitoServiceChangeProductStatus, err)
+		}
+		// Issued and returned products leave the PVZ.
+		if err := s.cellRepo.Free(ctx, c, []ProductID{productID}); err != nil {
+			return errors.Join(ErrAvitoServiceChangeProductStatus, err)
+		}
+
+		return nil
 	})
 	if err != nil {
-		return errors.Join(ErrAvitoServiceDeleteProduct, err)
+		return Product{}, err
 	}
-	return nil
+
+	return product, nil
 }
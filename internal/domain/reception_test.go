@@ -3,10 +3,13 @@ package domain_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
+	"time"
 
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -22,19 +25,31 @@ func TestServiceReception_Create(t *testing.T) {
 		PVZID: pvzID,
 	}
 	invalidPVZID := uuid.Nil
+	employee := fixtureAuthUser(t, domain.Employee)
+
+	now := time.Now().UTC()
+	openPVZ := domain.PVZ{ID: pvzID, PVZSchedule: domain.PVZSchedule{}.WithDefaults()}
+	closedPVZ := domain.PVZ{ID: pvzID, PVZSchedule: domain.PVZSchedule{
+		TimeZone: "UTC",
+		OpensAt:  now.Add(time.Hour).Format("15:04"),
+		ClosesAt: now.Add(2 * time.Hour).Format("15:04"),
+	}}
 
 	tests := []struct {
-		name         string
-		authUser     domain.AuthenticatedUser
-		pvzID        domain.PVZID
-		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockMetrics)
-		check        func(*testing.T, domain.Reception, error)
+		name             string
+		authUser         domain.AuthenticatedUser
+		pvzID            domain.PVZID
+		override         bool
+		manifest         domain.ReceptionManifest
+		prepareMocks     func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository, *mocks.MockMetrics)
+		prepareAnalytics func(*mocks.MockAnalyticsRepository)
+		check            func(*testing.T, domain.Reception, error)
 	}{
 		{
 			name:     "Success",
-			authUser: nil,
+			authUser: employee,
 			pvzID:    pvzID,
-			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, m *mocks.MockMetrics) {
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -49,27 +64,90 @@ func TestServiceReception_Create(t *testing.T) {
 					Once()
 				m.EXPECT().IncReceptions().Return().Once()
 
-				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(openPVZ, nil).Once()
+				repo.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.MatchedBy(func(reception domain.Reception) bool {
+						return reception.CreatedBy != nil && *reception.CreatedBy == employee.GetUserID()
+					})).
+					Return(nil).Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(reception, nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().AddReception(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, reception domain.Reception, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pvzID, reception.PVZID)
+			},
+		},
+		{
+			name:     "Success with manifest",
+			authUser: employee,
+			pvzID:    pvzID,
+			manifest: domain.ReceptionManifest{{Type: domain.Shoes, Count: 10}},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				m.EXPECT().IncReceptions().Return().Once()
+
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(openPVZ, nil).Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				repo.EXPECT().
+					SaveManifest(mock.Anything, mock.Anything, mock.Anything, domain.ReceptionManifest{{Type: domain.Shoes, Count: 10}}).
 					Return(nil).Once()
 				repo.EXPECT().FindActive(mock.Anything, mock.Anything, pvzID).
 					Return(reception, nil).Once()
 			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().AddReception(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
 			check: func(t *testing.T, reception domain.Reception, err error) {
 				require.NoError(t, err)
 				require.Equal(t, pvzID, reception.PVZID)
 			},
 		},
+		{
+			name:     "Invalid manifest",
+			authUser: employee,
+			pvzID:    pvzID,
+			manifest: domain.ReceptionManifest{{Type: domain.Shoes, Count: 0}},
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateReceptionInvalidManifest)
+				require.ErrorIs(t, err, domain.ErrManifestInvalidCount)
+			},
+		},
 		{
 			name:     "DB Error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
-			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, m *mocks.MockMetrics) {
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
 					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(openPVZ, nil).Once()
 				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
 					Return(errors.New("some error")).Once()
 			},
@@ -81,9 +159,9 @@ func TestServiceReception_Create(t *testing.T) {
 		},
 		{
 			name:     "Error find active",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
-			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, m *mocks.MockMetrics) {
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -96,28 +174,139 @@ func TestServiceReception_Create(t *testing.T) {
 						return f(ctx, &mocks.MockConnection{})
 					}).
 					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(openPVZ, nil).Once()
 				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
 					Return(nil).Once()
 				repo.EXPECT().FindActive(mock.Anything, mock.Anything, pvzID).
 					Return(reception, errors.New("some error")).Once()
 			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().AddReception(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
 			check: func(t *testing.T, _ domain.Reception, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "some error")
 				require.Contains(t, err.Error(), "find active failed")
 			},
 		},
+		{
+			name:     "Reception already in progress",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(openPVZ, nil).Once()
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.Join(errors.New("create failed"), domain.ErrReceptionInProgress)).Once()
+			},
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateReception)
+				require.ErrorIs(t, err, domain.ErrReceptionInProgress)
+			},
+		},
 		{
 			name:     "Invalid ID",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    invalidPVZID,
-			prepareMocks: func(_ *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, m *mocks.MockMetrics) {
-			},
 			check: func(t *testing.T, _ domain.Reception, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "invalid pvz id")
 			},
 		},
+		{
+			name:     "Error find pvz",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{}, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateReceptionFindPVZ)
+				require.Contains(t, err.Error(), "some error")
+			},
+		},
+		{
+			name:     "Outside working hours",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, pvzRepo *mocks.MockPVZsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					Execute(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(closedPVZ, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateReceptionOutsideWorkingHours)
+			},
+		},
+		{
+			name:     "Moderator override skips working hours",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			pvzID:    pvzID,
+			override: true,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository, _ *mocks.MockPVZsRepository, m *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				m.EXPECT().IncReceptions().Return().Once()
+
+				repo.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(reception, nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().AddReception(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, reception domain.Reception, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pvzID, reception.PVZID)
+			},
+		},
+		{
+			name:     "Employee cannot override",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    pvzID,
+			override: true,
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+		{
+			name:     "Moderator without override",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			pvzID:    pvzID,
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -127,14 +316,19 @@ func TestServiceReception_Create(t *testing.T) {
 
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			repoProduct := mocks.NewMockProductsRepository(t)
+			repoPVZ := mocks.NewMockPVZsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
 
 			if test.prepareMocks != nil {
-				test.prepareMocks(provider, repoReception, metrics)
+				test.prepareMocks(provider, repoReception, repoPVZ, metrics)
+			}
+			if test.prepareAnalytics != nil {
+				test.prepareAnalytics(repoAnalytics)
 			}
 
-			testReception, err := domain.NewReceptionService(provider, repoReception, repoProduct, metrics).
-				Create(t.Context(), test.authUser, test.pvzID)
+			testReception, err := domain.NewReceptionService(provider, repoReception, repoProduct, repoPVZ, repoAnalytics, fixtureProductTypes(t), mocks.NewMockOrdersRepository(t), mocks.NewMockStorageCellsRepository(t), metrics).
+				Create(t.Context(), test.authUser, test.pvzID, test.override, test.manifest)
 
 			test.check(t, testReception, err)
 		})
@@ -150,25 +344,87 @@ func TestServiceReception_Close(t *testing.T) {
 		PVZID: pvzID,
 	}
 	invalidPVZID := uuid.Nil
+	employee := fixtureAuthUser(t, domain.Employee)
 
 	tests := []struct {
-		name         string
-		authUser     domain.AuthenticatedUser
-		pvzID        domain.PVZID
-		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository)
-		check        func(*testing.T, domain.Reception, error)
+		name             string
+		authUser         domain.AuthenticatedUser
+		pvzID            domain.PVZID
+		prepareMocks     func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository)
+		prepareAnalytics func(*mocks.MockAnalyticsRepository)
+		prepareProducts  func(*mocks.MockProductsRepository)
+		check            func(*testing.T, domain.ClosedReception, error)
 	}{
 		{
 			name:     "Success",
-			authUser: nil,
+			authUser: employee,
 			pvzID:    pvzID,
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
 					Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, employee.GetUserID()).
+					Return(nil).Once()
+				repo.EXPECT().FindManifest(mock.Anything, mock.Anything, reception.ID).Return(nil, nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(
+						mock.Anything,
+						mock.Anything,
+						[]domain.ReceptionID{reception.ID},
+						pointer.Ref(employee.GetUserID()),
+					).
+					Return(nil).Once()
+				repo.EXPECT().FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+					Return([]domain.Product{
+						{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+							WeightGrams:      pointer.Ref(1200),
+							LengthCM:         pointer.Ref(30),
+							WidthCM:          pointer.Ref(20),
+							HeightCM:         pointer.Ref(10),
+							DeclaredValue:    pointer.Ref(int64(450000)),
+							InspectionStatus: pointer.Ref(domain.InspectionAccepted),
+						}},
+						{Type: domain.Clothes, ProductAttributes: domain.ProductAttributes{
+							WeightGrams:      pointer.Ref(300),
+							DeclaredValue:    pointer.Ref(int64(99900)),
+							InspectionStatus: pointer.Ref(domain.InspectionDamaged),
+						}},
+						{Type: domain.Clothes},
+					}, nil).Once()
+			},
+			check: func(t *testing.T, closed domain.ClosedReception, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pvzID, closed.PVZID)
+				require.Equal(t, pointer.Ref(employee.GetUserID()), closed.ClosedBy)
+				require.Nil(t, closed.Discrepancy)
+				require.Equal(t, domain.ProductSummary{
+					Products: 3,
+					Inspections: map[domain.InspectionStatus]int{
+						domain.InspectionAccepted: 1,
+						domain.InspectionDamaged:  1,
+					},
+					Uninspected:   1,
+					WeightGrams:   1500,
+					VolumeCM3:     6000,
+					DeclaredValue: 549900,
+				}, closed.Summary)
+			},
+		},
+		{
+			name:     "Success with manifest",
+			authUser: employee,
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -177,21 +433,138 @@ func TestServiceReception_Close(t *testing.T) {
 					Once()
 				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reception, nil).Once()
-				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID).
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).
+					Return(nil).Once()
+				repo.EXPECT().FindManifest(mock.Anything, mock.Anything, reception.ID).
+					Return(domain.ReceptionManifest{{Type: domain.Shoes, Count: 2}}, nil).Once()
+				repo.EXPECT().
+					SaveDiscrepancy(mock.Anything, mock.Anything, reception.ID, domain.DiscrepancyReport{
+						Missing: []domain.ManifestItem{{Type: domain.Shoes, Count: 1}},
+						Extra:   []domain.ManifestItem{{Type: domain.Clothes, Count: 1}},
+					}).
 					Return(nil).Once()
 			},
-			check: func(t *testing.T, reception domain.Reception, err error) {
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}, mock.Anything).
+					Return(nil).
+					Once()
+				repo.EXPECT().FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+					Return([]domain.Product{{Type: domain.Shoes}, {Type: domain.Clothes}}, nil).Once()
+			},
+			check: func(t *testing.T, closed domain.ClosedReception, err error) {
 				require.NoError(t, err)
-				require.Equal(t, pvzID, reception.PVZID)
+				require.NotNil(t, closed.Discrepancy)
+				require.Equal(t, []domain.ManifestItem{{Type: domain.Shoes, Count: 1}}, closed.Discrepancy.Missing)
+				require.Equal(t, []domain.ManifestItem{{Type: domain.Clothes, Count: 1}}, closed.Discrepancy.Extra)
+			},
+		},
+		{
+			name:     "Reconcile error",
+			authUser: employee,
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).
+					Return(nil).Once()
+				repo.EXPECT().FindManifest(mock.Anything, mock.Anything, reception.ID).
+					Return(nil, errors.New("some error")).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}, mock.Anything).
+					Return(nil).
+					Once()
+				repo.EXPECT().FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+					Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCloseReceptionReconcile)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "Summarize error",
+			authUser: employee,
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).
+					Return(nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}, mock.Anything).
+					Return(nil).
+					Once()
+				repo.EXPECT().FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+					Return(nil, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCloseReceptionSummarize)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "Store error",
+			authUser: employee,
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).
+					Return(nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCloseReception)
+				require.ErrorContains(t, err, "some error")
 			},
 		},
 		{
 			name:     "Find active Error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
@@ -199,7 +572,7 @@ func TestServiceReception_Close(t *testing.T) {
 				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reception, errors.New("some error")).Once()
 			},
-			check: func(t *testing.T, _ domain.Reception, err error) {
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "some error")
 				require.Contains(t, err.Error(), "find active failed")
@@ -207,15 +580,31 @@ func TestServiceReception_Close(t *testing.T) {
 		},
 		{
 			name:     "Close error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
 					Once()
+				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).
+					Return(errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "some error")
+				require.Contains(t, err.Error(), "close failed")
+			},
+		},
+		{
+			name:     "Analytics error",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -224,22 +613,25 @@ func TestServiceReception_Close(t *testing.T) {
 					Once()
 				repo.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reception, nil).Once()
-				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID).
+				repo.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).
+					Return(nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).
 					Return(errors.New("some error")).Once()
 			},
-			check: func(t *testing.T, _ domain.Reception, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "some error")
-				require.Contains(t, err.Error(), "close failed")
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCloseReception)
+				require.ErrorContains(t, err, "some error")
 			},
 		},
 		{
 			name:     "Invalid ID",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    invalidPVZID,
 			prepareMocks: func(_ *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository) {
 			},
-			check: func(t *testing.T, _ domain.Reception, err error) {
+			check: func(t *testing.T, _ domain.ClosedReception, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "invalid pvz id")
 			},
@@ -253,13 +645,20 @@ func TestServiceReception_Close(t *testing.T) {
 
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			repoProduct := mocks.NewMockProductsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
 
 			if test.prepareMocks != nil {
 				test.prepareMocks(provider, repoReception)
 			}
+			if test.prepareAnalytics != nil {
+				test.prepareAnalytics(repoAnalytics)
+			}
+			if test.prepareProducts != nil {
+				test.prepareProducts(repoProduct)
+			}
 
-			testReception, err := domain.NewReceptionService(provider, repoReception, repoProduct, metrics).
+			testReception, err := domain.NewReceptionService(provider, repoReception, repoProduct, mocks.NewMockPVZsRepository(t), repoAnalytics, mocks.NewMockProductTypesRepository(t), mocks.NewMockOrdersRepository(t), mocks.NewMockStorageCellsRepository(t), metrics).
 				Close(t.Context(), test.authUser, test.pvzID)
 
 			test.check(t, testReception, err)
@@ -278,26 +677,24 @@ func TestServiceReception_CreateProduct(t *testing.T) {
 	}
 
 	invalidPVZID := uuid.Nil
+	employee := fixtureAuthUser(t, domain.Employee)
+	duplicate := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Barcode: pointer.Ref("4600000000017")}
 
 	tests := []struct {
-		name         string
-		authUser     domain.AuthenticatedUser
-		pvzID        domain.PVZID
-		productType  domain.ProductType
-		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockProductsRepository, *mocks.MockMetrics)
-		check        func(*testing.T, domain.Product, error)
+		name             string
+		authUser         domain.AuthenticatedUser
+		pvzID            domain.PVZID
+		draft            domain.ProductDraft
+		prepareMocks     func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockProductsRepository, *mocks.MockMetrics)
+		prepareAnalytics func(*mocks.MockAnalyticsRepository)
+		check            func(*testing.T, domain.AddedProduct, error)
 	}{
 		{
 			name:     "Success",
-			authUser: nil,
+			authUser: employee,
 			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: domain.Shoes},
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, m *mocks.MockMetrics) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, &mocks.MockConnection{})
-					}).
-					Once()
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -312,17 +709,22 @@ func TestServiceReception_CreateProduct(t *testing.T) {
 				repoProduct.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
 					Return(nil).Once()
 			},
-			check: func(t *testing.T, _ domain.Product, err error) {
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().AddProduct(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, product domain.AddedProduct, err error) {
 				require.NoError(t, err)
+				require.Equal(t, pointer.Ref(employee.GetUserID()), product.CreatedBy)
 			},
 		},
 		{
 			name:     "Find active Error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: domain.Shoes},
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository, m *mocks.MockMetrics) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
@@ -330,7 +732,7 @@ func TestServiceReception_CreateProduct(t *testing.T) {
 				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reception, errors.New("some error")).Once()
 			},
-			check: func(t *testing.T, _ domain.Product, err error) {
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "some error")
 				require.Contains(t, err.Error(), "find active failed")
@@ -338,15 +740,10 @@ func TestServiceReception_CreateProduct(t *testing.T) {
 		},
 		{
 			name:     "Create Product error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: domain.Shoes},
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, m *mocks.MockMetrics) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, &mocks.MockConnection{})
-					}).
-					Once()
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -358,22 +755,114 @@ func TestServiceReception_CreateProduct(t *testing.T) {
 				repoProduct.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
 					Return(errors.New("some error")).Once()
 			},
-			check: func(t *testing.T, _ domain.Product, err error) {
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "some error")
 				require.Contains(t, err.Error(), "create product failed")
 			},
 		},
 		{
-			name:     "Invalid ID",
-			authUser: nil,
-			pvzID:    invalidPVZID,
-			prepareMocks: func(_ *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository, m *mocks.MockMetrics) {
-			},
-			check: func(t *testing.T, _ domain.Product, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "invalid pvz id")
-			},
+			name:     "Type not in catalogue",
+			authUser: employee,
+			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: "мебель"},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository, _ *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductInvalidDraft)
+				require.ErrorIs(t, err, domain.ErrProductTypeUnavailable)
+			},
+		},
+		{
+			name:     "Invalid ID",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    invalidPVZID,
+			draft:    domain.ProductDraft{Type: domain.Shoes},
+			prepareMocks: func(_ *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository, m *mocks.MockMetrics) {
+			},
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid pvz id")
+			},
+		},
+		{
+			name:     "Barcode duplicated in another reception",
+			authUser: employee,
+			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: domain.Shoes, Barcode: pointer.Ref(" 4600000000017 ")},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, m *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+
+				m.EXPECT().IncProducts().Return().Once()
+
+				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repoProduct.EXPECT().
+					FindInProgressByBarcodes(mock.Anything, mock.Anything, []string{"4600000000017"}).
+					Return([]domain.Product{duplicate}, nil).
+					Once()
+				repoProduct.EXPECT().
+					Create(mock.Anything, mock.Anything, mock.MatchedBy(func(product domain.Product) bool {
+						return product.Barcode != nil && *product.Barcode == "4600000000017"
+					})).
+					Return(nil).
+					Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().AddProduct(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			check: func(t *testing.T, product domain.AddedProduct, err error) {
+				require.NoError(t, err)
+				require.Equal(t, pointer.Ref("4600000000017"), product.Barcode)
+				require.Equal(t, []domain.Product{duplicate}, product.Duplicates)
+			},
+		},
+		{
+			name:     "Barcode already in reception",
+			authUser: employee,
+			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: domain.Shoes, Barcode: pointer.Ref("4600000000017")},
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository, m *mocks.MockMetrics) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repoProduct.EXPECT().
+					FindInProgressByBarcodes(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{{ReceptionID: reception.ID, Barcode: pointer.Ref("4600000000017")}}, nil).
+					Once()
+				repoProduct.EXPECT().Create(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrProductDuplicateBarcode).Once()
+			},
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProduct)
+				require.ErrorIs(t, err, domain.ErrProductDuplicateBarcode)
+			},
+		},
+		{
+			name:     "Empty barcode",
+			authUser: employee,
+			pvzID:    pvzID,
+			draft:    domain.ProductDraft{Type: domain.Shoes, Barcode: pointer.Ref("  ")},
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductInvalidDraft)
+				require.ErrorIs(t, err, domain.ErrProductDraftEmptyBarcode)
+			},
 		},
 	}
 	for _, test := range tests {
@@ -384,20 +873,249 @@ func TestServiceReception_CreateProduct(t *testing.T) {
 
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			repoProduct := mocks.NewMockProductsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
 
 			if test.prepareMocks != nil {
 				test.prepareMocks(provider, repoReception, repoProduct, metrics)
 			}
+			if test.prepareAnalytics != nil {
+				test.prepareAnalytics(repoAnalytics)
+			}
 
-			product, err := domain.NewReceptionService(provider, repoReception, repoProduct, metrics).
-				CreateProduct(t.Context(), test.authUser, test.pvzID, test.productType)
+			product, err := domain.NewReceptionService(provider, repoReception, repoProduct, mocks.NewMockPVZsRepository(t), repoAnalytics, fixtureProductTypes(t), mocks.NewMockOrdersRepository(t), fixtureStorageCells(t), metrics).
+				CreateProduct(t.Context(), test.authUser, test.pvzID, test.draft)
 
 			test.check(t, product, err)
 		})
 	}
 }
 
+func TestServiceReception_CreateProducts(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := domain.Reception{ID: uuid.New(), PVZID: pvzID, Status: domain.InProgress}
+	employee := fixtureAuthUser(t, domain.Employee)
+	drafts := []domain.ProductDraft{
+		{Type: domain.Shoes, Barcode: pointer.Ref("4600000000017")},
+		{Type: domain.Clothes},
+		{Type: domain.Shoes, Barcode: pointer.Ref("4600000000024")},
+	}
+	duplicate := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Barcode: pointer.Ref("4600000000024")}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		drafts       []domain.ProductDraft
+		prepareMocks func(
+			*mocks.MockConnectionProvider,
+			*mocks.MockReceptionsRepository,
+			*mocks.MockProductsRepository,
+			*mocks.MockAnalyticsRepository,
+			*mocks.MockMetrics,
+		)
+		check func(*testing.T, []domain.AddedProduct, error)
+	}{
+		{
+			name:     "Success",
+			authUser: employee,
+			drafts:   drafts,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repoReception *mocks.MockReceptionsRepository,
+				repoProduct *mocks.MockProductsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+				m *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, pvzID).Return(reception, nil).Once()
+				repoProduct.EXPECT().
+					FindInProgressByBarcodes(mock.Anything, mock.Anything, []string{"4600000000017", "4600000000024"}).
+					Return([]domain.Product{duplicate}, nil).
+					Once()
+				repoProduct.EXPECT().
+					CreateBatch(mock.Anything, mock.Anything, mock.MatchedBy(func(products []domain.Product) bool {
+						return len(products) == len(drafts)
+					})).
+					Return(nil).
+					Once()
+				analytics.EXPECT().
+					AddProducts(mock.Anything, mock.Anything, mock.MatchedBy(func(ids []domain.ProductID) bool {
+						return len(ids) == len(drafts)
+					})).
+					Return(nil).
+					Once()
+				m.EXPECT().IncProducts().Return().Times(len(drafts))
+			},
+			check: func(t *testing.T, products []domain.AddedProduct, err error) {
+				require.NoError(t, err)
+				require.Len(t, products, len(drafts))
+				require.Empty(t, products[0].Duplicates)
+				require.Equal(t, []domain.Product{duplicate}, products[2].Duplicates)
+				for i, product := range products {
+					require.Equal(t, drafts[i].Type, product.Type)
+					require.Equal(t, drafts[i].Barcode, product.Barcode)
+					require.Equal(t, reception.ID, product.ReceptionID)
+					require.Equal(t, pointer.Ref(employee.GetUserID()), product.CreatedBy)
+					if i > 0 {
+						require.True(t, product.CreatedAt.After(products[i-1].CreatedAt))
+					}
+				}
+			},
+		},
+		{
+			name:     "No active reception",
+			authUser: employee,
+			drafts:   drafts,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repoReception *mocks.MockReceptionsRepository,
+				_ *mocks.MockProductsRepository,
+				_ *mocks.MockAnalyticsRepository,
+				_ *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repoReception.EXPECT().
+					FindActive(mock.Anything, mock.Anything, pvzID).
+					Return(domain.Reception{}, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductFindActive)
+			},
+		},
+		{
+			name:     "Insert error",
+			authUser: employee,
+			drafts:   drafts,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repoReception *mocks.MockReceptionsRepository,
+				repoProduct *mocks.MockProductsRepository,
+				_ *mocks.MockAnalyticsRepository,
+				_ *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, pvzID).Return(reception, nil).Once()
+				repoProduct.EXPECT().
+					FindInProgressByBarcodes(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+				repoProduct.EXPECT().CreateBatch(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProduct)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "Empty batch",
+			authUser: employee,
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductsInvalidBatch)
+			},
+		},
+		{
+			name:     "Batch too large",
+			authUser: employee,
+			drafts:   make([]domain.ProductDraft, domain.MaxProductBatch+1),
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductsInvalidBatch)
+			},
+		},
+		{
+			name:     "Unknown type",
+			authUser: employee,
+			drafts:   []domain.ProductDraft{{Type: domain.Shoes}, {Type: "мебель"}},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockReceptionsRepository,
+				_ *mocks.MockProductsRepository,
+				_ *mocks.MockAnalyticsRepository,
+				_ *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+			},
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductsInvalidBatch)
+				require.ErrorIs(t, err, domain.ErrProductTypeUnavailable)
+			},
+		},
+		{
+			name:     "Barcode repeated in batch",
+			authUser: employee,
+			drafts: []domain.ProductDraft{
+				{Type: domain.Shoes, Barcode: pointer.Ref("4600000000017")},
+				{Type: domain.Clothes, Barcode: pointer.Ref("4600000000017 ")},
+			},
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductsInvalidBatch)
+				require.ErrorIs(t, err, domain.ErrProductDuplicateBarcode)
+			},
+		},
+		{
+			name:     "Moderator not authorized",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			drafts:   drafts,
+			check: func(t *testing.T, _ []domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoReception, repoProduct, repoAnalytics, metrics)
+			}
+
+			products, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				repoAnalytics,
+				fixtureProductTypes(t),
+				mocks.NewMockOrdersRepository(t),
+				fixtureStorageCells(t),
+				metrics,
+			).CreateProducts(t.Context(), test.authUser, pvzID, test.drafts)
+			test.check(t, products, err)
+		})
+	}
+}
+
 func TestServiceReception_DeleteLastProduct(t *testing.T) {
 	t.Parallel()
 
@@ -407,28 +1125,25 @@ func TestServiceReception_DeleteLastProduct(t *testing.T) {
 		PVZID:  pvzID,
 		Status: domain.InProgress,
 	}
+	product := domain.Product{ID: uuid.New(), ReceptionID: reception.ID, Type: domain.Shoes, CreatedAt: time.Now()}
 
 	invalidPVZID := uuid.Nil
+	employee := fixtureAuthUser(t, domain.Employee)
 
 	tests := []struct {
-		name         string
-		authUser     domain.AuthenticatedUser
-		pvzID        domain.PVZID
-		productType  domain.ProductType
-		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockProductsRepository)
-		check        func(*testing.T, error)
+		name             string
+		authUser         domain.AuthenticatedUser
+		pvzID            domain.PVZID
+		productType      domain.ProductType
+		prepareMocks     func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockProductsRepository)
+		prepareAnalytics func(*mocks.MockAnalyticsRepository)
+		check            func(*testing.T, error)
 	}{
 		{
 			name:     "Success",
-			authUser: nil,
+			authUser: employee,
 			pvzID:    pvzID,
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository) {
-				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
-					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
-						return f(ctx, &mocks.MockConnection{})
-					}).
-					Once()
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -437,8 +1152,11 @@ func TestServiceReception_DeleteLastProduct(t *testing.T) {
 					Once()
 				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reception, nil).Once()
-				repoProduct.EXPECT().DeleteLast(mock.Anything, mock.Anything, mock.Anything).
-					Return(nil).Once()
+				repoProduct.EXPECT().DeleteLast(mock.Anything, mock.Anything, reception.ID, employee.GetUserID()).
+					Return(product, nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().RemoveProduct(mock.Anything, mock.Anything, product).Return(nil).Once()
 			},
 			check: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -446,11 +1164,11 @@ func TestServiceReception_DeleteLastProduct(t *testing.T) {
 		},
 		{
 			name:     "Find active Error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
@@ -466,15 +1184,31 @@ func TestServiceReception_DeleteLastProduct(t *testing.T) {
 		},
 		{
 			name:     "Delete last error",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    pvzID,
 			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository) {
 				provider.EXPECT().
-					Execute(mock.Anything, mock.Anything).
+					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
 						return f(ctx, &mocks.MockConnection{})
 					}).
 					Once()
+				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
+					Return(reception, nil).Once()
+				repoProduct.EXPECT().DeleteLast(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, errors.New("some error")).Once()
+			},
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "some error")
+				require.Contains(t, err.Error(), "delete product failed")
+			},
+		},
+		{
+			name:     "Analytics error",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			pvzID:    pvzID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repoReception *mocks.MockReceptionsRepository, repoProduct *mocks.MockProductsRepository) {
 				provider.EXPECT().
 					ExecuteTx(mock.Anything, mock.Anything).
 					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
@@ -483,18 +1217,21 @@ func TestServiceReception_DeleteLastProduct(t *testing.T) {
 					Once()
 				repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, mock.Anything).
 					Return(reception, nil).Once()
-				repoProduct.EXPECT().DeleteLast(mock.Anything, mock.Anything, mock.Anything).
+				repoProduct.EXPECT().DeleteLast(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(product, nil).Once()
+			},
+			prepareAnalytics: func(repo *mocks.MockAnalyticsRepository) {
+				repo.EXPECT().RemoveProduct(mock.Anything, mock.Anything, product).
 					Return(errors.New("some error")).Once()
 			},
 			check: func(t *testing.T, err error) {
-				require.Error(t, err)
-				require.Contains(t, err.Error(), "some error")
-				require.Contains(t, err.Error(), "delete product failed")
+				require.ErrorIs(t, err, domain.ErrAvitoServiceDeleteProduct)
+				require.ErrorContains(t, err, "some error")
 			},
 		},
 		{
 			name:     "Invalid ID",
-			authUser: nil,
+			authUser: fixtureAuthUser(t, domain.Employee),
 			pvzID:    invalidPVZID,
 			prepareMocks: func(_ *mocks.MockConnectionProvider, _ *mocks.MockReceptionsRepository, _ *mocks.MockProductsRepository) {
 			},
@@ -512,16 +1249,949 @@ func TestServiceReception_DeleteLastProduct(t *testing.T) {
 
 			repoReception := mocks.NewMockReceptionsRepository(t)
 			repoProduct := mocks.NewMockProductsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
 			metrics := mocks.NewMockMetrics(t)
 
 			if test.prepareMocks != nil {
 				test.prepareMocks(provider, repoReception, repoProduct)
 			}
+			if test.prepareAnalytics != nil {
+				test.prepareAnalytics(repoAnalytics)
+			}
 
-			err := domain.NewReceptionService(provider, repoReception, repoProduct, metrics).
+			err := domain.NewReceptionService(provider, repoReception, repoProduct, mocks.NewMockPVZsRepository(t), repoAnalytics, mocks.NewMockProductTypesRepository(t), mocks.NewMockOrdersRepository(t), mocks.NewMockStorageCellsRepository(t), metrics).
 				DeleteLastProduct(t.Context(), test.authUser, test.pvzID)
 
 			test.check(t, err)
 		})
 	}
 }
+
+func fixtureAuthUser(t *testing.T, role domain.UserRole) domain.AuthenticatedUser {
+	t.Helper()
+
+	authUser, err := domain.AuthenticateByToken(uuid.NewString() + ":" + string(role))
+	require.NoError(t, err)
+
+	return authUser
+}
+
+// fixtureProductTypes answers catalogue lookups with the seeded product types.
+func fixtureProductTypes(t *testing.T) *mocks.MockProductTypesRepository {
+	t.Helper()
+
+	repo := mocks.NewMockProductTypesRepository(t)
+	repo.EXPECT().
+		FindByCodes(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ domain.Connection, codes []domain.ProductType) ([]domain.ProductTypeEntry, error) {
+			var entries []domain.ProductTypeEntry
+			for _, code := range codes {
+				if slices.Contains([]domain.ProductType{domain.Electronics, domain.Clothes, domain.Shoes}, code) {
+					entries = append(entries, domain.ProductTypeEntry{Code: code, Active: true})
+				}
+			}
+
+			return entries, nil
+		}).
+		Maybe()
+
+	return repo
+}
+
+// fixtureStorageCells stands for a PVZ without storage cells: nothing is
+// allocated and freeing always succeeds.
+func fixtureStorageCells(t *testing.T) *mocks.MockStorageCellsRepository {
+	t.Helper()
+
+	repo := mocks.NewMockStorageCellsRepository(t)
+	repo.EXPECT().Allocate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	repo.EXPECT().Free(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return repo
+}
+
+func TestServiceReception_FindByPVZ(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	summary := domain.ReceptionSummary{
+		Reception: domain.Reception{ID: uuid.New(), PVZID: pvzID, Status: domain.Close},
+		Products:  3,
+	}
+	filter := domain.ReceptionFilter{Status: pointer.Ref(domain.Close)}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		filter       domain.ReceptionFilter
+		page, limit  *int
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockPVZsRepository)
+		check        func(*testing.T, domain.ReceptionPage, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			filter:   filter,
+			limit:    pointer.Ref(1),
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil).Once()
+				repo.EXPECT().
+					FindByPVZ(mock.Anything, mock.Anything, pvzID, filter, domain.DefaultPage, 1).
+					Return([]domain.ReceptionSummary{summary}, nil).
+					Once()
+				repo.EXPECT().CountByPVZ(mock.Anything, mock.Anything, pvzID, filter).Return(4, nil).Once()
+			},
+			check: func(t *testing.T, page domain.ReceptionPage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.ReceptionPage{
+					Items: []domain.ReceptionSummary{summary},
+					Total: 4,
+					Page:  domain.DefaultPage,
+					Limit: 1,
+				}, page)
+			},
+		},
+		{
+			name:     "PVZ not found",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				_ *mocks.MockReceptionsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzRepo.EXPECT().
+					FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{}, errors.Join(errors.New("find failed"), domain.ErrPVZNotFound)).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.ReceptionPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindReceptionsFindPVZ)
+				require.ErrorIs(t, err, domain.ErrPVZNotFound)
+			},
+		},
+		{
+			name:     "Repository error",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				pvzRepo *mocks.MockPVZsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzRepo.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil).Once()
+				repo.EXPECT().
+					FindByPVZ(mock.Anything, mock.Anything, pvzID, domain.ReceptionFilter{}, domain.DefaultPage, domain.DefaultLimit).
+					Return(nil, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.ReceptionPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindReceptions)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "Invalid status",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			filter:   domain.ReceptionFilter{Status: pointer.Ref(domain.ReceptionStatus("open"))},
+			check: func(t *testing.T, _ domain.ReceptionPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindReceptionsInvalidFilter)
+				require.ErrorIs(t, err, domain.ErrFilterInvalidReceptionStatus)
+			},
+		},
+		{
+			name:     "Limit above maximum",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			limit:    pointer.Ref(domain.MaxLimit + 1),
+			check: func(t *testing.T, _ domain.ReceptionPage, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindReceptionsInvalidPage)
+			},
+		},
+		{
+			name: "Not authorized",
+			check: func(t *testing.T, _ domain.ReceptionPage, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoPVZ := mocks.NewMockPVZsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoReception, repoPVZ)
+			}
+
+			page, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				mocks.NewMockProductsRepository(t),
+				repoPVZ,
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				mocks.NewMockOrdersRepository(t),
+				mocks.NewMockStorageCellsRepository(t),
+				mocks.NewMockMetrics(t),
+			).FindByPVZ(t.Context(), test.authUser, pvzID, test.filter, test.page, test.limit)
+			test.check(t, page, err)
+		})
+	}
+}
+
+func TestServiceReception_FindDetails(t *testing.T) {
+	t.Parallel()
+
+	receptionID := uuid.New()
+	details := domain.ReceptionDetails{
+		Reception: domain.Reception{ID: receptionID, Status: domain.InProgress},
+		Products:  []domain.Product{{ID: uuid.New(), ReceptionID: receptionID}},
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository)
+		check        func(*testing.T, domain.ReceptionDetails, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindDetails(mock.Anything, mock.Anything, receptionID).Return(details, nil).Once()
+			},
+			check: func(t *testing.T, found domain.ReceptionDetails, err error) {
+				require.NoError(t, err)
+				require.Equal(t, details, found)
+			},
+		},
+		{
+			name:     "Reception not found",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockReceptionsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindDetails(mock.Anything, mock.Anything, receptionID).
+					Return(domain.ReceptionDetails{}, errors.Join(errors.New("find failed"), domain.ErrReceptionNotFound)).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.ReceptionDetails, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindReceptionDetails)
+				require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+			},
+		},
+		{
+			name: "Not authorized",
+			check: func(t *testing.T, _ domain.ReceptionDetails, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoReception)
+			}
+
+			found, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				mocks.NewMockProductsRepository(t),
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				mocks.NewMockOrdersRepository(t),
+				mocks.NewMockStorageCellsRepository(t),
+				mocks.NewMockMetrics(t),
+			).FindDetails(t.Context(), test.authUser, receptionID)
+			test.check(t, found, err)
+		})
+	}
+}
+
+func TestServiceReception_FindProductsByBarcode(t *testing.T) {
+	t.Parallel()
+
+	locations := []domain.ProductLocation{
+		{Product: domain.Product{ID: uuid.New(), Barcode: pointer.Ref("4600000000017")}},
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		barcode      string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository)
+		check        func(*testing.T, []domain.ProductLocation, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			barcode:  " 4600000000017 ",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindByBarcode(mock.Anything, mock.Anything, "4600000000017").Return(locations, nil).Once()
+			},
+			check: func(t *testing.T, found []domain.ProductLocation, err error) {
+				require.NoError(t, err)
+				require.Equal(t, locations, found)
+			},
+		},
+		{
+			name:     "Repository error",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			barcode:  "4600000000017",
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindByBarcode(mock.Anything, mock.Anything, "4600000000017").
+					Return(nil, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ []domain.ProductLocation, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindProductsByBarcode)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:     "Empty barcode",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			barcode:  " ",
+			check: func(t *testing.T, _ []domain.ProductLocation, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindProductsByBarcodeEmpty)
+			},
+		},
+		{
+			name:    "Not authorized",
+			barcode: "4600000000017",
+			check: func(t *testing.T, _ []domain.ProductLocation, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoProduct)
+			}
+
+			found, err := domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				mocks.NewMockOrdersRepository(t),
+				mocks.NewMockStorageCellsRepository(t),
+				mocks.NewMockMetrics(t),
+			).FindProductsByBarcode(t.Context(), test.authUser, test.barcode)
+			test.check(t, found, err)
+		})
+	}
+}
+
+func TestServiceReception_Reopen(t *testing.T) {
+	t.Parallel()
+
+	receptionID := uuid.New()
+	closedBy := uuid.New()
+	closed := domain.Reception{ID: receptionID, PVZID: uuid.New(), Status: domain.Close, ClosedBy: &closedBy}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		reason       string
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockAnalyticsRepository)
+		check        func(*testing.T, domain.Reception, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			reason:   "  закрыли по ошибке ",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, []domain.ReceptionID{receptionID}).
+					Return([]domain.Reception{closed}, nil).
+					Once()
+				analytics.EXPECT().ReopenReception(mock.Anything, mock.Anything, receptionID).Return(nil).Once()
+				repo.EXPECT().
+					Reopen(mock.Anything, mock.Anything, mock.MatchedBy(func(reopening domain.ReceptionReopening) bool {
+						return reopening.ID != uuid.Nil &&
+							reopening.ReceptionID == receptionID &&
+							reopening.Reason == "закрыли по ошибке"
+					})).
+					Return(nil).
+					Once()
+			},
+			check: func(t *testing.T, reception domain.Reception, err error) {
+				require.NoError(t, err)
+				require.Equal(t, receptionID, reception.ID)
+				require.Equal(t, domain.InProgress, reception.Status)
+				require.Nil(t, reception.ClosedBy)
+			},
+		},
+		{
+			name:     "Reception not found",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			reason:   "reason",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				_ *mocks.MockAnalyticsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindByIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceReopenReceptionFind)
+				require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+			},
+		},
+		{
+			name:     "Newer reception exists",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			reason:   "reason",
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Reception{closed}, nil).
+					Once()
+				analytics.EXPECT().ReopenReception(mock.Anything, mock.Anything, receptionID).Return(nil).Once()
+				repo.EXPECT().
+					Reopen(mock.Anything, mock.Anything, mock.Anything).
+					Return(errors.Join(errors.New("reopen failed"), domain.ErrReceptionNotReopenable)).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceReopenReception)
+				require.ErrorIs(t, err, domain.ErrReceptionNotReopenable)
+			},
+		},
+		{
+			name:     "Empty reason",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			reason:   "   ",
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceReopenReceptionEmptyReason)
+			},
+		},
+		{
+			name:     "Employee not authorized",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			reason:   "reason",
+			check: func(t *testing.T, _ domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoReception, repoAnalytics)
+			}
+
+			reception, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				mocks.NewMockProductsRepository(t),
+				mocks.NewMockPVZsRepository(t),
+				repoAnalytics,
+				mocks.NewMockProductTypesRepository(t),
+				mocks.NewMockOrdersRepository(t),
+				mocks.NewMockStorageCellsRepository(t),
+				mocks.NewMockMetrics(t),
+			).Reopen(t.Context(), test.authUser, receptionID, test.reason)
+			test.check(t, reception, err)
+		})
+	}
+}
+
+func TestServiceReception_CloseStale(t *testing.T) {
+	t.Parallel()
+
+	stale := []domain.Reception{
+		{ID: uuid.New(), PVZID: uuid.New(), Status: domain.Close},
+		{ID: uuid.New(), PVZID: uuid.New(), Status: domain.Close},
+	}
+
+	tests := []struct {
+		name            string
+		idleFor         time.Duration
+		prepareMocks    func(*mocks.MockConnectionProvider, *mocks.MockReceptionsRepository, *mocks.MockAnalyticsRepository, *mocks.MockMetrics)
+		prepareProducts func(*mocks.MockProductsRepository)
+		check           func(*testing.T, []domain.Reception, error)
+	}{
+		{
+			name:    "Success",
+			idleFor: time.Hour,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+				m *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					CloseStale(mock.Anything, mock.Anything, mock.MatchedBy(func(idleSince time.Time) bool {
+						return time.Since(idleSince) >= time.Hour
+					})).
+					Return(stale, nil).
+					Once()
+				for _, reception := range stale {
+					analytics.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+					repo.EXPECT().FindManifest(mock.Anything, mock.Anything, reception.ID).Return(nil, nil).Once()
+				}
+				m.EXPECT().IncStaleReceptionsClosed().Return().Times(len(stale))
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, []domain.ReceptionID{stale[0].ID, stale[1].ID}, (*domain.UserID)(nil)).
+					Return(nil).
+					Once()
+				repo.EXPECT().
+					FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{stale[0].ID, stale[1].ID}).
+					Return([]domain.Product{{ReceptionID: stale[1].ID, Type: domain.Shoes}}, nil).
+					Once()
+			},
+			check: func(t *testing.T, closed []domain.Reception, err error) {
+				require.NoError(t, err)
+				require.Equal(t, stale, closed)
+			},
+		},
+		{
+			name:    "Nothing to close",
+			idleFor: time.Hour,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				_ *mocks.MockAnalyticsRepository,
+				_ *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().CloseStale(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, closed []domain.Reception, err error) {
+				require.NoError(t, err)
+				require.Empty(t, closed)
+			},
+		},
+		{
+			name:    "Analytics error",
+			idleFor: time.Hour,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				repo *mocks.MockReceptionsRepository,
+				analytics *mocks.MockAnalyticsRepository,
+				_ *mocks.MockMetrics,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().CloseStale(mock.Anything, mock.Anything, mock.Anything).Return(stale, nil).Once()
+				analytics.EXPECT().
+					CloseReception(mock.Anything, mock.Anything, stale[0].ID).
+					Return(errors.New("some error")).
+					Once()
+			},
+			prepareProducts: func(repo *mocks.MockProductsRepository) {
+				repo.EXPECT().
+					StoreReceived(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+				repo.EXPECT().FindByReceptionIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, closed []domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCloseStale)
+				require.ErrorContains(t, err, "some error")
+				require.Empty(t, closed)
+			},
+		},
+		{
+			name: "Invalid idle time",
+			check: func(t *testing.T, _ []domain.Reception, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCloseStaleInvalidIdle)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoReception, repoAnalytics, metrics)
+			}
+			if test.prepareProducts != nil {
+				test.prepareProducts(repoProduct)
+			}
+
+			closed, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				repoAnalytics,
+				mocks.NewMockProductTypesRepository(t),
+				mocks.NewMockOrdersRepository(t),
+				mocks.NewMockStorageCellsRepository(t),
+				metrics,
+			).CloseStale(t.Context(), test.idleFor)
+			test.check(t, closed, err)
+		})
+	}
+}
+
+func TestServiceReception_IssueProduct(t *testing.T) {
+	t.Parallel()
+
+	employee := fixtureAuthUser(t, domain.Employee)
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		productID    domain.ProductID
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockProductsRepository)
+		check        func(*testing.T, domain.Product, error)
+	}{
+		{
+			name:      "Success",
+			authUser:  employee,
+			productID: product.ID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, []domain.ProductID{product.ID}).
+					Return([]domain.Product{product}, nil).
+					Once()
+				issued := product
+				issued.Status = domain.ProductIssued
+				repo.EXPECT().
+					ChangeStatus(mock.Anything, mock.Anything, domain.ProductTransition{
+						ProductID: product.ID,
+						From:      domain.ProductStored,
+						To:        domain.ProductIssued,
+						ChangedBy: pointer.Ref(employee.GetUserID()),
+					}).
+					Return(issued, nil).
+					Once()
+			},
+			check: func(t *testing.T, got domain.Product, err error) {
+				require.NoError(t, err)
+				require.Equal(t, domain.ProductIssued, got.Status)
+			},
+		},
+		{
+			name:      "Not stored",
+			authUser:  employee,
+			productID: product.ID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				received := product
+				received.Status = domain.ProductReceived
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, []domain.ProductID{product.ID}).
+					Return([]domain.Product{received}, nil).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.Product, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatus)
+				require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+			},
+		},
+		{
+			name:      "Changed concurrently",
+			authUser:  employee,
+			productID: product.ID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return([]domain.Product{product}, nil).
+					Once()
+				repo.EXPECT().
+					ChangeStatus(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.Product{}, domain.ErrProductInvalidTransition).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.Product, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatus)
+				require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+			},
+		},
+		{
+			name:      "Not found",
+			authUser:  employee,
+			productID: product.ID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().FindByIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.Product, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatusFind)
+				require.ErrorIs(t, err, domain.ErrProductNotFound)
+			},
+		},
+		{
+			name:      "Find error",
+			authUser:  employee,
+			productID: product.ID,
+			prepareMocks: func(provider *mocks.MockConnectionProvider, repo *mocks.MockProductsRepository) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				repo.EXPECT().
+					FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.Product, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatusFind)
+				require.ErrorContains(t, err, "some error")
+			},
+		},
+		{
+			name:      "Invalid ID",
+			authUser:  employee,
+			productID: uuid.Nil,
+			check: func(t *testing.T, _ domain.Product, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatusInvalidID)
+			},
+		},
+		{
+			name:      "Moderator not authorized",
+			authUser:  fixtureAuthUser(t, domain.Moderator),
+			productID: product.ID,
+			check: func(t *testing.T, _ domain.Product, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoProduct := mocks.NewMockProductsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoProduct)
+			}
+
+			got, err := domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				fixtureNoWaitingOrders(t),
+				fixtureStorageCells(t),
+				mocks.NewMockMetrics(t),
+			).IssueProduct(t.Context(), test.authUser, test.productID)
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestServiceReception_ReturnProduct(t *testing.T) {
+	t.Parallel()
+
+	employee := fixtureAuthUser(t, domain.Employee)
+
+	tests := []struct {
+		name   string
+		status domain.ProductStatus
+		err    error
+	}{
+		{name: "Received", status: domain.ProductReceived},
+		{name: "Stored", status: domain.ProductStored},
+		{name: "Issued", status: domain.ProductIssued, err: domain.ErrProductInvalidTransition},
+		{name: "Returned", status: domain.ProductReturned, err: domain.ErrProductInvalidTransition},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			product := domain.Product{ID: uuid.New(), Type: domain.Shoes, Status: test.status}
+
+			provider := mocks.NewMockConnectionProvider(t)
+			provider.EXPECT().
+				ExecuteTx(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+					return f(ctx, &mocks.MockConnection{})
+				}).
+				Once()
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoProduct.EXPECT().
+				FindByIDs(mock.Anything, mock.Anything, []domain.ProductID{product.ID}).
+				Return([]domain.Product{product}, nil).
+				Once()
+			if test.err == nil {
+				returned := product
+				returned.Status = domain.ProductReturned
+				repoProduct.EXPECT().
+					ChangeStatus(mock.Anything, mock.Anything, domain.ProductTransition{
+						ProductID: product.ID,
+						From:      test.status,
+						To:        domain.ProductReturned,
+						ChangedBy: pointer.Ref(employee.GetUserID()),
+					}).
+					Return(returned, nil).
+					Once()
+			}
+
+			got, err := domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				fixtureNoWaitingOrders(t),
+				fixtureStorageCells(t),
+				mocks.NewMockMetrics(t),
+			).ReturnProduct(t.Context(), employee, product.ID)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, domain.ProductReturned, got.Status)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+var (
+	errSchedule             = errors.New("pvz schedule error")
+	ErrScheduleInvalidZone  = errors.Join(errSchedule, errors.New("invalid time zone"))
+	ErrScheduleInvalidClock = errors.Join(errSchedule, errors.New("invalid clock value"))
+	ErrScheduleEmptyHours   = errors.Join(errSchedule, errors.New("opens and closes at the same time"))
+)
+
+func (s PVZSchedule) WithDefaults() PVZSchedule {
+	if s.TimeZone == "" {
+		s.TimeZone = DefaultTimeZone
+	}
+	if s.OpensAt == "" {
+		s.OpensAt = DefaultOpensAt
+	}
+	if s.ClosesAt == "" {
+		s.ClosesAt = DefaultClosesAt
+	}
+
+	return s
+}
+
+func (s PVZSchedule) Validate() error {
+	if _, err := s.Location(); err != nil {
+		return err
+	}
+
+	opens, err := parseClock(s.OpensAt)
+	if err != nil {
+		return err
+	}
+	closes, err := parseClock(s.ClosesAt)
+	if err != nil {
+		return err
+	}
+	if opens == closes {
+		return ErrScheduleEmptyHours
+	}
+
+	return nil
+}
+
+// Location loads the time zone of the schedule. Go reads "Local" and an empty
+// name as the zone of the server, which Postgres does not know, so both are
+// rejected: the zone is used in queries as well.
+func (s PVZSchedule) Location() (*time.Location, error) {
+	if s.TimeZone == "" || s.TimeZone == "Local" {
+		return nil, ErrScheduleInvalidZone
+	}
+
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, errors.Join(ErrScheduleInvalidZone, err)
+	}
+
+	return location, nil
+}
+
+// IsOpenAt reports whether the PVZ works at the given moment. Hours that cross
+// midnight (e.g. 20:00-08:00) are supported.
+func (s PVZSchedule) IsOpenAt(at time.Time) (bool, error) {
+	location, err := s.Location()
+	if err != nil {
+		return false, err
+	}
+
+	opens, err := parseClock(s.OpensAt)
+	if err != nil {
+		return false, err
+	}
+	closes, err := parseClock(s.ClosesAt)
+	if err != nil {
+		return false, err
+	}
+
+	local := at.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	if opens < closes {
+		return opens <= minute && minute < closes, nil
+	}
+
+	return opens <= minute || minute < closes, nil
+}
+
+func parseClock(clock string) (int, error) {
+	hours, minutes, ok := strings.Cut(clock, ":")
+	if !ok {
+		return 0, errors.Join(ErrScheduleInvalidClock, errors.New(clock))
+	}
+
+	h, errHours := strconv.Atoi(hours)
+	m, errMinutes := strconv.Atoi(minutes)
+	if errHours != nil || errMinutes != nil || len(minutes) != 2 ||
+		h < 0 || m < 0 || m >= 60 || h*60+m > minutesPerDay {
+		return 0, errors.Join(ErrScheduleInvalidClock, errors.New(clock))
+	}
+
+	return h*60 + m, nil
+}
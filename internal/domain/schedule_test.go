@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"avito_pvz/internal/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVZSchedule_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schedule domain.PVZSchedule
+		err      error
+	}{
+		{
+			name:     "Defaults",
+			schedule: domain.PVZSchedule{}.WithDefaults(),
+		},
+		{
+			name:     "Overnight",
+			schedule: domain.PVZSchedule{TimeZone: "Asia/Vladivostok", OpensAt: "20:00", ClosesAt: "08:00"},
+		},
+		{
+			name:     "Unknown time zone",
+			schedule: domain.PVZSchedule{TimeZone: "Mars/Olympus", OpensAt: "09:00", ClosesAt: "21:00"},
+			err:      domain.ErrScheduleInvalidZone,
+		},
+		{
+			name:     "Server local time zone",
+			schedule: domain.PVZSchedule{TimeZone: "Local", OpensAt: "09:00", ClosesAt: "21:00"},
+			err:      domain.ErrScheduleInvalidZone,
+		},
+		{
+			name:     "Empty time zone",
+			schedule: domain.PVZSchedule{OpensAt: "09:00", ClosesAt: "21:00"},
+			err:      domain.ErrScheduleInvalidZone,
+		},
+		{
+			name:     "Invalid clock",
+			schedule: domain.PVZSchedule{TimeZone: "UTC", OpensAt: "9", ClosesAt: "21:00"},
+			err:      domain.ErrScheduleInvalidClock,
+		},
+		{
+			name:     "Clock out of range",
+			schedule: domain.PVZSchedule{TimeZone: "UTC", OpensAt: "09:00", ClosesAt: "24:30"},
+			err:      domain.ErrScheduleInvalidClock,
+		},
+		{
+			name:     "Empty hours",
+			schedule: domain.PVZSchedule{TimeZone: "UTC", OpensAt: "09:00", ClosesAt: "09:00"},
+			err:      domain.ErrScheduleEmptyHours,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.schedule.Validate()
+			if test.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestPVZSchedule_IsOpenAt(t *testing.T) {
+	t.Parallel()
+
+	// 06:30 UTC is 09:30 in Moscow and 16:30 in Vladivostok.
+	at := time.Date(2025, time.April, 10, 6, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule domain.PVZSchedule
+		open     bool
+	}{
+		{
+			name:     "Round the clock",
+			schedule: domain.PVZSchedule{}.WithDefaults(),
+			open:     true,
+		},
+		{
+			name:     "Open in local time",
+			schedule: domain.PVZSchedule{TimeZone: "Europe/Moscow", OpensAt: "09:00", ClosesAt: "21:00"},
+			open:     true,
+		},
+		{
+			name:     "Closed in local time",
+			schedule: domain.PVZSchedule{TimeZone: "Europe/Moscow", OpensAt: "10:00", ClosesAt: "21:00"},
+			open:     false,
+		},
+		{
+			name:     "Overnight open",
+			schedule: domain.PVZSchedule{TimeZone: "Asia/Vladivostok", OpensAt: "16:00", ClosesAt: "02:00"},
+			open:     true,
+		},
+		{
+			name:     "Overnight closed",
+			schedule: domain.PVZSchedule{TimeZone: "Asia/Vladivostok", OpensAt: "20:00", ClosesAt: "08:00"},
+			open:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			open, err := test.schedule.IsOpenAt(at)
+			require.NoError(t, err)
+			require.Equal(t, test.open, open)
+		})
+	}
+}
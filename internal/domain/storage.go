@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CellAllocationFillFirst puts a product into the free cell with the
+	// lowest position, whatever the cell type.
+	CellAllocationFillFirst CellAllocation = "fill_first"
+	// CellAllocationTypeGrouped puts a product into a free cell reserved for
+	// its type, or into a free cell without a type when those are taken.
+	CellAllocationTypeGrouped CellAllocation = "type_grouped"
+)
+
+const (
+	// MaxStorageCells bounds the number of cells of a PVZ.
+	MaxStorageCells = 5000
+	// MaxStorageCellCodeLength bounds the code printed on a cell.
+	MaxStorageCellCodeLength = 32
+)
+
+var (
+	errStorageLayout                  = errors.New("storage layout error")
+	ErrStorageLayoutInvalidAllocation = errors.Join(errStorageLayout, errors.New("invalid allocation"))
+	ErrStorageLayoutTooManyCells      = errors.Join(errStorageLayout, errors.New("too many cells"))
+	ErrStorageLayoutInvalidCode       = errors.Join(errStorageLayout, errors.New("invalid cell code"))
+	ErrStorageLayoutDuplicateCode     = errors.Join(errStorageLayout, errors.New("duplicate cell code"))
+
+	errStorage                         = errors.New("storage service error")
+	ErrAvitoServiceStorageInvalidPVZID = errors.Join(errStorage, errors.New("invalid pvz id"))
+	ErrAvitoServiceFindStorageLayout   = errors.Join(
+		errStorage,
+		errors.New("find layout failed"),
+	)
+	errAvitoServiceSaveStorageLayout = errors.Join(
+		errStorage,
+		errors.New("save layout failed"),
+	)
+	ErrAvitoServiceSaveStorageLayoutInvalid = errors.Join(
+		errAvitoServiceSaveStorageLayout,
+		errors.New("invalid layout"),
+	)
+	ErrAvitoServiceSaveStorageLayoutFindPVZ = errors.Join(
+		errAvitoServiceSaveStorageLayout,
+		errors.New("find pvz failed"),
+	)
+	ErrAvitoServiceSaveStorageLayout = errors.Join(
+		errAvitoServiceSaveStorageLayout,
+		errors.New("save layout failed"),
+	)
+)
+
+// Valid tells whether the allocation is a known strategy.
+func (a CellAllocation) Valid() bool {
+	return a == CellAllocationFillFirst || a == CellAllocationTypeGrouped
+}
+
+// Normalize validates the layout, trims the cell codes and numbers the cells
+// in the order given. An empty allocation means fill-first.
+func (l StorageLayout) Normalize() (StorageLayout, error) {
+	if l.Allocation == "" {
+		l.Allocation = CellAllocationFillFirst
+	}
+	if !l.Allocation.Valid() {
+		return l, errors.Join(ErrStorageLayoutInvalidAllocation, errors.New(string(l.Allocation)))
+	}
+	if len(l.Cells) > MaxStorageCells {
+		return l, errors.Join(ErrStorageLayoutTooManyCells, errors.New(strconv.Itoa(len(l.Cells))+" cells"))
+	}
+
+	cells := make([]StorageCell, 0, len(l.Cells))
+	codes := make(map[string]bool, len(l.Cells))
+	for i, cell := range l.Cells {
+		code := strings.TrimSpace(cell.Code)
+		if code == "" || utf8.RuneCountInString(code) > MaxStorageCellCodeLength {
+			return l, errors.Join(ErrStorageLayoutInvalidCode, errors.New(code))
+		}
+		if codes[code] {
+			return l, errors.Join(ErrStorageLayoutDuplicateCode, errors.New(code))
+		}
+		codes[code] = true
+
+		cells = append(cells, StorageCell{
+			ID:       cell.ID,
+			PVZID:    l.PVZID,
+			Code:     code,
+			Type:     cell.Type,
+			Position: i + 1,
+		})
+	}
+
+	return StorageLayout{PVZID: l.PVZID, Allocation: l.Allocation, Cells: cells}, nil
+}
+
+// cellTypes returns the distinct types the cells are reserved for.
+func (l StorageLayout) cellTypes() []ProductType {
+	var productTypes []ProductType
+	seen := make(map[ProductType]bool)
+	for _, cell := range l.Cells {
+		if cell.Type != nil && !seen[*cell.Type] {
+			seen[*cell.Type] = true
+			productTypes = append(productTypes, *cell.Type)
+		}
+	}
+
+	return productTypes
+}
+
+// FindStorageLayout returns the storage cells of the PVZ with the products
+// they hold.
+func (s *ReceptionService) FindStorageLayout(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	pvzID PVZID,
+) (StorageLayout, error) {
+	if authUser == nil ||
+		authUser.GetUserRole() != Moderator && authUser.GetUserRole() != Employee {
+		return StorageLayout{}, ErrNotAuthorized
+	}
+
+	if err := validID(pvzID); err != nil {
+		return StorageLayout{}, errors.Join(ErrAvitoServiceStorageInvalidPVZID, err)
+	}
+
+	var layout StorageLayout
+	err := s.provider.ExecuteReadOnly(ctx, func(ctx context.Context, c Connection) error {
+		if _, err := s.pvzRepo.FindByID(ctx, c, pvzID); err != nil {
+			return err
+		}
+
+		var err error
+		layout, err = s.cellRepo.FindLayout(ctx, c, pvzID)
+
+		return err
+	})
+	if err != nil {
+		return StorageLayout{}, errors.Join(ErrAvitoServiceFindStorageLayout, err)
+	}
+
+	return layout, nil
+}
+
+// SaveStorageLayout replaces the storage cells of the PVZ. Cells are matched
+// by code, so products stay in the cells that are kept; removing a cell that
+// holds a product fails with ErrStorageCellOccupied. Cell types must be in the
+// catalogue.
+func (s *ReceptionService) SaveStorageLayout(
+	ctx context.Context,
+	authUser AuthenticatedUser,
+	layout StorageLayout,
+) (StorageLayout, error) {
+	if authUser == nil || authUser.GetUserRole() != Moderator {
+		return StorageLayout{}, ErrNotAuthorized
+	}
+
+	if err := validID(layout.PVZID); err != nil {
+		return StorageLayout{}, errors.Join(ErrAvitoServiceStorageInvalidPVZID, err)
+	}
+	layout, err := layout.Normalize()
+	if err != nil {
+		return StorageLayout{}, errors.Join(ErrAvitoServiceSaveStorageLayoutInvalid, err)
+	}
+	for i := range layout.Cells {
+		layout.Cells[i].ID = uuid.New()
+	}
+
+	var saved StorageLayout
+	err = s.provider.ExecuteTx(ctx, func(ctx context.Context, c Connection) error {
+		if _, err := s.pvzRepo.FindByID(ctx, c, layout.PVZID); err != nil {
+			return errors.Join(ErrAvitoServiceSaveStorageLayoutFindPVZ, err)
+		}
+
+		if err := s.checkCellTypes(ctx, c, layout.cellTypes()); err != nil {
+			return errors.Join(ErrAvitoServiceSaveStorageLayoutInvalid, err)
+		}
+
+		if err := s.cellRepo.SaveLayout(ctx, c, layout); err != nil {
+			return errors.Join(ErrAvitoServiceSaveStorageLayout, err)
+		}
+
+		var err error
+		saved, err = s.cellRepo.FindLayout(ctx, c, layout.PVZID)
+		if err != nil {
+			return errors.Join(ErrAvitoServiceSaveStorageLayout, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return StorageLayout{}, err
+	}
+
+	return saved, nil
+}
+
+// checkCellTypes makes sure every type is in the catalogue. Unlike new
+// products, cells may keep a type that was deactivated.
+func (s *ReceptionService) checkCellTypes(ctx context.Context, c Connection, productTypes []ProductType) error {
+	if len(productTypes) == 0 {
+		return nil
+	}
+
+	entries, err := s.typeRepo.FindByCodes(ctx, c, productTypes)
+	if err != nil {
+		return err
+	}
+	if len(entries) != len(productTypes) {
+		return ErrProductTypeNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,588 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageLayout_Normalize(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	shoes := pointer.Ref(domain.Shoes)
+
+	tests := []struct {
+		name   string
+		layout domain.StorageLayout
+		want   domain.StorageLayout
+		err    error
+	}{
+		{
+			name: "Numbered in order",
+			layout: domain.StorageLayout{
+				PVZID:      pvzID,
+				Allocation: domain.CellAllocationTypeGrouped,
+				Cells:      []domain.StorageCell{{Code: " B-1 ", Type: shoes}, {Code: "A-1"}},
+			},
+			want: domain.StorageLayout{
+				PVZID:      pvzID,
+				Allocation: domain.CellAllocationTypeGrouped,
+				Cells: []domain.StorageCell{
+					{PVZID: pvzID, Code: "B-1", Type: shoes, Position: 1},
+					{PVZID: pvzID, Code: "A-1", Position: 2},
+				},
+			},
+		},
+		{
+			name:   "Fill-first by default",
+			layout: domain.StorageLayout{PVZID: pvzID},
+			want: domain.StorageLayout{
+				PVZID:      pvzID,
+				Allocation: domain.CellAllocationFillFirst,
+				Cells:      []domain.StorageCell{},
+			},
+		},
+		{
+			name:   "Unknown allocation",
+			layout: domain.StorageLayout{Allocation: "random"},
+			err:    domain.ErrStorageLayoutInvalidAllocation,
+		},
+		{
+			name:   "Empty code",
+			layout: domain.StorageLayout{Cells: []domain.StorageCell{{Code: " "}}},
+			err:    domain.ErrStorageLayoutInvalidCode,
+		},
+		{
+			name: "Long code",
+			layout: domain.StorageLayout{
+				Cells: []domain.StorageCell{{Code: strings.Repeat("Я", domain.MaxStorageCellCodeLength+1)}},
+			},
+			err: domain.ErrStorageLayoutInvalidCode,
+		},
+		{
+			name:   "Duplicate code",
+			layout: domain.StorageLayout{Cells: []domain.StorageCell{{Code: "A-1"}, {Code: "A-1 "}}},
+			err:    domain.ErrStorageLayoutDuplicateCode,
+		},
+		{
+			name:   "Too many cells",
+			layout: domain.StorageLayout{Cells: make([]domain.StorageCell, domain.MaxStorageCells+1)},
+			err:    domain.ErrStorageLayoutTooManyCells,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			layout, err := test.layout.Normalize()
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, layout)
+		})
+	}
+}
+
+func TestServiceReception_CreateProductAllocatesCell(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := domain.Reception{ID: uuid.New(), PVZID: pvzID, Status: domain.InProgress}
+	cell := &domain.StorageCell{ID: uuid.New(), PVZID: pvzID, Code: "A-1", Position: 1}
+	shoes := domain.ProductDraft{Type: domain.Shoes}
+	// The reception is looked up, the product is inserted and the cell is
+	// allocated in one transaction: a close either waits for all of them or
+	// leaves the product nothing to be added to.
+	tx := &mocks.MockConnection{}
+	inTx := mock.MatchedBy(func(c domain.Connection) bool { return c == tx })
+
+	tests := []struct {
+		name    string
+		draft   domain.ProductDraft
+		prepare func(*mocks.MockStorageCellsRepository, *mocks.MockMetrics)
+		check   func(*testing.T, domain.AddedProduct, error)
+	}{
+		{
+			name:  "Cell allocated",
+			draft: shoes,
+			prepare: func(repo *mocks.MockStorageCellsRepository, m *mocks.MockMetrics) {
+				repo.EXPECT().
+					Allocate(mock.Anything, inTx, pvzID, mock.MatchedBy(func(product domain.Product) bool {
+						return product.Type == domain.Shoes
+					})).
+					RunAndReturn(func(
+						_ context.Context,
+						_ domain.Connection,
+						_ domain.PVZID,
+						product domain.Product,
+					) (*domain.StorageCell, error) {
+						allocated := *cell
+						allocated.ProductID = &product.ID
+
+						return &allocated, nil
+					}).
+					Once()
+				m.EXPECT().IncProducts().Return().Once()
+			},
+			check: func(t *testing.T, product domain.AddedProduct, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, product.Cell)
+				require.Equal(t, "A-1", product.Cell.Code)
+				require.Equal(t, &product.ID, product.Cell.ProductID)
+			},
+		},
+		{
+			name:  "No free cell",
+			draft: shoes,
+			prepare: func(repo *mocks.MockStorageCellsRepository, m *mocks.MockMetrics) {
+				repo.EXPECT().
+					Allocate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+				m.EXPECT().IncProducts().Return().Once()
+			},
+			check: func(t *testing.T, product domain.AddedProduct, err error) {
+				require.NoError(t, err)
+				require.Nil(t, product.Cell)
+			},
+		},
+		{
+			name:  "Allocate error",
+			draft: shoes,
+			prepare: func(repo *mocks.MockStorageCellsRepository, _ *mocks.MockMetrics) {
+				repo.EXPECT().
+					Allocate(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errors.New("some error")).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.AddedProduct, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductAllocateCell)
+			},
+		},
+		{
+			name: "Rejected product takes no cell",
+			draft: domain.ProductDraft{Type: domain.Shoes, ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+				InspectionReason: pointer.Ref("Коробка вскрыта"),
+			}},
+			prepare: func(_ *mocks.MockStorageCellsRepository, m *mocks.MockMetrics) {
+				m.EXPECT().IncProducts().Return().Once()
+			},
+			check: func(t *testing.T, product domain.AddedProduct, err error) {
+				require.NoError(t, err)
+				require.Nil(t, product.Cell)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			provider.EXPECT().
+				ExecuteTx(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+					return f(ctx, tx)
+				}).
+				Once()
+			repoReception := mocks.NewMockReceptionsRepository(t)
+			repoReception.EXPECT().FindActive(mock.Anything, inTx, pvzID).Return(reception, nil).Once()
+			repoProduct := mocks.NewMockProductsRepository(t)
+			repoProduct.EXPECT().Create(mock.Anything, inTx, mock.Anything).Return(nil).Once()
+			repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+			repoAnalytics.EXPECT().AddProduct(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			repoCells := mocks.NewMockStorageCellsRepository(t)
+			metrics := mocks.NewMockMetrics(t)
+
+			test.prepare(repoCells, metrics)
+
+			product, err := domain.NewReceptionService(
+				provider,
+				repoReception,
+				repoProduct,
+				mocks.NewMockPVZsRepository(t),
+				repoAnalytics,
+				fixtureProductTypes(t),
+				mocks.NewMockOrdersRepository(t),
+				repoCells,
+				metrics,
+			).CreateProduct(t.Context(), fixtureAuthUser(t, domain.Employee), pvzID, test.draft)
+			test.check(t, product, err)
+		})
+	}
+}
+
+// A reception closed while the product was on its way is not found under the
+// lock, so no product is inserted and no cell is taken.
+func TestServiceReception_CreateProductReceptionClosed(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	provider := mocks.NewMockConnectionProvider(t)
+	provider.EXPECT().
+		ExecuteTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+			return f(ctx, &mocks.MockConnection{})
+		}).
+		Once()
+	repoReception := mocks.NewMockReceptionsRepository(t)
+	repoReception.EXPECT().
+		FindActive(mock.Anything, mock.Anything, pvzID).
+		Return(domain.Reception{}, domain.ErrReceptionNotFound).
+		Once()
+
+	_, err := domain.NewReceptionService(
+		provider,
+		repoReception,
+		mocks.NewMockProductsRepository(t),
+		mocks.NewMockPVZsRepository(t),
+		mocks.NewMockAnalyticsRepository(t),
+		fixtureProductTypes(t),
+		mocks.NewMockOrdersRepository(t),
+		mocks.NewMockStorageCellsRepository(t),
+		mocks.NewMockMetrics(t),
+	).CreateProduct(t.Context(), fixtureAuthUser(t, domain.Employee), pvzID, domain.ProductDraft{Type: domain.Shoes})
+	require.ErrorIs(t, err, domain.ErrAvitoServiceCreateProductFindActive)
+	require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+}
+
+func TestServiceReception_IssueProductFreesCell(t *testing.T) {
+	t.Parallel()
+
+	product := domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Type: domain.Shoes, Status: domain.ProductStored}
+
+	provider := mocks.NewMockConnectionProvider(t)
+	provider.EXPECT().
+		ExecuteTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+			return f(ctx, &mocks.MockConnection{})
+		}).
+		Once()
+	repoProduct := mocks.NewMockProductsRepository(t)
+	repoProduct.EXPECT().
+		FindByIDs(mock.Anything, mock.Anything, mock.Anything).
+		Return([]domain.Product{product}, nil).
+		Once()
+	repoProduct.EXPECT().ChangeStatus(mock.Anything, mock.Anything, mock.Anything).Return(product, nil).Once()
+	repoCells := mocks.NewMockStorageCellsRepository(t)
+	repoCells.EXPECT().
+		Free(mock.Anything, mock.Anything, []domain.ProductID{product.ID}).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := domain.NewReceptionService(
+		provider,
+		mocks.NewMockReceptionsRepository(t),
+		repoProduct,
+		mocks.NewMockPVZsRepository(t),
+		mocks.NewMockAnalyticsRepository(t),
+		mocks.NewMockProductTypesRepository(t),
+		fixtureNoWaitingOrders(t),
+		repoCells,
+		mocks.NewMockMetrics(t),
+	).IssueProduct(t.Context(), fixtureAuthUser(t, domain.Employee), product.ID)
+	require.ErrorIs(t, err, domain.ErrAvitoServiceChangeProductStatus)
+}
+
+func TestServiceReception_CloseFreesReturnedCells(t *testing.T) {
+	t.Parallel()
+
+	reception := domain.Reception{ID: uuid.New(), PVZID: uuid.New(), Status: domain.InProgress}
+	returned := domain.Product{ID: uuid.New(), ReceptionID: reception.ID, Status: domain.ProductReturned}
+	stored := domain.Product{ID: uuid.New(), ReceptionID: reception.ID, Status: domain.ProductStored}
+
+	provider := mocks.NewMockConnectionProvider(t)
+	provider.EXPECT().
+		ExecuteTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+			return f(ctx, &mocks.MockConnection{})
+		}).
+		Once()
+	repoReception := mocks.NewMockReceptionsRepository(t)
+	repoReception.EXPECT().FindActive(mock.Anything, mock.Anything, reception.PVZID).Return(reception, nil).Once()
+	repoReception.EXPECT().Close(mock.Anything, mock.Anything, reception.ID, mock.Anything).Return(nil).Once()
+	repoAnalytics := mocks.NewMockAnalyticsRepository(t)
+	repoAnalytics.EXPECT().CloseReception(mock.Anything, mock.Anything, reception.ID).Return(nil).Once()
+	repoProduct := mocks.NewMockProductsRepository(t)
+	repoProduct.EXPECT().StoreReceived(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	repoProduct.EXPECT().
+		FindByReceptionIDs(mock.Anything, mock.Anything, []domain.ReceptionID{reception.ID}).
+		Return([]domain.Product{returned, stored}, nil).
+		Once()
+	repoCells := mocks.NewMockStorageCellsRepository(t)
+	repoCells.EXPECT().
+		Free(mock.Anything, mock.Anything, []domain.ProductID{returned.ID}).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := domain.NewReceptionService(
+		provider,
+		repoReception,
+		repoProduct,
+		mocks.NewMockPVZsRepository(t),
+		repoAnalytics,
+		mocks.NewMockProductTypesRepository(t),
+		mocks.NewMockOrdersRepository(t),
+		repoCells,
+		mocks.NewMockMetrics(t),
+	).Close(t.Context(), fixtureAuthUser(t, domain.Employee), reception.PVZID)
+	require.ErrorIs(t, err, domain.ErrAvitoServiceCloseReception)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestServiceReception_FindStorageLayout(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	layout := domain.StorageLayout{
+		PVZID:      pvzID,
+		Allocation: domain.CellAllocationFillFirst,
+		Cells:      []domain.StorageCell{{ID: uuid.New(), PVZID: pvzID, Code: "A-1", Position: 1}},
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository, *mocks.MockStorageCellsRepository)
+		check        func(*testing.T, domain.StorageLayout, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				cells *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil).Once()
+				cells.EXPECT().FindLayout(mock.Anything, mock.Anything, pvzID).Return(layout, nil).Once()
+			},
+			check: func(t *testing.T, got domain.StorageLayout, err error) {
+				require.NoError(t, err)
+				require.Equal(t, layout, got)
+			},
+		},
+		{
+			name:     "PVZ not found",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				_ *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteReadOnly(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzs.EXPECT().
+					FindByID(mock.Anything, mock.Anything, pvzID).
+					Return(domain.PVZ{}, domain.ErrPVZNotFound).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.StorageLayout, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceFindStorageLayout)
+				require.ErrorIs(t, err, domain.ErrPVZNotFound)
+			},
+		},
+		{
+			name: "Not authorized",
+			check: func(t *testing.T, _ domain.StorageLayout, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoPVZ := mocks.NewMockPVZsRepository(t)
+			repoCells := mocks.NewMockStorageCellsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoPVZ, repoCells)
+			}
+
+			got, err := domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				mocks.NewMockProductsRepository(t),
+				repoPVZ,
+				mocks.NewMockAnalyticsRepository(t),
+				mocks.NewMockProductTypesRepository(t),
+				mocks.NewMockOrdersRepository(t),
+				repoCells,
+				mocks.NewMockMetrics(t),
+			).FindStorageLayout(t.Context(), test.authUser, pvzID)
+			test.check(t, got, err)
+		})
+	}
+}
+
+func TestServiceReception_SaveStorageLayout(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	layout := domain.StorageLayout{
+		PVZID:      pvzID,
+		Allocation: domain.CellAllocationTypeGrouped,
+		Cells:      []domain.StorageCell{{Code: "A-1", Type: pointer.Ref(domain.Shoes)}, {Code: "A-2"}},
+	}
+
+	tests := []struct {
+		name         string
+		authUser     domain.AuthenticatedUser
+		layout       domain.StorageLayout
+		prepareMocks func(*mocks.MockConnectionProvider, *mocks.MockPVZsRepository, *mocks.MockStorageCellsRepository)
+		check        func(*testing.T, domain.StorageLayout, error)
+	}{
+		{
+			name:     "Success",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			layout:   layout,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				cells *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil).Once()
+				cells.EXPECT().
+					SaveLayout(mock.Anything, mock.Anything, mock.MatchedBy(func(saved domain.StorageLayout) bool {
+						return len(saved.Cells) == 2 && saved.Cells[1].Position == 2 && saved.Cells[1].ID != uuid.Nil
+					})).
+					Return(nil).
+					Once()
+				cells.EXPECT().FindLayout(mock.Anything, mock.Anything, pvzID).Return(layout, nil).Once()
+			},
+			check: func(t *testing.T, got domain.StorageLayout, err error) {
+				require.NoError(t, err)
+				require.Equal(t, layout, got)
+			},
+		},
+		{
+			name:     "Occupied cell removed",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			layout:   layout,
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				cells *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil).Once()
+				cells.EXPECT().
+					SaveLayout(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ErrStorageCellOccupied).
+					Once()
+			},
+			check: func(t *testing.T, _ domain.StorageLayout, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceSaveStorageLayout)
+				require.ErrorIs(t, err, domain.ErrStorageCellOccupied)
+			},
+		},
+		{
+			name:     "Unknown cell type",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			layout: domain.StorageLayout{
+				PVZID: pvzID,
+				Cells: []domain.StorageCell{{Code: "A-1", Type: pointer.Ref(domain.ProductType("посуда"))}},
+			},
+			prepareMocks: func(
+				provider *mocks.MockConnectionProvider,
+				pvzs *mocks.MockPVZsRepository,
+				_ *mocks.MockStorageCellsRepository,
+			) {
+				provider.EXPECT().
+					ExecuteTx(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, f func(context.Context, domain.Connection) error) error {
+						return f(ctx, &mocks.MockConnection{})
+					}).
+					Once()
+				pvzs.EXPECT().FindByID(mock.Anything, mock.Anything, pvzID).Return(domain.PVZ{ID: pvzID}, nil).Once()
+			},
+			check: func(t *testing.T, _ domain.StorageLayout, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceSaveStorageLayoutInvalid)
+				require.ErrorIs(t, err, domain.ErrProductTypeNotFound)
+			},
+		},
+		{
+			name:     "Invalid layout",
+			authUser: fixtureAuthUser(t, domain.Moderator),
+			layout:   domain.StorageLayout{PVZID: pvzID, Cells: []domain.StorageCell{{Code: ""}}},
+			check: func(t *testing.T, _ domain.StorageLayout, err error) {
+				require.ErrorIs(t, err, domain.ErrAvitoServiceSaveStorageLayoutInvalid)
+			},
+		},
+		{
+			name:     "Employee not authorized",
+			authUser: fixtureAuthUser(t, domain.Employee),
+			layout:   layout,
+			check: func(t *testing.T, _ domain.StorageLayout, err error) {
+				require.ErrorIs(t, err, domain.ErrNotAuthorized)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := mocks.NewMockConnectionProvider(t)
+			repoPVZ := mocks.NewMockPVZsRepository(t)
+			repoCells := mocks.NewMockStorageCellsRepository(t)
+
+			if test.prepareMocks != nil {
+				test.prepareMocks(provider, repoPVZ, repoCells)
+			}
+
+			got, err := domain.NewReceptionService(
+				provider,
+				mocks.NewMockReceptionsRepository(t),
+				mocks.NewMockProductsRepository(t),
+				repoPVZ,
+				mocks.NewMockAnalyticsRepository(t),
+				fixtureProductTypes(t),
+				mocks.NewMockOrdersRepository(t),
+				repoCells,
+				mocks.NewMockMetrics(t),
+			).SaveStorageLayout(t.Context(), test.authUser, test.layout)
+			test.check(t, got, err)
+		})
+	}
+}
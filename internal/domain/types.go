@@ -16,7 +16,18 @@ type (
 	ReceptionID     = uuid.UUID
 	ReceptionStatus string
 	ProductID       = uuid.UUID
+	OrderID         = uuid.UUID
+	StorageCellID   = uuid.UUID
 	ProductType     string
+	// InspectionStatus is the outcome of checking a product before acceptance.
+	InspectionStatus string
+	// ProductStatus is the stage of the product lifecycle at the PVZ.
+	ProductStatus string
+	// CellAllocation is the strategy picking a free storage cell for an
+	// accepted product.
+	CellAllocation string
+	PVZStatus      string
+	PVZSort        string
 
 	User struct {
 		ID           UserID   `db:"id"`
@@ -30,20 +41,274 @@ type (
 		ID           PVZID
 		City         PVZCity
 		RegisteredAt time.Time
+		PVZSchedule
 	}
 
+	// PVZSchedule describes daily working hours of a PVZ in its own time zone.
+	// OpensAt and ClosesAt are "HH:MM" clock values, ClosesAt may be "24:00".
+	PVZSchedule struct {
+		TimeZone string
+		OpensAt  string
+		ClosesAt string
+	}
+
+	// Reception records who opened and closed it; either is nil when unknown.
 	Reception struct {
 		ID        ReceptionID     `db:"id"`
 		PVZID     PVZID           `db:"pvz_id"`
 		Status    ReceptionStatus `db:"status"`
 		CreatedAt time.Time       `db:"created_at"`
+		CreatedBy *UserID         `db:"created_by"`
+		ClosedBy  *UserID         `db:"closed_by"`
 	}
 
+	// Product is a parcel received at a PVZ. Barcode is the barcode or order
+	// number printed on it, nil when it was not scanned.
 	Product struct {
-		ID          ProductID   `db:"id"`
-		ReceptionID ReceptionID `db:"reception_id"`
-		Type        ProductType `db:"type"`
-		CreatedAt   time.Time   `db:"created_at"`
+		ID          ProductID     `db:"id"`
+		ReceptionID ReceptionID   `db:"reception_id"`
+		Type        ProductType   `db:"type"`
+		CreatedAt   time.Time     `db:"created_at"`
+		CreatedBy   *UserID       `db:"created_by"`
+		Barcode     *string       `db:"barcode"`
+		Status      ProductStatus `db:"status"`
+		ProductAttributes
+	}
+
+	// ProductTransition records a product changing its status. ChangedBy is
+	// nil for changes made by background jobs.
+	ProductTransition struct {
+		ProductID ProductID     `db:"product_id"`
+		From      ProductStatus `db:"from_status"`
+		To        ProductStatus `db:"to_status"`
+		ChangedAt time.Time     `db:"changed_at"`
+		ChangedBy *UserID       `db:"changed_by"`
+	}
+
+	// ProductAttributes are the physical attributes and the inspection
+	// outcome of a product, each nil when it was not recorded. Weight is in
+	// grams, the dimensions are in centimetres and set together, the declared
+	// value is in kopecks. InspectionReason explains a damaged or rejected
+	// product.
+	ProductAttributes struct {
+		WeightGrams      *int              `db:"weight_grams"`
+		LengthCM         *int              `db:"length_cm"`
+		WidthCM          *int              `db:"width_cm"`
+		HeightCM         *int              `db:"height_cm"`
+		DeclaredValue    *int64            `db:"declared_value"`
+		InspectionStatus *InspectionStatus `db:"inspection_status"`
+		InspectionReason *string           `db:"inspection_reason"`
+	}
+
+	// Order is a customer order waiting at a PVZ. PickupCode is given to the
+	// customer and checked when the order is picked up. IssuedAt and IssuedBy
+	// are nil until then.
+	Order struct {
+		ID         OrderID    `db:"id"`
+		PVZID      PVZID      `db:"pvz_id"`
+		PickupCode string     `db:"pickup_code"`
+		CreatedAt  time.Time  `db:"created_at"`
+		CreatedBy  *UserID    `db:"created_by"`
+		IssuedAt   *time.Time `db:"issued_at"`
+		IssuedBy   *UserID    `db:"issued_by"`
+	}
+
+	// OrderProducts is an order with its products.
+	OrderProducts struct {
+		Order    Order
+		Products []Product
+	}
+
+	// ProductTypeEntry is a product type of the catalogue. Names are display
+	// names keyed by language code. Inactive types stay on the products
+	// already received but cannot be used for new ones.
+	ProductTypeEntry struct {
+		Code   ProductType       `db:"code"`
+		Names  map[string]string `db:"names"`
+		Active bool              `db:"active"`
+	}
+
+	// ProductDraft is a product about to be added to a reception.
+	ProductDraft struct {
+		Type    ProductType
+		Barcode *string
+		ProductAttributes
+	}
+
+	// AddedProduct is a just added product with the products carrying the
+	// same barcode in other receptions in progress. Such duplicates usually
+	// mean a parcel was delivered to the wrong PVZ. Cell is the storage cell
+	// allocated to the product, nil when the PVZ has no free cell or the product
+	// was inspected as rejected.
+	AddedProduct struct {
+		Product
+		Duplicates []Product
+		Cell       *StorageCell
+	}
+
+	// StorageCell is a shelf place of a PVZ holding one product at most.
+	// Type reserves the cell for products of that type under type-grouped
+	// allocation. ProductID is nil while the cell is free.
+	StorageCell struct {
+		ID        StorageCellID `db:"id"`
+		PVZID     PVZID         `db:"pvz_id"`
+		Code      string        `db:"code"`
+		Type      *ProductType  `db:"type"`
+		Position  int           `db:"position"`
+		ProductID *ProductID    `db:"product_id"`
+	}
+
+	// StorageLayout is the storage cells of a PVZ in allocation order with
+	// the strategy picking among them.
+	StorageLayout struct {
+		PVZID      PVZID
+		Allocation CellAllocation
+		Cells      []StorageCell
+	}
+
+	// ProductLocation is a product with the reception and PVZ it was received in.
+	ProductLocation struct {
+		PVZ       PVZ
+		Reception Reception
+		Product   Product
+	}
+
+	// ReceptionReopening records a moderator putting a closed reception back
+	// in progress. ClosedAt and ClosedBy describe the close that was undone.
+	ReceptionReopening struct {
+		ID          uuid.UUID
+		ReceptionID ReceptionID
+		ReopenedAt  time.Time
+		ReopenedBy  UserID
+		Reason      string
+		ClosedAt    *time.Time
+		ClosedBy    *UserID
+	}
+
+	// ManifestItem is a count of products of one type.
+	ManifestItem struct {
+		Type  ProductType `db:"type"`
+		Count int         `db:"count"`
+	}
+
+	// ReceptionManifest is what a delivery is expected to contain according to
+	// its packing list, at most one item per product type.
+	ReceptionManifest []ManifestItem
+
+	// DiscrepancyReport compares the products of a closed reception with its
+	// manifest: Missing are expected but not received, Extra are received but
+	// not expected. Both are ordered by product type.
+	DiscrepancyReport struct {
+		Missing []ManifestItem
+		Extra   []ManifestItem
+	}
+
+	// ClosedReception is a just closed reception with the summary of its
+	// products and its discrepancy report, Discrepancy is nil when the
+	// reception was opened without a manifest.
+	ClosedReception struct {
+		Reception
+		Summary     ProductSummary
+		Discrepancy *DiscrepancyReport
+	}
+
+	// ProductSummary aggregates products. Inspections counts the products by
+	// inspection status, Uninspected those without one. The totals add up
+	// the products the figure was recorded for.
+	ProductSummary struct {
+		Products      int
+		Inspections   map[InspectionStatus]int
+		Uninspected   int
+		WeightGrams   int64
+		VolumeCM3     int64
+		DeclaredValue int64
+	}
+
+	// DeletedProduct is a product removed from its reception.
+	DeletedProduct struct {
+		Product
+		DeletedAt time.Time `db:"deleted_at"`
+		DeletedBy *UserID   `db:"deleted_by"`
+	}
+
+	// Period bounds reception dates. Local dates are calendar days in the
+	// time zone of the PVZ the reception belongs to.
+	Period struct {
+		From      *time.Time
+		To        *time.Time
+		LocalFrom *time.Time
+		LocalTo   *time.Time
+	}
+
+	// PVZFilter narrows and orders the PVZ list. Period, ReceptionStatus,
+	// ReceptionCreatedBy, ProductTypes and ProductStatuses select the
+	// receptions (and products) shown for every PVZ; when any of them is set
+	// only PVZs with such receptions are listed.
+	PVZFilter struct {
+		Period
+		Cities             []PVZCity
+		Status             *PVZStatus
+		ProductTypes       []ProductType
+		ProductStatuses    []ProductStatus
+		ReceptionStatus    *ReceptionStatus
+		ReceptionCreatedBy *UserID
+		HasActiveReception *bool
+		Sort               PVZSort
+		Descending         bool
+	}
+
+	// PVZPage is a page of the PVZ list with the filter it was selected by.
+	// Page is zero when the page was addressed by a cursor.
+	PVZPage struct {
+		Items      []PVZReceptionsProducts
+		Total      int
+		Page       int
+		Limit      int
+		Filter     PVZFilter
+		NextCursor *string
+	}
+
+	// ReceptionFilter narrows the reception history of a PVZ.
+	ReceptionFilter struct {
+		Period
+		Status    *ReceptionStatus
+		CreatedBy *UserID
+		ClosedBy  *UserID
+	}
+
+	// ReceptionSummary is a reception with the number of its products.
+	ReceptionSummary struct {
+		Reception
+		Products int `db:"products"`
+	}
+
+	// ReceptionPage is a page of the reception history of a PVZ, newest first.
+	ReceptionPage struct {
+		Items []ReceptionSummary
+		Total int
+		Page  int
+		Limit int
+	}
+
+	// ReceptionTimeline tells when and by whom a reception was opened and
+	// closed. ClosedAt is nil while the reception is in progress. Reopenings
+	// are the closes undone by moderators, oldest first.
+	ReceptionTimeline struct {
+		OpenedAt   time.Time
+		OpenedBy   *UserID
+		ClosedAt   *time.Time
+		ClosedBy   *UserID
+		Reopenings []ReceptionReopening
+	}
+
+	// ReceptionDetails is a reception with its PVZ, its timeline, all its
+	// products in the order they were added and the products deleted from it.
+	ReceptionDetails struct {
+		Reception       Reception
+		PVZ             PVZ
+		Timeline        ReceptionTimeline
+		Products        []Product
+		DeletedProducts []DeletedProduct
 	}
 
 	PVZReceptionsProducts struct {
@@ -56,6 +321,72 @@ type (
 		Products  []Product
 	}
 
+	// PVZDetails is a PVZ profile with its in-progress reception (if any) and
+	// all-time reception and product counters.
+	PVZDetails struct {
+		PVZ              PVZ
+		ActiveReception  *ReceptionsProducts
+		ReceptionsTotal  int
+		ReceptionsClosed int
+		ProductsByType   map[ProductType]int
+	}
+
+	// ExportRow is a product with the reception and PVZ it belongs to.
+	ExportRow struct {
+		PVZ       PVZ
+		Reception Reception
+		Product   Product
+	}
+
+	// DailyStats sums one calendar day (in the PVZ time zone) of reception
+	// activity. Receptions and their duration count on the day they were
+	// opened, products on the day they were added.
+	DailyStats struct {
+		Day                  time.Time
+		Receptions           int
+		ClosedReceptions     int
+		AvgReceptionDuration time.Duration
+		ProductsByType       map[ProductType]int
+	}
+
+	// CityStats aggregates the receptions of a city opened within a report
+	// window (in PVZ local days) and the products received in them.
+	CityStats struct {
+		City             PVZCity             `db:"city"`
+		PVZs             int                 `db:"pvzs"`
+		Receptions       int                 `db:"receptions"`
+		ReceptionsPerDay float64             `db:"-"`
+		ProductsByType   map[ProductType]int `db:"products_by_type"`
+		Busiest          []BusyPVZ           `db:"busiest"`
+	}
+
+	// BusyPVZ is a PVZ ranked by the number of products received in a window.
+	BusyPVZ struct {
+		PVZID      PVZID
+		Receptions int
+		Products   int
+	}
+
+	// CityReport compares a city with the earlier window of the report, which
+	// is the week before for a report of up to a week. The fleet report has an
+	// empty City.
+	CityReport struct {
+		City         PVZCity
+		Current      CityStats
+		PreviousWeek CityStats
+	}
+
+	// CitiesReport compares the window from From to To with the window from
+	// PreviousFrom to PreviousTo of the same length, whole weeks earlier.
+	CitiesReport struct {
+		From         time.Time
+		To           time.Time
+		PreviousFrom time.Time
+		PreviousTo   time.Time
+		Cities       []CityReport
+		Fleet        CityReport
+	}
+
 	AuthenticatedUser interface {
 		GetUserID() UserID
 		GetUserRole() UserRole
@@ -84,6 +415,55 @@ const (
 	Close      ReceptionStatus = "close"
 )
 
+const (
+	InspectionAccepted InspectionStatus = "accepted"
+	InspectionDamaged  InspectionStatus = "damaged"
+	InspectionRejected InspectionStatus = "rejected"
+)
+
+const (
+	ProductReceived ProductStatus = "received"
+	ProductStored   ProductStatus = "stored"
+	ProductIssued   ProductStatus = "issued"
+	ProductReturned ProductStatus = "returned"
+)
+
+const (
+	// PVZOpen and PVZClosed tell whether a PVZ works right now by its schedule.
+	PVZOpen   PVZStatus = "open"
+	PVZClosed PVZStatus = "closed"
+)
+
+const (
+	SortByRegisteredAt    PVZSort = "registeredAt"
+	SortByLastReceptionAt PVZSort = "lastReceptionAt"
+	SortByProductCount    PVZSort = "productCount"
+)
+
+const (
+	DefaultPage  = 1
+	DefaultLimit = 10
+	MaxLimit     = 30
+)
+
+const (
+	// MaxAnalyticsDays bounds the window of a daily analytics request and of
+	// a city report.
+	MaxAnalyticsDays = 366
+	// BusiestPVZs is how many PVZs a city report ranks.
+	BusiestPVZs = 3
+)
+
+// MaxProductBatch bounds how many products are added in one request.
+const MaxProductBatch = 500
+
+const (
+	DefaultTimeZone = "Europe/Moscow"
+	DefaultOpensAt  = "00:00"
+	DefaultClosesAt = "24:00"
+)
+
+// Product types seeded into the catalogue by the initial schema.
 const (
 	Electronics ProductType = "электроника"
 	Clothes     ProductType = "одежда"
@@ -98,22 +478,63 @@ type (
 	}
 
 	PVZsInterface interface {
-		Create(context.Context, AuthenticatedUser, PVZCity) (PVZ, error)
+		Create(context.Context, AuthenticatedUser, PVZCity, PVZSchedule) (PVZ, error)
 		FindPVZReceptionProducts(
 			context.Context,
 			AuthenticatedUser,
-			*time.Time,
-			*time.Time,
+			PVZFilter,
 			*int,
 			*int,
-		) ([]PVZReceptionsProducts, error)
+			*string,
+		) (PVZPage, error)
 		FindAll(context.Context) ([]PVZ, error)
+		FindDetails(context.Context, AuthenticatedUser, PVZID) (PVZDetails, error)
+		Export(ctx context.Context, authUser AuthenticatedUser, filter PVZFilter, each func(ExportRow) error) error
+		Stream(
+			ctx context.Context,
+			authUser AuthenticatedUser,
+			filter PVZFilter,
+			each func(PVZReceptionsProducts) error,
+		) error
 	}
 
 	ReceptionsInterface interface {
-		Create(context.Context, AuthenticatedUser, PVZID) (Reception, error)
-		CreateProduct(context.Context, AuthenticatedUser, PVZID, ProductType) (Product, error)
+		Create(context.Context, AuthenticatedUser, PVZID, bool, ReceptionManifest) (Reception, error)
+		CreateProduct(context.Context, AuthenticatedUser, PVZID, ProductDraft) (AddedProduct, error)
+		CreateProducts(context.Context, AuthenticatedUser, PVZID, []ProductDraft) ([]AddedProduct, error)
+		FindProductsByBarcode(context.Context, AuthenticatedUser, string) ([]ProductLocation, error)
 		DeleteLastProduct(context.Context, AuthenticatedUser, PVZID) error
-		Close(context.Context, AuthenticatedUser, PVZID) (Reception, error)
+		IssueProduct(context.Context, AuthenticatedUser, ProductID) (Product, error)
+		ReturnProduct(context.Context, AuthenticatedUser, ProductID) (Product, error)
+		CreateOrder(context.Context, AuthenticatedUser, PVZID, []ProductID) (OrderProducts, error)
+		Pickup(ctx context.Context, authUser AuthenticatedUser, pvzID PVZID, code string) (OrderProducts, error)
+		FindStorageLayout(context.Context, AuthenticatedUser, PVZID) (StorageLayout, error)
+		SaveStorageLayout(context.Context, AuthenticatedUser, StorageLayout) (StorageLayout, error)
+		Close(context.Context, AuthenticatedUser, PVZID) (ClosedReception, error)
+		FindByPVZ(
+			ctx context.Context,
+			authUser AuthenticatedUser,
+			pvzID PVZID,
+			filter ReceptionFilter,
+			page, limit *int,
+		) (ReceptionPage, error)
+		FindDetails(context.Context, AuthenticatedUser, ReceptionID) (ReceptionDetails, error)
+		Reopen(
+			ctx context.Context,
+			authUser AuthenticatedUser,
+			receptionID ReceptionID,
+			reason string,
+		) (Reception, error)
+	}
+
+	ProductTypesInterface interface {
+		FindAll(ctx context.Context, authUser AuthenticatedUser, includeInactive bool) ([]ProductTypeEntry, error)
+		Create(context.Context, AuthenticatedUser, ProductTypeEntry) (ProductTypeEntry, error)
+		Update(context.Context, AuthenticatedUser, ProductTypeEntry) (ProductTypeEntry, error)
+	}
+
+	AnalyticsInterface interface {
+		Daily(ctx context.Context, authUser AuthenticatedUser, pvzID *PVZID, from, to time.Time) ([]DailyStats, error)
+		Cities(ctx context.Context, authUser AuthenticatedUser, from, to time.Time) (CitiesReport, error)
 	}
 )
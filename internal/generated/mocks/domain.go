@@ -40,6 +40,153 @@ func (_m *MockConnection) EXPECT() *MockConnection_Expecter {
 	return &MockConnection_Expecter{mock: &_m.Mock}
 }
 
+// EachContext provides a mock function for the type MockConnection
+func (_mock *MockConnection) EachContext(ctx context.Context, dest any, each func() error, query string, args ...any) error {
+	var tmpRet mock.Arguments
+	if len(args) > 0 {
+		tmpRet = _mock.Called(ctx, dest, each, query, args)
+	} else {
+		tmpRet = _mock.Called(ctx, dest, each, query)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for EachContext")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any, func() error, string, ...any) error); ok {
+		r0 = returnFunc(ctx, dest, each, query, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnection_EachContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EachContext'
+type MockConnection_EachContext_Call struct {
+	*mock.Call
+}
+
+// EachContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dest any
+//   - each func() error
+//   - query string
+//   - args ...any
+func (_e *MockConnection_Expecter) EachContext(ctx interface{}, dest interface{}, each interface{}, query interface{}, args ...interface{}) *MockConnection_EachContext_Call {
+	return &MockConnection_EachContext_Call{Call: _e.mock.On("EachContext",
+		append([]interface{}{ctx, dest, each, query}, args...)...)}
+}
+
+func (_c *MockConnection_EachContext_Call) Run(run func(ctx context.Context, dest any, each func() error, query string, args ...any)) *MockConnection_EachContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		var arg2 func() error
+		if args[2] != nil {
+			arg2 = args[2].(func() error)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 []any
+		var variadicArgs []any
+		if len(args) > 4 {
+			variadicArgs = args[4].([]any)
+		}
+		arg4 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnection_EachContext_Call) Return(err error) *MockConnection_EachContext_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnection_EachContext_Call) RunAndReturn(run func(ctx context.Context, dest any, each func() error, query string, args ...any) error) *MockConnection_EachContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecBatch provides a mock function for the type MockConnection
+func (_mock *MockConnection) ExecBatch(ctx context.Context, query string, args [][]any) error {
+	ret := _mock.Called(ctx, query, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecBatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, [][]any) error); ok {
+		r0 = returnFunc(ctx, query, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnection_ExecBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecBatch'
+type MockConnection_ExecBatch_Call struct {
+	*mock.Call
+}
+
+// ExecBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - args [][]any
+func (_e *MockConnection_Expecter) ExecBatch(ctx interface{}, query interface{}, args interface{}) *MockConnection_ExecBatch_Call {
+	return &MockConnection_ExecBatch_Call{Call: _e.mock.On("ExecBatch", ctx, query, args)}
+}
+
+func (_c *MockConnection_ExecBatch_Call) Run(run func(ctx context.Context, query string, args [][]any)) *MockConnection_ExecBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 [][]any
+		if args[2] != nil {
+			arg2 = args[2].([][]any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnection_ExecBatch_Call) Return(err error) *MockConnection_ExecBatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnection_ExecBatch_Call) RunAndReturn(run func(ctx context.Context, query string, args [][]any) error) *MockConnection_ExecBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecContext provides a mock function for the type MockConnection
 func (_mock *MockConnection) ExecContext(context1 context.Context, s string, vs ...any) (int64, error) {
 	var tmpRet mock.Arguments
@@ -405,6 +552,63 @@ func (_c *MockConnectionProvider_Execute_Call) RunAndReturn(run func(context1 co
 	return _c
 }
 
+// ExecuteReadOnly provides a mock function for the type MockConnectionProvider
+func (_mock *MockConnectionProvider) ExecuteReadOnly(context1 context.Context, fn func(context.Context, domain.Connection) error) error {
+	ret := _mock.Called(context1, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteReadOnly")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(context.Context, domain.Connection) error) error); ok {
+		r0 = returnFunc(context1, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockConnectionProvider_ExecuteReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteReadOnly'
+type MockConnectionProvider_ExecuteReadOnly_Call struct {
+	*mock.Call
+}
+
+// ExecuteReadOnly is a helper method to define mock.On call
+//   - context1 context.Context
+//   - fn func(context.Context, domain.Connection) error
+func (_e *MockConnectionProvider_Expecter) ExecuteReadOnly(context1 interface{}, fn interface{}) *MockConnectionProvider_ExecuteReadOnly_Call {
+	return &MockConnectionProvider_ExecuteReadOnly_Call{Call: _e.mock.On("ExecuteReadOnly", context1, fn)}
+}
+
+func (_c *MockConnectionProvider_ExecuteReadOnly_Call) Run(run func(context1 context.Context, fn func(context.Context, domain.Connection) error)) *MockConnectionProvider_ExecuteReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(context.Context, domain.Connection) error
+		if args[1] != nil {
+			arg1 = args[1].(func(context.Context, domain.Connection) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockConnectionProvider_ExecuteReadOnly_Call) Return(err error) *MockConnectionProvider_ExecuteReadOnly_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockConnectionProvider_ExecuteReadOnly_Call) RunAndReturn(run func(context1 context.Context, fn func(context.Context, domain.Connection) error) error) *MockConnectionProvider_ExecuteReadOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecuteTx provides a mock function for the type MockConnectionProvider
 func (_mock *MockConnectionProvider) ExecuteTx(context1 context.Context, fn func(context.Context, domain.Connection) error) error {
 	ret := _mock.Called(context1, fn)
@@ -783,6 +987,78 @@ func (_m *MockPVZsRepository) EXPECT() *MockPVZsRepository_Expecter {
 	return &MockPVZsRepository_Expecter{mock: &_m.Mock}
 }
 
+// Count provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) Count(ctx context.Context, connection domain.Connection, filter domain.PVZFilter) (int, error) {
+	ret := _mock.Called(ctx, connection, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZFilter) (int, error)); ok {
+		return returnFunc(ctx, connection, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZFilter) int); ok {
+		r0 = returnFunc(ctx, connection, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZFilter) error); ok {
+		r1 = returnFunc(ctx, connection, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZsRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockPVZsRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - filter domain.PVZFilter
+func (_e *MockPVZsRepository_Expecter) Count(ctx interface{}, connection interface{}, filter interface{}) *MockPVZsRepository_Count_Call {
+	return &MockPVZsRepository_Count_Call{Call: _e.mock.On("Count", ctx, connection, filter)}
+}
+
+func (_c *MockPVZsRepository_Count_Call) Run(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter)) *MockPVZsRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsRepository_Count_Call) Return(n int, err error) *MockPVZsRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockPVZsRepository_Count_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter) (int, error)) *MockPVZsRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockPVZsRepository
 func (_mock *MockPVZsRepository) Create(context1 context.Context, connection domain.Connection, pVZ domain.PVZ) error {
 	ret := _mock.Called(context1, connection, pVZ)
@@ -846,6 +1122,75 @@ func (_c *MockPVZsRepository_Create_Call) RunAndReturn(run func(context1 context
 	return _c
 }
 
+// Export provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) Export(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, each func(domain.ExportRow) error) error {
+	ret := _mock.Called(ctx, connection, filter, each)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZFilter, func(domain.ExportRow) error) error); ok {
+		r0 = returnFunc(ctx, connection, filter, each)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZsRepository_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockPVZsRepository_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - filter domain.PVZFilter
+//   - each func(domain.ExportRow) error
+func (_e *MockPVZsRepository_Expecter) Export(ctx interface{}, connection interface{}, filter interface{}, each interface{}) *MockPVZsRepository_Export_Call {
+	return &MockPVZsRepository_Export_Call{Call: _e.mock.On("Export", ctx, connection, filter, each)}
+}
+
+func (_c *MockPVZsRepository_Export_Call) Run(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, each func(domain.ExportRow) error)) *MockPVZsRepository_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		var arg3 func(domain.ExportRow) error
+		if args[3] != nil {
+			arg3 = args[3].(func(domain.ExportRow) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsRepository_Export_Call) Return(err error) *MockPVZsRepository_Export_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZsRepository_Export_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, each func(domain.ExportRow) error) error) *MockPVZsRepository_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindAll provides a mock function for the type MockPVZsRepository
 func (_mock *MockPVZsRepository) FindAll(context1 context.Context, connection domain.Connection) ([]domain.PVZ, error) {
 	ret := _mock.Called(context1, connection)
@@ -914,48 +1259,46 @@ func (_c *MockPVZsRepository_FindAll_Call) RunAndReturn(run func(context1 contex
 	return _c
 }
 
-// FindByIDs provides a mock function for the type MockPVZsRepository
-func (_mock *MockPVZsRepository) FindByIDs(context1 context.Context, connection domain.Connection, vs []domain.PVZID) ([]domain.PVZ, error) {
-	ret := _mock.Called(context1, connection, vs)
+// FindByID provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) FindByID(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.PVZ, error) {
+	ret := _mock.Called(context1, connection, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByIDs")
+		panic("no return value specified for FindByID")
 	}
 
-	var r0 []domain.PVZ
+	var r0 domain.PVZ
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.PVZID) ([]domain.PVZ, error)); ok {
-		return returnFunc(context1, connection, vs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) (domain.PVZ, error)); ok {
+		return returnFunc(context1, connection, v)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.PVZID) []domain.PVZ); ok {
-		r0 = returnFunc(context1, connection, vs)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) domain.PVZ); ok {
+		r0 = returnFunc(context1, connection, v)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZ)
-		}
+		r0 = ret.Get(0).(domain.PVZ)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.PVZID) error); ok {
-		r1 = returnFunc(context1, connection, vs)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, connection, v)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZsRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
-type MockPVZsRepository_FindByIDs_Call struct {
+// MockPVZsRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockPVZsRepository_FindByID_Call struct {
 	*mock.Call
 }
 
-// FindByIDs is a helper method to define mock.On call
+// FindByID is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - vs []domain.PVZID
-func (_e *MockPVZsRepository_Expecter) FindByIDs(context1 interface{}, connection interface{}, vs interface{}) *MockPVZsRepository_FindByIDs_Call {
-	return &MockPVZsRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", context1, connection, vs)}
+//   - v domain.PVZID
+func (_e *MockPVZsRepository_Expecter) FindByID(context1 interface{}, connection interface{}, v interface{}) *MockPVZsRepository_FindByID_Call {
+	return &MockPVZsRepository_FindByID_Call{Call: _e.mock.On("FindByID", context1, connection, v)}
 }
 
-func (_c *MockPVZsRepository_FindByIDs_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.PVZID)) *MockPVZsRepository_FindByIDs_Call {
+func (_c *MockPVZsRepository_FindByID_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.PVZID)) *MockPVZsRepository_FindByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -965,9 +1308,9 @@ func (_c *MockPVZsRepository_FindByIDs_Call) Run(run func(context1 context.Conte
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 []domain.PVZID
+		var arg2 domain.PVZID
 		if args[2] != nil {
-			arg2 = args[2].([]domain.PVZID)
+			arg2 = args[2].(domain.PVZID)
 		}
 		run(
 			arg0,
@@ -978,74 +1321,58 @@ func (_c *MockPVZsRepository_FindByIDs_Call) Run(run func(context1 context.Conte
 	return _c
 }
 
-func (_c *MockPVZsRepository_FindByIDs_Call) Return(pVZs []domain.PVZ, err error) *MockPVZsRepository_FindByIDs_Call {
-	_c.Call.Return(pVZs, err)
+func (_c *MockPVZsRepository_FindByID_Call) Return(pVZ domain.PVZ, err error) *MockPVZsRepository_FindByID_Call {
+	_c.Call.Return(pVZ, err)
 	return _c
 }
 
-func (_c *MockPVZsRepository_FindByIDs_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.PVZID) ([]domain.PVZ, error)) *MockPVZsRepository_FindByIDs_Call {
+func (_c *MockPVZsRepository_FindByID_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.PVZ, error)) *MockPVZsRepository_FindByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReceptionsRepository creates a new instance of MockReceptionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockReceptionsRepository(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockReceptionsRepository {
-	mock := &MockReceptionsRepository{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockReceptionsRepository is an autogenerated mock type for the ReceptionsRepository type
-type MockReceptionsRepository struct {
-	mock.Mock
-}
-
-type MockReceptionsRepository_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockReceptionsRepository) EXPECT() *MockReceptionsRepository_Expecter {
-	return &MockReceptionsRepository_Expecter{mock: &_m.Mock}
-}
-
-// Close provides a mock function for the type MockReceptionsRepository
-func (_mock *MockReceptionsRepository) Close(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error {
-	ret := _mock.Called(context1, connection, v)
+// FindByIDs provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) FindByIDs(context1 context.Context, connection domain.Connection, vs []domain.PVZID) ([]domain.PVZ, error) {
+	ret := _mock.Called(context1, connection, vs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for FindByIDs")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
-		r0 = returnFunc(context1, connection, v)
+	var r0 []domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.PVZID) ([]domain.PVZ, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.PVZID) []domain.PVZ); ok {
+		r0 = returnFunc(context1, connection, vs)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZ)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.PVZID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockReceptionsRepository_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockReceptionsRepository_Close_Call struct {
+// MockPVZsRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockPVZsRepository_FindByIDs_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
+// FindByIDs is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - v domain.ReceptionID
-func (_e *MockReceptionsRepository_Expecter) Close(context1 interface{}, connection interface{}, v interface{}) *MockReceptionsRepository_Close_Call {
-	return &MockReceptionsRepository_Close_Call{Call: _e.mock.On("Close", context1, connection, v)}
+//   - vs []domain.PVZID
+func (_e *MockPVZsRepository_Expecter) FindByIDs(context1 interface{}, connection interface{}, vs interface{}) *MockPVZsRepository_FindByIDs_Call {
+	return &MockPVZsRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", context1, connection, vs)}
 }
 
-func (_c *MockReceptionsRepository_Close_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockReceptionsRepository_Close_Call {
+func (_c *MockPVZsRepository_FindByIDs_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.PVZID)) *MockPVZsRepository_FindByIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1055,9 +1382,9 @@ func (_c *MockReceptionsRepository_Close_Call) Run(run func(context1 context.Con
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.ReceptionID
+		var arg2 []domain.PVZID
 		if args[2] != nil {
-			arg2 = args[2].(domain.ReceptionID)
+			arg2 = args[2].([]domain.PVZID)
 		}
 		run(
 			arg0,
@@ -1068,47 +1395,56 @@ func (_c *MockReceptionsRepository_Close_Call) Run(run func(context1 context.Con
 	return _c
 }
 
-func (_c *MockReceptionsRepository_Close_Call) Return(err error) *MockReceptionsRepository_Close_Call {
-	_c.Call.Return(err)
+func (_c *MockPVZsRepository_FindByIDs_Call) Return(pVZs []domain.PVZ, err error) *MockPVZsRepository_FindByIDs_Call {
+	_c.Call.Return(pVZs, err)
 	return _c
 }
 
-func (_c *MockReceptionsRepository_Close_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error) *MockReceptionsRepository_Close_Call {
+func (_c *MockPVZsRepository_FindByIDs_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.PVZID) ([]domain.PVZ, error)) *MockPVZsRepository_FindByIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockReceptionsRepository
-func (_mock *MockReceptionsRepository) Create(context1 context.Context, connection domain.Connection, reception domain.Reception) error {
-	ret := _mock.Called(context1, connection, reception)
+// FindDetails provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) FindDetails(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.PVZDetails, error) {
+	ret := _mock.Called(context1, connection, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindDetails")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Reception) error); ok {
-		r0 = returnFunc(context1, connection, reception)
+	var r0 domain.PVZDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) (domain.PVZDetails, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) domain.PVZDetails); ok {
+		r0 = returnFunc(context1, connection, v)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(domain.PVZDetails)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockReceptionsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockReceptionsRepository_Create_Call struct {
+// MockPVZsRepository_FindDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDetails'
+type MockPVZsRepository_FindDetails_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// FindDetails is a helper method to define mock.On call
 //   - context1 context.Context
 //   - connection domain.Connection
-//   - reception domain.Reception
-func (_e *MockReceptionsRepository_Expecter) Create(context1 interface{}, connection interface{}, reception interface{}) *MockReceptionsRepository_Create_Call {
-	return &MockReceptionsRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, reception)}
+//   - v domain.PVZID
+func (_e *MockPVZsRepository_Expecter) FindDetails(context1 interface{}, connection interface{}, v interface{}) *MockPVZsRepository_FindDetails_Call {
+	return &MockPVZsRepository_FindDetails_Call{Call: _e.mock.On("FindDetails", context1, connection, v)}
 }
 
-func (_c *MockReceptionsRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, reception domain.Reception)) *MockReceptionsRepository_Create_Call {
+func (_c *MockPVZsRepository_FindDetails_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.PVZID)) *MockPVZsRepository_FindDetails_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1118,9 +1454,9 @@ func (_c *MockReceptionsRepository_Create_Call) Run(run func(context1 context.Co
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.Reception
+		var arg2 domain.PVZID
 		if args[2] != nil {
-			arg2 = args[2].(domain.Reception)
+			arg2 = args[2].(domain.PVZID)
 		}
 		run(
 			arg0,
@@ -1131,56 +1467,61 @@ func (_c *MockReceptionsRepository_Create_Call) Run(run func(context1 context.Co
 	return _c
 }
 
-func (_c *MockReceptionsRepository_Create_Call) Return(err error) *MockReceptionsRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockPVZsRepository_FindDetails_Call) Return(pVZDetails domain.PVZDetails, err error) *MockPVZsRepository_FindDetails_Call {
+	_c.Call.Return(pVZDetails, err)
 	return _c
 }
 
-func (_c *MockReceptionsRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, reception domain.Reception) error) *MockReceptionsRepository_Create_Call {
+func (_c *MockPVZsRepository_FindDetails_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.PVZDetails, error)) *MockPVZsRepository_FindDetails_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindActive provides a mock function for the type MockReceptionsRepository
-func (_mock *MockReceptionsRepository) FindActive(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.Reception, error) {
-	ret := _mock.Called(context1, connection, v)
+// SearchReceptionsProducts provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) SearchReceptionsProducts(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, page int, limit int, after *domain.Cursor) ([]domain.PVZReceptionsProducts, error) {
+	ret := _mock.Called(ctx, connection, filter, page, limit, after)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindActive")
+		panic("no return value specified for SearchReceptionsProducts")
 	}
 
-	var r0 domain.Reception
+	var r0 []domain.PVZReceptionsProducts
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) (domain.Reception, error)); ok {
-		return returnFunc(context1, connection, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZFilter, int, int, *domain.Cursor) ([]domain.PVZReceptionsProducts, error)); ok {
+		return returnFunc(ctx, connection, filter, page, limit, after)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) domain.Reception); ok {
-		r0 = returnFunc(context1, connection, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZFilter, int, int, *domain.Cursor) []domain.PVZReceptionsProducts); ok {
+		r0 = returnFunc(ctx, connection, filter, page, limit, after)
 	} else {
-		r0 = ret.Get(0).(domain.Reception)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZReceptionsProducts)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID) error); ok {
-		r1 = returnFunc(context1, connection, v)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZFilter, int, int, *domain.Cursor) error); ok {
+		r1 = returnFunc(ctx, connection, filter, page, limit, after)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionsRepository_FindActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActive'
-type MockReceptionsRepository_FindActive_Call struct {
+// MockPVZsRepository_SearchReceptionsProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchReceptionsProducts'
+type MockPVZsRepository_SearchReceptionsProducts_Call struct {
 	*mock.Call
 }
 
-// FindActive is a helper method to define mock.On call
-//   - context1 context.Context
+// SearchReceptionsProducts is a helper method to define mock.On call
+//   - ctx context.Context
 //   - connection domain.Connection
-//   - v domain.PVZID
-func (_e *MockReceptionsRepository_Expecter) FindActive(context1 interface{}, connection interface{}, v interface{}) *MockReceptionsRepository_FindActive_Call {
-	return &MockReceptionsRepository_FindActive_Call{Call: _e.mock.On("FindActive", context1, connection, v)}
+//   - filter domain.PVZFilter
+//   - page int
+//   - limit int
+//   - after *domain.Cursor
+func (_e *MockPVZsRepository_Expecter) SearchReceptionsProducts(ctx interface{}, connection interface{}, filter interface{}, page interface{}, limit interface{}, after interface{}) *MockPVZsRepository_SearchReceptionsProducts_Call {
+	return &MockPVZsRepository_SearchReceptionsProducts_Call{Call: _e.mock.On("SearchReceptionsProducts", ctx, connection, filter, page, limit, after)}
 }
 
-func (_c *MockReceptionsRepository_FindActive_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.PVZID)) *MockReceptionsRepository_FindActive_Call {
+func (_c *MockPVZsRepository_SearchReceptionsProducts_Call) Run(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, page int, limit int, after *domain.Cursor)) *MockPVZsRepository_SearchReceptionsProducts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1190,71 +1531,76 @@ func (_c *MockReceptionsRepository_FindActive_Call) Run(run func(context1 contex
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.PVZID
+		var arg2 domain.PVZFilter
 		if args[2] != nil {
-			arg2 = args[2].(domain.PVZID)
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		var arg5 *domain.Cursor
+		if args[5] != nil {
+			arg5 = args[5].(*domain.Cursor)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4,
+			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *MockReceptionsRepository_FindActive_Call) Return(reception domain.Reception, err error) *MockReceptionsRepository_FindActive_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockPVZsRepository_SearchReceptionsProducts_Call) Return(pVZReceptionsProductss []domain.PVZReceptionsProducts, err error) *MockPVZsRepository_SearchReceptionsProducts_Call {
+	_c.Call.Return(pVZReceptionsProductss, err)
 	return _c
 }
 
-func (_c *MockReceptionsRepository_FindActive_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.Reception, error)) *MockReceptionsRepository_FindActive_Call {
+func (_c *MockPVZsRepository_SearchReceptionsProducts_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, page int, limit int, after *domain.Cursor) ([]domain.PVZReceptionsProducts, error)) *MockPVZsRepository_SearchReceptionsProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindByIDs provides a mock function for the type MockReceptionsRepository
-func (_mock *MockReceptionsRepository) FindByIDs(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID) ([]domain.Reception, error) {
-	ret := _mock.Called(context1, connection, vs)
+// Stream provides a mock function for the type MockPVZsRepository
+func (_mock *MockPVZsRepository) Stream(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, each func(domain.PVZReceptionsProducts) error) error {
+	ret := _mock.Called(ctx, connection, filter, each)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByIDs")
+		panic("no return value specified for Stream")
 	}
 
-	var r0 []domain.Reception
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID) ([]domain.Reception, error)); ok {
-		return returnFunc(context1, connection, vs)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID) []domain.Reception); ok {
-		r0 = returnFunc(context1, connection, vs)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Reception)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.ReceptionID) error); ok {
-		r1 = returnFunc(context1, connection, vs)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZFilter, func(domain.PVZReceptionsProducts) error) error); ok {
+		r0 = returnFunc(ctx, connection, filter, each)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockReceptionsRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
-type MockReceptionsRepository_FindByIDs_Call struct {
+// MockPVZsRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockPVZsRepository_Stream_Call struct {
 	*mock.Call
 }
 
-// FindByIDs is a helper method to define mock.On call
-//   - context1 context.Context
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
 //   - connection domain.Connection
-//   - vs []domain.ReceptionID
-func (_e *MockReceptionsRepository_Expecter) FindByIDs(context1 interface{}, connection interface{}, vs interface{}) *MockReceptionsRepository_FindByIDs_Call {
-	return &MockReceptionsRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", context1, connection, vs)}
+//   - filter domain.PVZFilter
+//   - each func(domain.PVZReceptionsProducts) error
+func (_e *MockPVZsRepository_Expecter) Stream(ctx interface{}, connection interface{}, filter interface{}, each interface{}) *MockPVZsRepository_Stream_Call {
+	return &MockPVZsRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, connection, filter, each)}
 }
 
-func (_c *MockReceptionsRepository_FindByIDs_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID)) *MockReceptionsRepository_FindByIDs_Call {
+func (_c *MockPVZsRepository_Stream_Call) Run(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, each func(domain.PVZReceptionsProducts) error)) *MockPVZsRepository_Stream_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1264,36 +1610,41 @@ func (_c *MockReceptionsRepository_FindByIDs_Call) Run(run func(context1 context
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 []domain.ReceptionID
+		var arg2 domain.PVZFilter
 		if args[2] != nil {
-			arg2 = args[2].([]domain.ReceptionID)
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		var arg3 func(domain.PVZReceptionsProducts) error
+		if args[3] != nil {
+			arg3 = args[3].(func(domain.PVZReceptionsProducts) error)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockReceptionsRepository_FindByIDs_Call) Return(receptions []domain.Reception, err error) *MockReceptionsRepository_FindByIDs_Call {
-	_c.Call.Return(receptions, err)
+func (_c *MockPVZsRepository_Stream_Call) Return(err error) *MockPVZsRepository_Stream_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockReceptionsRepository_FindByIDs_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID) ([]domain.Reception, error)) *MockReceptionsRepository_FindByIDs_Call {
+func (_c *MockPVZsRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, filter domain.PVZFilter, each func(domain.PVZReceptionsProducts) error) error) *MockPVZsRepository_Stream_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockProductsRepository creates a new instance of MockProductsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockReceptionsRepository creates a new instance of MockReceptionsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProductsRepository(t interface {
+func NewMockReceptionsRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProductsRepository {
-	mock := &MockProductsRepository{}
+}) *MockReceptionsRepository {
+	mock := &MockReceptionsRepository{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1301,50 +1652,51 @@ func NewMockProductsRepository(t interface {
 	return mock
 }
 
-// MockProductsRepository is an autogenerated mock type for the ProductsRepository type
-type MockProductsRepository struct {
+// MockReceptionsRepository is an autogenerated mock type for the ReceptionsRepository type
+type MockReceptionsRepository struct {
 	mock.Mock
 }
 
-type MockProductsRepository_Expecter struct {
+type MockReceptionsRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProductsRepository) EXPECT() *MockProductsRepository_Expecter {
-	return &MockProductsRepository_Expecter{mock: &_m.Mock}
+func (_m *MockReceptionsRepository) EXPECT() *MockReceptionsRepository_Expecter {
+	return &MockReceptionsRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockProductsRepository
-func (_mock *MockProductsRepository) Create(context1 context.Context, connection domain.Connection, product domain.Product) error {
-	ret := _mock.Called(context1, connection, product)
+// Close provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) Close(ctx context.Context, connection domain.Connection, receptionID domain.ReceptionID, closedBy domain.UserID) error {
+	ret := _mock.Called(ctx, connection, receptionID, closedBy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Close")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Product) error); ok {
-		r0 = returnFunc(context1, connection, product)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID, domain.UserID) error); ok {
+		r0 = returnFunc(ctx, connection, receptionID, closedBy)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockProductsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockProductsRepository_Create_Call struct {
+// MockReceptionsRepository_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockReceptionsRepository_Close_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - context1 context.Context
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
 //   - connection domain.Connection
-//   - product domain.Product
-func (_e *MockProductsRepository_Expecter) Create(context1 interface{}, connection interface{}, product interface{}) *MockProductsRepository_Create_Call {
-	return &MockProductsRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, product)}
+//   - receptionID domain.ReceptionID
+//   - closedBy domain.UserID
+func (_e *MockReceptionsRepository_Expecter) Close(ctx interface{}, connection interface{}, receptionID interface{}, closedBy interface{}) *MockReceptionsRepository_Close_Call {
+	return &MockReceptionsRepository_Close_Call{Call: _e.mock.On("Close", ctx, connection, receptionID, closedBy)}
 }
 
-func (_c *MockProductsRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, product domain.Product)) *MockProductsRepository_Create_Call {
+func (_c *MockReceptionsRepository_Close_Call) Run(run func(ctx context.Context, connection domain.Connection, receptionID domain.ReceptionID, closedBy domain.UserID)) *MockReceptionsRepository_Close_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1354,60 +1706,76 @@ func (_c *MockProductsRepository_Create_Call) Run(run func(context1 context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.Product
+		var arg2 domain.ReceptionID
 		if args[2] != nil {
-			arg2 = args[2].(domain.Product)
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		var arg3 domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserID)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockProductsRepository_Create_Call) Return(err error) *MockProductsRepository_Create_Call {
+func (_c *MockReceptionsRepository_Close_Call) Return(err error) *MockReceptionsRepository_Close_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProductsRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, product domain.Product) error) *MockProductsRepository_Create_Call {
+func (_c *MockReceptionsRepository_Close_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, receptionID domain.ReceptionID, closedBy domain.UserID) error) *MockReceptionsRepository_Close_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLast provides a mock function for the type MockProductsRepository
-func (_mock *MockProductsRepository) DeleteLast(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error {
-	ret := _mock.Called(context1, connection, v)
+// CloseStale provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) CloseStale(ctx context.Context, connection domain.Connection, idleSince time.Time) ([]domain.Reception, error) {
+	ret := _mock.Called(ctx, connection, idleSince)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLast")
+		panic("no return value specified for CloseStale")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
-		r0 = returnFunc(context1, connection, v)
+	var r0 []domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time) ([]domain.Reception, error)); ok {
+		return returnFunc(ctx, connection, idleSince)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time) []domain.Reception); ok {
+		r0 = returnFunc(ctx, connection, idleSince)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Reception)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time) error); ok {
+		r1 = returnFunc(ctx, connection, idleSince)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockProductsRepository_DeleteLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLast'
-type MockProductsRepository_DeleteLast_Call struct {
+// MockReceptionsRepository_CloseStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseStale'
+type MockReceptionsRepository_CloseStale_Call struct {
 	*mock.Call
 }
 
-// DeleteLast is a helper method to define mock.On call
-//   - context1 context.Context
+// CloseStale is a helper method to define mock.On call
+//   - ctx context.Context
 //   - connection domain.Connection
-//   - v domain.ReceptionID
-func (_e *MockProductsRepository_Expecter) DeleteLast(context1 interface{}, connection interface{}, v interface{}) *MockProductsRepository_DeleteLast_Call {
-	return &MockProductsRepository_DeleteLast_Call{Call: _e.mock.On("DeleteLast", context1, connection, v)}
+//   - idleSince time.Time
+func (_e *MockReceptionsRepository_Expecter) CloseStale(ctx interface{}, connection interface{}, idleSince interface{}) *MockReceptionsRepository_CloseStale_Call {
+	return &MockReceptionsRepository_CloseStale_Call{Call: _e.mock.On("CloseStale", ctx, connection, idleSince)}
 }
 
-func (_c *MockProductsRepository_DeleteLast_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockProductsRepository_DeleteLast_Call {
+func (_c *MockReceptionsRepository_CloseStale_Call) Run(run func(ctx context.Context, connection domain.Connection, idleSince time.Time)) *MockReceptionsRepository_CloseStale_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1417,9 +1785,9 @@ func (_c *MockProductsRepository_DeleteLast_Call) Run(run func(context1 context.
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 domain.ReceptionID
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(domain.ReceptionID)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
@@ -1430,61 +1798,57 @@ func (_c *MockProductsRepository_DeleteLast_Call) Run(run func(context1 context.
 	return _c
 }
 
-func (_c *MockProductsRepository_DeleteLast_Call) Return(err error) *MockProductsRepository_DeleteLast_Call {
-	_c.Call.Return(err)
+func (_c *MockReceptionsRepository_CloseStale_Call) Return(receptions []domain.Reception, err error) *MockReceptionsRepository_CloseStale_Call {
+	_c.Call.Return(receptions, err)
 	return _c
 }
 
-func (_c *MockProductsRepository_DeleteLast_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error) *MockProductsRepository_DeleteLast_Call {
+func (_c *MockReceptionsRepository_CloseStale_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, idleSince time.Time) ([]domain.Reception, error)) *MockReceptionsRepository_CloseStale_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Search provides a mock function for the type MockProductsRepository
-func (_mock *MockProductsRepository) Search(ctx context.Context, connection domain.Connection, from *time.Time, to *time.Time, page *int, limit *int) ([]domain.Product, error) {
-	ret := _mock.Called(ctx, connection, from, to, page, limit)
+// CountByPVZ provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) CountByPVZ(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, filter domain.ReceptionFilter) (int, error) {
+	ret := _mock.Called(ctx, connection, pvzID, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Search")
+		panic("no return value specified for CountByPVZ")
 	}
 
-	var r0 []domain.Product
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *time.Time, *time.Time, *int, *int) ([]domain.Product, error)); ok {
-		return returnFunc(ctx, connection, from, to, page, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, domain.ReceptionFilter) (int, error)); ok {
+		return returnFunc(ctx, connection, pvzID, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *time.Time, *time.Time, *int, *int) []domain.Product); ok {
-		r0 = returnFunc(ctx, connection, from, to, page, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, domain.ReceptionFilter) int); ok {
+		r0 = returnFunc(ctx, connection, pvzID, filter)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Product)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, *time.Time, *time.Time, *int, *int) error); ok {
-		r1 = returnFunc(ctx, connection, from, to, page, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID, domain.ReceptionFilter) error); ok {
+		r1 = returnFunc(ctx, connection, pvzID, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProductsRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
-type MockProductsRepository_Search_Call struct {
+// MockReceptionsRepository_CountByPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByPVZ'
+type MockReceptionsRepository_CountByPVZ_Call struct {
 	*mock.Call
 }
 
-// Search is a helper method to define mock.On call
+// CountByPVZ is a helper method to define mock.On call
 //   - ctx context.Context
 //   - connection domain.Connection
-//   - from *time.Time
-//   - to *time.Time
-//   - page *int
-//   - limit *int
-func (_e *MockProductsRepository_Expecter) Search(ctx interface{}, connection interface{}, from interface{}, to interface{}, page interface{}, limit interface{}) *MockProductsRepository_Search_Call {
-	return &MockProductsRepository_Search_Call{Call: _e.mock.On("Search", ctx, connection, from, to, page, limit)}
+//   - pvzID domain.PVZID
+//   - filter domain.ReceptionFilter
+func (_e *MockReceptionsRepository_Expecter) CountByPVZ(ctx interface{}, connection interface{}, pvzID interface{}, filter interface{}) *MockReceptionsRepository_CountByPVZ_Call {
+	return &MockReceptionsRepository_CountByPVZ_Call{Call: _e.mock.On("CountByPVZ", ctx, connection, pvzID, filter)}
 }
 
-func (_c *MockProductsRepository_Search_Call) Run(run func(ctx context.Context, connection domain.Connection, from *time.Time, to *time.Time, page *int, limit *int)) *MockProductsRepository_Search_Call {
+func (_c *MockReceptionsRepository_CountByPVZ_Call) Run(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, filter domain.ReceptionFilter)) *MockReceptionsRepository_CountByPVZ_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1494,477 +1858,4713 @@ func (_c *MockProductsRepository_Search_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(domain.Connection)
 		}
-		var arg2 *time.Time
+		var arg2 domain.PVZID
 		if args[2] != nil {
-			arg2 = args[2].(*time.Time)
+			arg2 = args[2].(domain.PVZID)
 		}
-		var arg3 *time.Time
+		var arg3 domain.ReceptionFilter
 		if args[3] != nil {
-			arg3 = args[3].(*time.Time)
-		}
-		var arg4 *int
-		if args[4] != nil {
-			arg4 = args[4].(*int)
-		}
-		var arg5 *int
-		if args[5] != nil {
-			arg5 = args[5].(*int)
+			arg3 = args[3].(domain.ReceptionFilter)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
-			arg4,
-			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *MockProductsRepository_Search_Call) Return(products []domain.Product, err error) *MockProductsRepository_Search_Call {
-	_c.Call.Return(products, err)
+func (_c *MockReceptionsRepository_CountByPVZ_Call) Return(n int, err error) *MockReceptionsRepository_CountByPVZ_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockProductsRepository_Search_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, from *time.Time, to *time.Time, page *int, limit *int) ([]domain.Product, error)) *MockProductsRepository_Search_Call {
+func (_c *MockReceptionsRepository_CountByPVZ_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, filter domain.ReceptionFilter) (int, error)) *MockReceptionsRepository_CountByPVZ_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockMetrics creates a new instance of MockMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockMetrics(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockMetrics {
-	mock := &MockMetrics{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockMetrics is an autogenerated mock type for the Metrics type
-type MockMetrics struct {
-	mock.Mock
-}
-
-type MockMetrics_Expecter struct {
-	mock *mock.Mock
-}
+// Create provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) Create(context1 context.Context, connection domain.Connection, reception domain.Reception) error {
+	ret := _mock.Called(context1, connection, reception)
 
-func (_m *MockMetrics) EXPECT() *MockMetrics_Expecter {
-	return &MockMetrics_Expecter{mock: &_m.Mock}
-}
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
 
-// IncPVZs provides a mock function for the type MockMetrics
-func (_mock *MockMetrics) IncPVZs() {
-	_mock.Called()
-	return
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Reception) error); ok {
+		r0 = returnFunc(context1, connection, reception)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockMetrics_IncPVZs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncPVZs'
-type MockMetrics_IncPVZs_Call struct {
+// MockReceptionsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReceptionsRepository_Create_Call struct {
 	*mock.Call
 }
 
-// IncPVZs is a helper method to define mock.On call
-func (_e *MockMetrics_Expecter) IncPVZs() *MockMetrics_IncPVZs_Call {
-	return &MockMetrics_IncPVZs_Call{Call: _e.mock.On("IncPVZs")}
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - reception domain.Reception
+func (_e *MockReceptionsRepository_Expecter) Create(context1 interface{}, connection interface{}, reception interface{}) *MockReceptionsRepository_Create_Call {
+	return &MockReceptionsRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, reception)}
 }
 
-func (_c *MockMetrics_IncPVZs_Call) Run(run func()) *MockMetrics_IncPVZs_Call {
+func (_c *MockReceptionsRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, reception domain.Reception)) *MockReceptionsRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Reception
+		if args[2] != nil {
+			arg2 = args[2].(domain.Reception)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
 	})
 	return _c
 }
 
-func (_c *MockMetrics_IncPVZs_Call) Return() *MockMetrics_IncPVZs_Call {
-	_c.Call.Return()
+func (_c *MockReceptionsRepository_Create_Call) Return(err error) *MockReceptionsRepository_Create_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockMetrics_IncPVZs_Call) RunAndReturn(run func()) *MockMetrics_IncPVZs_Call {
-	_c.Run(run)
+func (_c *MockReceptionsRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, reception domain.Reception) error) *MockReceptionsRepository_Create_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// IncProducts provides a mock function for the type MockMetrics
-func (_mock *MockMetrics) IncProducts() {
-	_mock.Called()
-	return
+// FindActive provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) FindActive(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.Reception, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActive")
+	}
+
+	var r0 domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) (domain.Reception, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) domain.Reception); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.Reception)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockMetrics_IncProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncProducts'
-type MockMetrics_IncProducts_Call struct {
+// MockReceptionsRepository_FindActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActive'
+type MockReceptionsRepository_FindActive_Call struct {
 	*mock.Call
 }
 
-// IncProducts is a helper method to define mock.On call
-func (_e *MockMetrics_Expecter) IncProducts() *MockMetrics_IncProducts_Call {
-	return &MockMetrics_IncProducts_Call{Call: _e.mock.On("IncProducts")}
+// FindActive is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.PVZID
+func (_e *MockReceptionsRepository_Expecter) FindActive(context1 interface{}, connection interface{}, v interface{}) *MockReceptionsRepository_FindActive_Call {
+	return &MockReceptionsRepository_FindActive_Call{Call: _e.mock.On("FindActive", context1, connection, v)}
 }
 
-func (_c *MockMetrics_IncProducts_Call) Run(run func()) *MockMetrics_IncProducts_Call {
+func (_c *MockReceptionsRepository_FindActive_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.PVZID)) *MockReceptionsRepository_FindActive_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
 	})
 	return _c
 }
 
-func (_c *MockMetrics_IncProducts_Call) Return() *MockMetrics_IncProducts_Call {
-	_c.Call.Return()
+func (_c *MockReceptionsRepository_FindActive_Call) Return(reception domain.Reception, err error) *MockReceptionsRepository_FindActive_Call {
+	_c.Call.Return(reception, err)
 	return _c
 }
 
-func (_c *MockMetrics_IncProducts_Call) RunAndReturn(run func()) *MockMetrics_IncProducts_Call {
-	_c.Run(run)
+func (_c *MockReceptionsRepository_FindActive_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.Reception, error)) *MockReceptionsRepository_FindActive_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// IncReceptions provides a mock function for the type MockMetrics
-func (_mock *MockMetrics) IncReceptions() {
-	_mock.Called()
-	return
+// FindByIDs provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) FindByIDs(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID) ([]domain.Reception, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID) ([]domain.Reception, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID) []domain.Reception); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Reception)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.ReceptionID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockMetrics_IncReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncReceptions'
-type MockMetrics_IncReceptions_Call struct {
+// MockReceptionsRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockReceptionsRepository_FindByIDs_Call struct {
 	*mock.Call
 }
 
-// IncReceptions is a helper method to define mock.On call
-func (_e *MockMetrics_Expecter) IncReceptions() *MockMetrics_IncReceptions_Call {
-	return &MockMetrics_IncReceptions_Call{Call: _e.mock.On("IncReceptions")}
+// FindByIDs is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.ReceptionID
+func (_e *MockReceptionsRepository_Expecter) FindByIDs(context1 interface{}, connection interface{}, vs interface{}) *MockReceptionsRepository_FindByIDs_Call {
+	return &MockReceptionsRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", context1, connection, vs)}
 }
 
-func (_c *MockMetrics_IncReceptions_Call) Run(run func()) *MockMetrics_IncReceptions_Call {
+func (_c *MockReceptionsRepository_FindByIDs_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID)) *MockReceptionsRepository_FindByIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
 	return _c
 }
 
-func (_c *MockMetrics_IncReceptions_Call) Return() *MockMetrics_IncReceptions_Call {
-	_c.Call.Return()
+func (_c *MockReceptionsRepository_FindByIDs_Call) Return(receptions []domain.Reception, err error) *MockReceptionsRepository_FindByIDs_Call {
+	_c.Call.Return(receptions, err)
 	return _c
 }
 
-func (_c *MockMetrics_IncReceptions_Call) RunAndReturn(run func()) *MockMetrics_IncReceptions_Call {
-	_c.Run(run)
+func (_c *MockReceptionsRepository_FindByIDs_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID) ([]domain.Reception, error)) *MockReceptionsRepository_FindByIDs_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// IncUsers provides a mock function for the type MockMetrics
-func (_mock *MockMetrics) IncUsers() {
-	_mock.Called()
-	return
+// FindByPVZ provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) FindByPVZ(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, filter domain.ReceptionFilter, page int, limit int) ([]domain.ReceptionSummary, error) {
+	ret := _mock.Called(ctx, connection, pvzID, filter, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPVZ")
+	}
+
+	var r0 []domain.ReceptionSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, domain.ReceptionFilter, int, int) ([]domain.ReceptionSummary, error)); ok {
+		return returnFunc(ctx, connection, pvzID, filter, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, domain.ReceptionFilter, int, int) []domain.ReceptionSummary); ok {
+		r0 = returnFunc(ctx, connection, pvzID, filter, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReceptionSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID, domain.ReceptionFilter, int, int) error); ok {
+		r1 = returnFunc(ctx, connection, pvzID, filter, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockMetrics_IncUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncUsers'
-type MockMetrics_IncUsers_Call struct {
+// MockReceptionsRepository_FindByPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPVZ'
+type MockReceptionsRepository_FindByPVZ_Call struct {
 	*mock.Call
 }
 
-// IncUsers is a helper method to define mock.On call
-func (_e *MockMetrics_Expecter) IncUsers() *MockMetrics_IncUsers_Call {
-	return &MockMetrics_IncUsers_Call{Call: _e.mock.On("IncUsers")}
+// FindByPVZ is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - pvzID domain.PVZID
+//   - filter domain.ReceptionFilter
+//   - page int
+//   - limit int
+func (_e *MockReceptionsRepository_Expecter) FindByPVZ(ctx interface{}, connection interface{}, pvzID interface{}, filter interface{}, page interface{}, limit interface{}) *MockReceptionsRepository_FindByPVZ_Call {
+	return &MockReceptionsRepository_FindByPVZ_Call{Call: _e.mock.On("FindByPVZ", ctx, connection, pvzID, filter, page, limit)}
 }
 
-func (_c *MockMetrics_IncUsers_Call) Run(run func()) *MockMetrics_IncUsers_Call {
+func (_c *MockReceptionsRepository_FindByPVZ_Call) Run(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, filter domain.ReceptionFilter, page int, limit int)) *MockReceptionsRepository_FindByPVZ_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 domain.ReceptionFilter
+		if args[3] != nil {
+			arg3 = args[3].(domain.ReceptionFilter)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		var arg5 int
+		if args[5] != nil {
+			arg5 = args[5].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
 	})
 	return _c
 }
 
-func (_c *MockMetrics_IncUsers_Call) Return() *MockMetrics_IncUsers_Call {
-	_c.Call.Return()
+func (_c *MockReceptionsRepository_FindByPVZ_Call) Return(receptionSummarys []domain.ReceptionSummary, err error) *MockReceptionsRepository_FindByPVZ_Call {
+	_c.Call.Return(receptionSummarys, err)
 	return _c
 }
 
-func (_c *MockMetrics_IncUsers_Call) RunAndReturn(run func()) *MockMetrics_IncUsers_Call {
-	_c.Run(run)
+func (_c *MockReceptionsRepository_FindByPVZ_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, filter domain.ReceptionFilter, page int, limit int) ([]domain.ReceptionSummary, error)) *MockReceptionsRepository_FindByPVZ_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockAuthenticatedUser creates a new instance of MockAuthenticatedUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockAuthenticatedUser(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockAuthenticatedUser {
-	mock := &MockAuthenticatedUser{}
-	mock.Mock.Test(t)
+// FindDetails provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) FindDetails(context1 context.Context, connection domain.Connection, v domain.ReceptionID) (domain.ReceptionDetails, error) {
+	ret := _mock.Called(context1, connection, v)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for FindDetails")
+	}
 
-	return mock
+	var r0 domain.ReceptionDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) (domain.ReceptionDetails, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) domain.ReceptionDetails); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.ReceptionDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockAuthenticatedUser is an autogenerated mock type for the AuthenticatedUser type
-type MockAuthenticatedUser struct {
-	mock.Mock
+// MockReceptionsRepository_FindDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDetails'
+type MockReceptionsRepository_FindDetails_Call struct {
+	*mock.Call
 }
 
-type MockAuthenticatedUser_Expecter struct {
-	mock *mock.Mock
+// FindDetails is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+func (_e *MockReceptionsRepository_Expecter) FindDetails(context1 interface{}, connection interface{}, v interface{}) *MockReceptionsRepository_FindDetails_Call {
+	return &MockReceptionsRepository_FindDetails_Call{Call: _e.mock.On("FindDetails", context1, connection, v)}
 }
 
-func (_m *MockAuthenticatedUser) EXPECT() *MockAuthenticatedUser_Expecter {
-	return &MockAuthenticatedUser_Expecter{mock: &_m.Mock}
+func (_c *MockReceptionsRepository_FindDetails_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockReceptionsRepository_FindDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
 }
 
-// GetUserID provides a mock function for the type MockAuthenticatedUser
-func (_mock *MockAuthenticatedUser) GetUserID() domain.UserID {
-	ret := _mock.Called()
+func (_c *MockReceptionsRepository_FindDetails_Call) Return(receptionDetails domain.ReceptionDetails, err error) *MockReceptionsRepository_FindDetails_Call {
+	_c.Call.Return(receptionDetails, err)
+	return _c
+}
+
+func (_c *MockReceptionsRepository_FindDetails_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) (domain.ReceptionDetails, error)) *MockReceptionsRepository_FindDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindManifest provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) FindManifest(context1 context.Context, connection domain.Connection, v domain.ReceptionID) (domain.ReceptionManifest, error) {
+	ret := _mock.Called(context1, connection, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserID")
+		panic("no return value specified for FindManifest")
 	}
 
-	var r0 domain.UserID
-	if returnFunc, ok := ret.Get(0).(func() domain.UserID); ok {
-		r0 = returnFunc()
+	var r0 domain.ReceptionManifest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) (domain.ReceptionManifest, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) domain.ReceptionManifest); ok {
+		r0 = returnFunc(context1, connection, v)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.UserID)
+			r0 = ret.Get(0).(domain.ReceptionManifest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsRepository_FindManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindManifest'
+type MockReceptionsRepository_FindManifest_Call struct {
+	*mock.Call
+}
+
+// FindManifest is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+func (_e *MockReceptionsRepository_Expecter) FindManifest(context1 interface{}, connection interface{}, v interface{}) *MockReceptionsRepository_FindManifest_Call {
+	return &MockReceptionsRepository_FindManifest_Call{Call: _e.mock.On("FindManifest", context1, connection, v)}
+}
+
+func (_c *MockReceptionsRepository_FindManifest_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockReceptionsRepository_FindManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
 		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsRepository_FindManifest_Call) Return(receptionManifest domain.ReceptionManifest, err error) *MockReceptionsRepository_FindManifest_Call {
+	_c.Call.Return(receptionManifest, err)
+	return _c
+}
+
+func (_c *MockReceptionsRepository_FindManifest_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) (domain.ReceptionManifest, error)) *MockReceptionsRepository_FindManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reopen provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) Reopen(context1 context.Context, connection domain.Connection, receptionReopening domain.ReceptionReopening) error {
+	ret := _mock.Called(context1, connection, receptionReopening)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reopen")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionReopening) error); ok {
+		r0 = returnFunc(context1, connection, receptionReopening)
+	} else {
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockAuthenticatedUser_GetUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserID'
-type MockAuthenticatedUser_GetUserID_Call struct {
+// MockReceptionsRepository_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type MockReceptionsRepository_Reopen_Call struct {
 	*mock.Call
 }
 
-// GetUserID is a helper method to define mock.On call
-func (_e *MockAuthenticatedUser_Expecter) GetUserID() *MockAuthenticatedUser_GetUserID_Call {
-	return &MockAuthenticatedUser_GetUserID_Call{Call: _e.mock.On("GetUserID")}
+// Reopen is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - receptionReopening domain.ReceptionReopening
+func (_e *MockReceptionsRepository_Expecter) Reopen(context1 interface{}, connection interface{}, receptionReopening interface{}) *MockReceptionsRepository_Reopen_Call {
+	return &MockReceptionsRepository_Reopen_Call{Call: _e.mock.On("Reopen", context1, connection, receptionReopening)}
 }
 
-func (_c *MockAuthenticatedUser_GetUserID_Call) Run(run func()) *MockAuthenticatedUser_GetUserID_Call {
+func (_c *MockReceptionsRepository_Reopen_Call) Run(run func(context1 context.Context, connection domain.Connection, receptionReopening domain.ReceptionReopening)) *MockReceptionsRepository_Reopen_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionReopening
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionReopening)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsRepository_Reopen_Call) Return(err error) *MockReceptionsRepository_Reopen_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionsRepository_Reopen_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, receptionReopening domain.ReceptionReopening) error) *MockReceptionsRepository_Reopen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDiscrepancy provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) SaveDiscrepancy(context1 context.Context, connection domain.Connection, v domain.ReceptionID, discrepancyReport domain.DiscrepancyReport) error {
+	ret := _mock.Called(context1, connection, v, discrepancyReport)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDiscrepancy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID, domain.DiscrepancyReport) error); ok {
+		r0 = returnFunc(context1, connection, v, discrepancyReport)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionsRepository_SaveDiscrepancy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDiscrepancy'
+type MockReceptionsRepository_SaveDiscrepancy_Call struct {
+	*mock.Call
+}
+
+// SaveDiscrepancy is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+//   - discrepancyReport domain.DiscrepancyReport
+func (_e *MockReceptionsRepository_Expecter) SaveDiscrepancy(context1 interface{}, connection interface{}, v interface{}, discrepancyReport interface{}) *MockReceptionsRepository_SaveDiscrepancy_Call {
+	return &MockReceptionsRepository_SaveDiscrepancy_Call{Call: _e.mock.On("SaveDiscrepancy", context1, connection, v, discrepancyReport)}
+}
+
+func (_c *MockReceptionsRepository_SaveDiscrepancy_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID, discrepancyReport domain.DiscrepancyReport)) *MockReceptionsRepository_SaveDiscrepancy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		var arg3 domain.DiscrepancyReport
+		if args[3] != nil {
+			arg3 = args[3].(domain.DiscrepancyReport)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsRepository_SaveDiscrepancy_Call) Return(err error) *MockReceptionsRepository_SaveDiscrepancy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionsRepository_SaveDiscrepancy_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID, discrepancyReport domain.DiscrepancyReport) error) *MockReceptionsRepository_SaveDiscrepancy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveManifest provides a mock function for the type MockReceptionsRepository
+func (_mock *MockReceptionsRepository) SaveManifest(context1 context.Context, connection domain.Connection, v domain.ReceptionID, receptionManifest domain.ReceptionManifest) error {
+	ret := _mock.Called(context1, connection, v, receptionManifest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveManifest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID, domain.ReceptionManifest) error); ok {
+		r0 = returnFunc(context1, connection, v, receptionManifest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionsRepository_SaveManifest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveManifest'
+type MockReceptionsRepository_SaveManifest_Call struct {
+	*mock.Call
+}
+
+// SaveManifest is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+//   - receptionManifest domain.ReceptionManifest
+func (_e *MockReceptionsRepository_Expecter) SaveManifest(context1 interface{}, connection interface{}, v interface{}, receptionManifest interface{}) *MockReceptionsRepository_SaveManifest_Call {
+	return &MockReceptionsRepository_SaveManifest_Call{Call: _e.mock.On("SaveManifest", context1, connection, v, receptionManifest)}
+}
+
+func (_c *MockReceptionsRepository_SaveManifest_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID, receptionManifest domain.ReceptionManifest)) *MockReceptionsRepository_SaveManifest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		var arg3 domain.ReceptionManifest
+		if args[3] != nil {
+			arg3 = args[3].(domain.ReceptionManifest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsRepository_SaveManifest_Call) Return(err error) *MockReceptionsRepository_SaveManifest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionsRepository_SaveManifest_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID, receptionManifest domain.ReceptionManifest) error) *MockReceptionsRepository_SaveManifest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductsRepository creates a new instance of MockProductsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductsRepository {
+	mock := &MockProductsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductsRepository is an autogenerated mock type for the ProductsRepository type
+type MockProductsRepository struct {
+	mock.Mock
+}
+
+type MockProductsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductsRepository) EXPECT() *MockProductsRepository_Expecter {
+	return &MockProductsRepository_Expecter{mock: &_m.Mock}
+}
+
+// ChangeStatus provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) ChangeStatus(context1 context.Context, connection domain.Connection, productTransition domain.ProductTransition) (domain.Product, error) {
+	ret := _mock.Called(context1, connection, productTransition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStatus")
+	}
+
+	var r0 domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductTransition) (domain.Product, error)); ok {
+		return returnFunc(context1, connection, productTransition)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductTransition) domain.Product); ok {
+		r0 = returnFunc(context1, connection, productTransition)
+	} else {
+		r0 = ret.Get(0).(domain.Product)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ProductTransition) error); ok {
+		r1 = returnFunc(context1, connection, productTransition)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductsRepository_ChangeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStatus'
+type MockProductsRepository_ChangeStatus_Call struct {
+	*mock.Call
+}
+
+// ChangeStatus is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - productTransition domain.ProductTransition
+func (_e *MockProductsRepository_Expecter) ChangeStatus(context1 interface{}, connection interface{}, productTransition interface{}) *MockProductsRepository_ChangeStatus_Call {
+	return &MockProductsRepository_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus", context1, connection, productTransition)}
+}
+
+func (_c *MockProductsRepository_ChangeStatus_Call) Run(run func(context1 context.Context, connection domain.Connection, productTransition domain.ProductTransition)) *MockProductsRepository_ChangeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ProductTransition
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductTransition)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_ChangeStatus_Call) Return(product domain.Product, err error) *MockProductsRepository_ChangeStatus_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductsRepository_ChangeStatus_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, productTransition domain.ProductTransition) (domain.Product, error)) *MockProductsRepository_ChangeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) Create(context1 context.Context, connection domain.Connection, product domain.Product) error {
+	ret := _mock.Called(context1, connection, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Product) error); ok {
+		r0 = returnFunc(context1, connection, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductsRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductsRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - product domain.Product
+func (_e *MockProductsRepository_Expecter) Create(context1 interface{}, connection interface{}, product interface{}) *MockProductsRepository_Create_Call {
+	return &MockProductsRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, product)}
+}
+
+func (_c *MockProductsRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, product domain.Product)) *MockProductsRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Product
+		if args[2] != nil {
+			arg2 = args[2].(domain.Product)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_Create_Call) Return(err error) *MockProductsRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductsRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, product domain.Product) error) *MockProductsRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBatch provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) CreateBatch(context1 context.Context, connection domain.Connection, products []domain.Product) error {
+	ret := _mock.Called(context1, connection, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.Product) error); ok {
+		r0 = returnFunc(context1, connection, products)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductsRepository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type MockProductsRepository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - products []domain.Product
+func (_e *MockProductsRepository_Expecter) CreateBatch(context1 interface{}, connection interface{}, products interface{}) *MockProductsRepository_CreateBatch_Call {
+	return &MockProductsRepository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", context1, connection, products)}
+}
+
+func (_c *MockProductsRepository_CreateBatch_Call) Run(run func(context1 context.Context, connection domain.Connection, products []domain.Product)) *MockProductsRepository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.Product
+		if args[2] != nil {
+			arg2 = args[2].([]domain.Product)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_CreateBatch_Call) Return(err error) *MockProductsRepository_CreateBatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductsRepository_CreateBatch_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, products []domain.Product) error) *MockProductsRepository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLast provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) DeleteLast(ctx context.Context, connection domain.Connection, receptionID domain.ReceptionID, deletedBy domain.UserID) (domain.Product, error) {
+	ret := _mock.Called(ctx, connection, receptionID, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLast")
+	}
+
+	var r0 domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID, domain.UserID) (domain.Product, error)); ok {
+		return returnFunc(ctx, connection, receptionID, deletedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID, domain.UserID) domain.Product); ok {
+		r0 = returnFunc(ctx, connection, receptionID, deletedBy)
+	} else {
+		r0 = ret.Get(0).(domain.Product)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ReceptionID, domain.UserID) error); ok {
+		r1 = returnFunc(ctx, connection, receptionID, deletedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductsRepository_DeleteLast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLast'
+type MockProductsRepository_DeleteLast_Call struct {
+	*mock.Call
+}
+
+// DeleteLast is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - receptionID domain.ReceptionID
+//   - deletedBy domain.UserID
+func (_e *MockProductsRepository_Expecter) DeleteLast(ctx interface{}, connection interface{}, receptionID interface{}, deletedBy interface{}) *MockProductsRepository_DeleteLast_Call {
+	return &MockProductsRepository_DeleteLast_Call{Call: _e.mock.On("DeleteLast", ctx, connection, receptionID, deletedBy)}
+}
+
+func (_c *MockProductsRepository_DeleteLast_Call) Run(run func(ctx context.Context, connection domain.Connection, receptionID domain.ReceptionID, deletedBy domain.UserID)) *MockProductsRepository_DeleteLast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		var arg3 domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_DeleteLast_Call) Return(product domain.Product, err error) *MockProductsRepository_DeleteLast_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductsRepository_DeleteLast_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, receptionID domain.ReceptionID, deletedBy domain.UserID) (domain.Product, error)) *MockProductsRepository_DeleteLast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByBarcode provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) FindByBarcode(context1 context.Context, connection domain.Connection, s string) ([]domain.ProductLocation, error) {
+	ret := _mock.Called(context1, connection, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBarcode")
+	}
+
+	var r0 []domain.ProductLocation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, string) ([]domain.ProductLocation, error)); ok {
+		return returnFunc(context1, connection, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, string) []domain.ProductLocation); ok {
+		r0 = returnFunc(context1, connection, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductLocation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, string) error); ok {
+		r1 = returnFunc(context1, connection, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductsRepository_FindByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByBarcode'
+type MockProductsRepository_FindByBarcode_Call struct {
+	*mock.Call
+}
+
+// FindByBarcode is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - s string
+func (_e *MockProductsRepository_Expecter) FindByBarcode(context1 interface{}, connection interface{}, s interface{}) *MockProductsRepository_FindByBarcode_Call {
+	return &MockProductsRepository_FindByBarcode_Call{Call: _e.mock.On("FindByBarcode", context1, connection, s)}
+}
+
+func (_c *MockProductsRepository_FindByBarcode_Call) Run(run func(context1 context.Context, connection domain.Connection, s string)) *MockProductsRepository_FindByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_FindByBarcode_Call) Return(productLocations []domain.ProductLocation, err error) *MockProductsRepository_FindByBarcode_Call {
+	_c.Call.Return(productLocations, err)
+	return _c
+}
+
+func (_c *MockProductsRepository_FindByBarcode_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, s string) ([]domain.ProductLocation, error)) *MockProductsRepository_FindByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIDs provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) FindByIDs(context1 context.Context, connection domain.Connection, vs []domain.ProductID) ([]domain.Product, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDs")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ProductID) ([]domain.Product, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ProductID) []domain.Product); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.ProductID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductsRepository_FindByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDs'
+type MockProductsRepository_FindByIDs_Call struct {
+	*mock.Call
+}
+
+// FindByIDs is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.ProductID
+func (_e *MockProductsRepository_Expecter) FindByIDs(context1 interface{}, connection interface{}, vs interface{}) *MockProductsRepository_FindByIDs_Call {
+	return &MockProductsRepository_FindByIDs_Call{Call: _e.mock.On("FindByIDs", context1, connection, vs)}
+}
+
+func (_c *MockProductsRepository_FindByIDs_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.ProductID)) *MockProductsRepository_FindByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_FindByIDs_Call) Return(products []domain.Product, err error) *MockProductsRepository_FindByIDs_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductsRepository_FindByIDs_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.ProductID) ([]domain.Product, error)) *MockProductsRepository_FindByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByReceptionIDs provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) FindByReceptionIDs(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID) ([]domain.Product, error) {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByReceptionIDs")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID) ([]domain.Product, error)); ok {
+		return returnFunc(context1, connection, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID) []domain.Product); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.ReceptionID) error); ok {
+		r1 = returnFunc(context1, connection, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductsRepository_FindByReceptionIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByReceptionIDs'
+type MockProductsRepository_FindByReceptionIDs_Call struct {
+	*mock.Call
+}
+
+// FindByReceptionIDs is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.ReceptionID
+func (_e *MockProductsRepository_Expecter) FindByReceptionIDs(context1 interface{}, connection interface{}, vs interface{}) *MockProductsRepository_FindByReceptionIDs_Call {
+	return &MockProductsRepository_FindByReceptionIDs_Call{Call: _e.mock.On("FindByReceptionIDs", context1, connection, vs)}
+}
+
+func (_c *MockProductsRepository_FindByReceptionIDs_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID)) *MockProductsRepository_FindByReceptionIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_FindByReceptionIDs_Call) Return(products []domain.Product, err error) *MockProductsRepository_FindByReceptionIDs_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductsRepository_FindByReceptionIDs_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.ReceptionID) ([]domain.Product, error)) *MockProductsRepository_FindByReceptionIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindInProgressByBarcodes provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) FindInProgressByBarcodes(context1 context.Context, connection domain.Connection, strings []string) ([]domain.Product, error) {
+	ret := _mock.Called(context1, connection, strings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindInProgressByBarcodes")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []string) ([]domain.Product, error)); ok {
+		return returnFunc(context1, connection, strings)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []string) []domain.Product); ok {
+		r0 = returnFunc(context1, connection, strings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []string) error); ok {
+		r1 = returnFunc(context1, connection, strings)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductsRepository_FindInProgressByBarcodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindInProgressByBarcodes'
+type MockProductsRepository_FindInProgressByBarcodes_Call struct {
+	*mock.Call
+}
+
+// FindInProgressByBarcodes is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - strings []string
+func (_e *MockProductsRepository_Expecter) FindInProgressByBarcodes(context1 interface{}, connection interface{}, strings interface{}) *MockProductsRepository_FindInProgressByBarcodes_Call {
+	return &MockProductsRepository_FindInProgressByBarcodes_Call{Call: _e.mock.On("FindInProgressByBarcodes", context1, connection, strings)}
+}
+
+func (_c *MockProductsRepository_FindInProgressByBarcodes_Call) Run(run func(context1 context.Context, connection domain.Connection, strings []string)) *MockProductsRepository_FindInProgressByBarcodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_FindInProgressByBarcodes_Call) Return(products []domain.Product, err error) *MockProductsRepository_FindInProgressByBarcodes_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockProductsRepository_FindInProgressByBarcodes_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, strings []string) ([]domain.Product, error)) *MockProductsRepository_FindInProgressByBarcodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreReceived provides a mock function for the type MockProductsRepository
+func (_mock *MockProductsRepository) StoreReceived(ctx context.Context, connection domain.Connection, receptionIDs []domain.ReceptionID, storedBy *domain.UserID) error {
+	ret := _mock.Called(ctx, connection, receptionIDs, storedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreReceived")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ReceptionID, *domain.UserID) error); ok {
+		r0 = returnFunc(ctx, connection, receptionIDs, storedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductsRepository_StoreReceived_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreReceived'
+type MockProductsRepository_StoreReceived_Call struct {
+	*mock.Call
+}
+
+// StoreReceived is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - receptionIDs []domain.ReceptionID
+//   - storedBy *domain.UserID
+func (_e *MockProductsRepository_Expecter) StoreReceived(ctx interface{}, connection interface{}, receptionIDs interface{}, storedBy interface{}) *MockProductsRepository_StoreReceived_Call {
+	return &MockProductsRepository_StoreReceived_Call{Call: _e.mock.On("StoreReceived", ctx, connection, receptionIDs, storedBy)}
+}
+
+func (_c *MockProductsRepository_StoreReceived_Call) Run(run func(ctx context.Context, connection domain.Connection, receptionIDs []domain.ReceptionID, storedBy *domain.UserID)) *MockProductsRepository_StoreReceived_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ReceptionID)
+		}
+		var arg3 *domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(*domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductsRepository_StoreReceived_Call) Return(err error) *MockProductsRepository_StoreReceived_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductsRepository_StoreReceived_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, receptionIDs []domain.ReceptionID, storedBy *domain.UserID) error) *MockProductsRepository_StoreReceived_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrdersRepository creates a new instance of MockOrdersRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrdersRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrdersRepository {
+	mock := &MockOrdersRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOrdersRepository is an autogenerated mock type for the OrdersRepository type
+type MockOrdersRepository struct {
+	mock.Mock
+}
+
+type MockOrdersRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrdersRepository) EXPECT() *MockOrdersRepository_Expecter {
+	return &MockOrdersRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockOrdersRepository
+func (_mock *MockOrdersRepository) Create(context1 context.Context, connection domain.Connection, order domain.Order, vs []domain.ProductID) error {
+	ret := _mock.Called(context1, connection, order, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Order, []domain.ProductID) error); ok {
+		r0 = returnFunc(context1, connection, order, vs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrdersRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockOrdersRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - order domain.Order
+//   - vs []domain.ProductID
+func (_e *MockOrdersRepository_Expecter) Create(context1 interface{}, connection interface{}, order interface{}, vs interface{}) *MockOrdersRepository_Create_Call {
+	return &MockOrdersRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, order, vs)}
+}
+
+func (_c *MockOrdersRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, order domain.Order, vs []domain.ProductID)) *MockOrdersRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Order
+		if args[2] != nil {
+			arg2 = args[2].(domain.Order)
+		}
+		var arg3 []domain.ProductID
+		if args[3] != nil {
+			arg3 = args[3].([]domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrdersRepository_Create_Call) Return(err error) *MockOrdersRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrdersRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, order domain.Order, vs []domain.ProductID) error) *MockOrdersRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindProducts provides a mock function for the type MockOrdersRepository
+func (_mock *MockOrdersRepository) FindProducts(context1 context.Context, connection domain.Connection, v domain.OrderID) ([]domain.Product, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProducts")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.OrderID) ([]domain.Product, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.OrderID) []domain.Product); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.OrderID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrdersRepository_FindProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProducts'
+type MockOrdersRepository_FindProducts_Call struct {
+	*mock.Call
+}
+
+// FindProducts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.OrderID
+func (_e *MockOrdersRepository_Expecter) FindProducts(context1 interface{}, connection interface{}, v interface{}) *MockOrdersRepository_FindProducts_Call {
+	return &MockOrdersRepository_FindProducts_Call{Call: _e.mock.On("FindProducts", context1, connection, v)}
+}
+
+func (_c *MockOrdersRepository_FindProducts_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.OrderID)) *MockOrdersRepository_FindProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.OrderID
+		if args[2] != nil {
+			arg2 = args[2].(domain.OrderID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrdersRepository_FindProducts_Call) Return(products []domain.Product, err error) *MockOrdersRepository_FindProducts_Call {
+	_c.Call.Return(products, err)
+	return _c
+}
+
+func (_c *MockOrdersRepository_FindProducts_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.OrderID) ([]domain.Product, error)) *MockOrdersRepository_FindProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWaiting provides a mock function for the type MockOrdersRepository
+func (_mock *MockOrdersRepository) FindWaiting(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, code string) (domain.Order, error) {
+	ret := _mock.Called(ctx, connection, pvzID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWaiting")
+	}
+
+	var r0 domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, string) (domain.Order, error)); ok {
+		return returnFunc(ctx, connection, pvzID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, string) domain.Order); ok {
+		r0 = returnFunc(ctx, connection, pvzID, code)
+	} else {
+		r0 = ret.Get(0).(domain.Order)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID, string) error); ok {
+		r1 = returnFunc(ctx, connection, pvzID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrdersRepository_FindWaiting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWaiting'
+type MockOrdersRepository_FindWaiting_Call struct {
+	*mock.Call
+}
+
+// FindWaiting is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - pvzID domain.PVZID
+//   - code string
+func (_e *MockOrdersRepository_Expecter) FindWaiting(ctx interface{}, connection interface{}, pvzID interface{}, code interface{}) *MockOrdersRepository_FindWaiting_Call {
+	return &MockOrdersRepository_FindWaiting_Call{Call: _e.mock.On("FindWaiting", ctx, connection, pvzID, code)}
+}
+
+func (_c *MockOrdersRepository_FindWaiting_Call) Run(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, code string)) *MockOrdersRepository_FindWaiting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrdersRepository_FindWaiting_Call) Return(order domain.Order, err error) *MockOrdersRepository_FindWaiting_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrdersRepository_FindWaiting_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, code string) (domain.Order, error)) *MockOrdersRepository_FindWaiting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWaitingByProduct provides a mock function for the type MockOrdersRepository
+func (_mock *MockOrdersRepository) FindWaitingByProduct(context1 context.Context, connection domain.Connection, v domain.ProductID) (domain.Order, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWaitingByProduct")
+	}
+
+	var r0 domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductID) (domain.Order, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductID) domain.Order); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.Order)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.ProductID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrdersRepository_FindWaitingByProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWaitingByProduct'
+type MockOrdersRepository_FindWaitingByProduct_Call struct {
+	*mock.Call
+}
+
+// FindWaitingByProduct is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ProductID
+func (_e *MockOrdersRepository_Expecter) FindWaitingByProduct(context1 interface{}, connection interface{}, v interface{}) *MockOrdersRepository_FindWaitingByProduct_Call {
+	return &MockOrdersRepository_FindWaitingByProduct_Call{Call: _e.mock.On("FindWaitingByProduct", context1, connection, v)}
+}
+
+func (_c *MockOrdersRepository_FindWaitingByProduct_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ProductID)) *MockOrdersRepository_FindWaitingByProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrdersRepository_FindWaitingByProduct_Call) Return(order domain.Order, err error) *MockOrdersRepository_FindWaitingByProduct_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrdersRepository_FindWaitingByProduct_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ProductID) (domain.Order, error)) *MockOrdersRepository_FindWaitingByProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkIssued provides a mock function for the type MockOrdersRepository
+func (_mock *MockOrdersRepository) MarkIssued(ctx context.Context, connection domain.Connection, orderID domain.OrderID, issuedBy domain.UserID) error {
+	ret := _mock.Called(ctx, connection, orderID, issuedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkIssued")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.OrderID, domain.UserID) error); ok {
+		r0 = returnFunc(ctx, connection, orderID, issuedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrdersRepository_MarkIssued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkIssued'
+type MockOrdersRepository_MarkIssued_Call struct {
+	*mock.Call
+}
+
+// MarkIssued is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - orderID domain.OrderID
+//   - issuedBy domain.UserID
+func (_e *MockOrdersRepository_Expecter) MarkIssued(ctx interface{}, connection interface{}, orderID interface{}, issuedBy interface{}) *MockOrdersRepository_MarkIssued_Call {
+	return &MockOrdersRepository_MarkIssued_Call{Call: _e.mock.On("MarkIssued", ctx, connection, orderID, issuedBy)}
+}
+
+func (_c *MockOrdersRepository_MarkIssued_Call) Run(run func(ctx context.Context, connection domain.Connection, orderID domain.OrderID, issuedBy domain.UserID)) *MockOrdersRepository_MarkIssued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.OrderID
+		if args[2] != nil {
+			arg2 = args[2].(domain.OrderID)
+		}
+		var arg3 domain.UserID
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrdersRepository_MarkIssued_Call) Return(err error) *MockOrdersRepository_MarkIssued_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrdersRepository_MarkIssued_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, orderID domain.OrderID, issuedBy domain.UserID) error) *MockOrdersRepository_MarkIssued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStorageCellsRepository creates a new instance of MockStorageCellsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStorageCellsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStorageCellsRepository {
+	mock := &MockStorageCellsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStorageCellsRepository is an autogenerated mock type for the StorageCellsRepository type
+type MockStorageCellsRepository struct {
+	mock.Mock
+}
+
+type MockStorageCellsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStorageCellsRepository) EXPECT() *MockStorageCellsRepository_Expecter {
+	return &MockStorageCellsRepository_Expecter{mock: &_m.Mock}
+}
+
+// Allocate provides a mock function for the type MockStorageCellsRepository
+func (_mock *MockStorageCellsRepository) Allocate(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, product domain.Product) (*domain.StorageCell, error) {
+	ret := _mock.Called(ctx, connection, pvzID, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allocate")
+	}
+
+	var r0 *domain.StorageCell
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, domain.Product) (*domain.StorageCell, error)); ok {
+		return returnFunc(ctx, connection, pvzID, product)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID, domain.Product) *domain.StorageCell); ok {
+		r0 = returnFunc(ctx, connection, pvzID, product)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StorageCell)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID, domain.Product) error); ok {
+		r1 = returnFunc(ctx, connection, pvzID, product)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStorageCellsRepository_Allocate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allocate'
+type MockStorageCellsRepository_Allocate_Call struct {
+	*mock.Call
+}
+
+// Allocate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - pvzID domain.PVZID
+//   - product domain.Product
+func (_e *MockStorageCellsRepository_Expecter) Allocate(ctx interface{}, connection interface{}, pvzID interface{}, product interface{}) *MockStorageCellsRepository_Allocate_Call {
+	return &MockStorageCellsRepository_Allocate_Call{Call: _e.mock.On("Allocate", ctx, connection, pvzID, product)}
+}
+
+func (_c *MockStorageCellsRepository_Allocate_Call) Run(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, product domain.Product)) *MockStorageCellsRepository_Allocate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 domain.Product
+		if args[3] != nil {
+			arg3 = args[3].(domain.Product)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_Allocate_Call) Return(storageCell *domain.StorageCell, err error) *MockStorageCellsRepository_Allocate_Call {
+	_c.Call.Return(storageCell, err)
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_Allocate_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, pvzID domain.PVZID, product domain.Product) (*domain.StorageCell, error)) *MockStorageCellsRepository_Allocate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLayout provides a mock function for the type MockStorageCellsRepository
+func (_mock *MockStorageCellsRepository) FindLayout(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.StorageLayout, error) {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLayout")
+	}
+
+	var r0 domain.StorageLayout
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) (domain.StorageLayout, error)); ok {
+		return returnFunc(context1, connection, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.PVZID) domain.StorageLayout); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Get(0).(domain.StorageLayout)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, connection, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStorageCellsRepository_FindLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLayout'
+type MockStorageCellsRepository_FindLayout_Call struct {
+	*mock.Call
+}
+
+// FindLayout is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.PVZID
+func (_e *MockStorageCellsRepository_Expecter) FindLayout(context1 interface{}, connection interface{}, v interface{}) *MockStorageCellsRepository_FindLayout_Call {
+	return &MockStorageCellsRepository_FindLayout_Call{Call: _e.mock.On("FindLayout", context1, connection, v)}
+}
+
+func (_c *MockStorageCellsRepository_FindLayout_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.PVZID)) *MockStorageCellsRepository_FindLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_FindLayout_Call) Return(storageLayout domain.StorageLayout, err error) *MockStorageCellsRepository_FindLayout_Call {
+	_c.Call.Return(storageLayout, err)
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_FindLayout_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.PVZID) (domain.StorageLayout, error)) *MockStorageCellsRepository_FindLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Free provides a mock function for the type MockStorageCellsRepository
+func (_mock *MockStorageCellsRepository) Free(context1 context.Context, connection domain.Connection, vs []domain.ProductID) error {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Free")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ProductID) error); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStorageCellsRepository_Free_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Free'
+type MockStorageCellsRepository_Free_Call struct {
+	*mock.Call
+}
+
+// Free is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.ProductID
+func (_e *MockStorageCellsRepository_Expecter) Free(context1 interface{}, connection interface{}, vs interface{}) *MockStorageCellsRepository_Free_Call {
+	return &MockStorageCellsRepository_Free_Call{Call: _e.mock.On("Free", context1, connection, vs)}
+}
+
+func (_c *MockStorageCellsRepository_Free_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.ProductID)) *MockStorageCellsRepository_Free_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_Free_Call) Return(err error) *MockStorageCellsRepository_Free_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_Free_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.ProductID) error) *MockStorageCellsRepository_Free_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveLayout provides a mock function for the type MockStorageCellsRepository
+func (_mock *MockStorageCellsRepository) SaveLayout(context1 context.Context, connection domain.Connection, storageLayout domain.StorageLayout) error {
+	ret := _mock.Called(context1, connection, storageLayout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveLayout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.StorageLayout) error); ok {
+		r0 = returnFunc(context1, connection, storageLayout)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStorageCellsRepository_SaveLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveLayout'
+type MockStorageCellsRepository_SaveLayout_Call struct {
+	*mock.Call
+}
+
+// SaveLayout is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - storageLayout domain.StorageLayout
+func (_e *MockStorageCellsRepository_Expecter) SaveLayout(context1 interface{}, connection interface{}, storageLayout interface{}) *MockStorageCellsRepository_SaveLayout_Call {
+	return &MockStorageCellsRepository_SaveLayout_Call{Call: _e.mock.On("SaveLayout", context1, connection, storageLayout)}
+}
+
+func (_c *MockStorageCellsRepository_SaveLayout_Call) Run(run func(context1 context.Context, connection domain.Connection, storageLayout domain.StorageLayout)) *MockStorageCellsRepository_SaveLayout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.StorageLayout
+		if args[2] != nil {
+			arg2 = args[2].(domain.StorageLayout)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_SaveLayout_Call) Return(err error) *MockStorageCellsRepository_SaveLayout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStorageCellsRepository_SaveLayout_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, storageLayout domain.StorageLayout) error) *MockStorageCellsRepository_SaveLayout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProductTypesRepository creates a new instance of MockProductTypesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProductTypesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProductTypesRepository {
+	mock := &MockProductTypesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProductTypesRepository is an autogenerated mock type for the ProductTypesRepository type
+type MockProductTypesRepository struct {
+	mock.Mock
+}
+
+type MockProductTypesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProductTypesRepository) EXPECT() *MockProductTypesRepository_Expecter {
+	return &MockProductTypesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockProductTypesRepository
+func (_mock *MockProductTypesRepository) Create(context1 context.Context, connection domain.Connection, productTypeEntry domain.ProductTypeEntry) error {
+	ret := _mock.Called(context1, connection, productTypeEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductTypeEntry) error); ok {
+		r0 = returnFunc(context1, connection, productTypeEntry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductTypesRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductTypesRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - productTypeEntry domain.ProductTypeEntry
+func (_e *MockProductTypesRepository_Expecter) Create(context1 interface{}, connection interface{}, productTypeEntry interface{}) *MockProductTypesRepository_Create_Call {
+	return &MockProductTypesRepository_Create_Call{Call: _e.mock.On("Create", context1, connection, productTypeEntry)}
+}
+
+func (_c *MockProductTypesRepository_Create_Call) Run(run func(context1 context.Context, connection domain.Connection, productTypeEntry domain.ProductTypeEntry)) *MockProductTypesRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ProductTypeEntry
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductTypeEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductTypesRepository_Create_Call) Return(err error) *MockProductTypesRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductTypesRepository_Create_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, productTypeEntry domain.ProductTypeEntry) error) *MockProductTypesRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockProductTypesRepository
+func (_mock *MockProductTypesRepository) FindAll(ctx context.Context, connection domain.Connection, includeInactive bool) ([]domain.ProductTypeEntry, error) {
+	ret := _mock.Called(ctx, connection, includeInactive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.ProductTypeEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, bool) ([]domain.ProductTypeEntry, error)); ok {
+		return returnFunc(ctx, connection, includeInactive)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, bool) []domain.ProductTypeEntry); ok {
+		r0 = returnFunc(ctx, connection, includeInactive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTypeEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, bool) error); ok {
+		r1 = returnFunc(ctx, connection, includeInactive)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypesRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockProductTypesRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - includeInactive bool
+func (_e *MockProductTypesRepository_Expecter) FindAll(ctx interface{}, connection interface{}, includeInactive interface{}) *MockProductTypesRepository_FindAll_Call {
+	return &MockProductTypesRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, connection, includeInactive)}
+}
+
+func (_c *MockProductTypesRepository_FindAll_Call) Run(run func(ctx context.Context, connection domain.Connection, includeInactive bool)) *MockProductTypesRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductTypesRepository_FindAll_Call) Return(productTypeEntrys []domain.ProductTypeEntry, err error) *MockProductTypesRepository_FindAll_Call {
+	_c.Call.Return(productTypeEntrys, err)
+	return _c
+}
+
+func (_c *MockProductTypesRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, includeInactive bool) ([]domain.ProductTypeEntry, error)) *MockProductTypesRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByCodes provides a mock function for the type MockProductTypesRepository
+func (_mock *MockProductTypesRepository) FindByCodes(context1 context.Context, connection domain.Connection, productTypes []domain.ProductType) ([]domain.ProductTypeEntry, error) {
+	ret := _mock.Called(context1, connection, productTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCodes")
+	}
+
+	var r0 []domain.ProductTypeEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ProductType) ([]domain.ProductTypeEntry, error)); ok {
+		return returnFunc(context1, connection, productTypes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ProductType) []domain.ProductTypeEntry); ok {
+		r0 = returnFunc(context1, connection, productTypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTypeEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, []domain.ProductType) error); ok {
+		r1 = returnFunc(context1, connection, productTypes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductTypesRepository_FindByCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByCodes'
+type MockProductTypesRepository_FindByCodes_Call struct {
+	*mock.Call
+}
+
+// FindByCodes is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - productTypes []domain.ProductType
+func (_e *MockProductTypesRepository_Expecter) FindByCodes(context1 interface{}, connection interface{}, productTypes interface{}) *MockProductTypesRepository_FindByCodes_Call {
+	return &MockProductTypesRepository_FindByCodes_Call{Call: _e.mock.On("FindByCodes", context1, connection, productTypes)}
+}
+
+func (_c *MockProductTypesRepository_FindByCodes_Call) Run(run func(context1 context.Context, connection domain.Connection, productTypes []domain.ProductType)) *MockProductTypesRepository_FindByCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ProductType
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ProductType)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductTypesRepository_FindByCodes_Call) Return(productTypeEntrys []domain.ProductTypeEntry, err error) *MockProductTypesRepository_FindByCodes_Call {
+	_c.Call.Return(productTypeEntrys, err)
+	return _c
+}
+
+func (_c *MockProductTypesRepository_FindByCodes_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, productTypes []domain.ProductType) ([]domain.ProductTypeEntry, error)) *MockProductTypesRepository_FindByCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockProductTypesRepository
+func (_mock *MockProductTypesRepository) Update(context1 context.Context, connection domain.Connection, productTypeEntry domain.ProductTypeEntry) error {
+	ret := _mock.Called(context1, connection, productTypeEntry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductTypeEntry) error); ok {
+		r0 = returnFunc(context1, connection, productTypeEntry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductTypesRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProductTypesRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - productTypeEntry domain.ProductTypeEntry
+func (_e *MockProductTypesRepository_Expecter) Update(context1 interface{}, connection interface{}, productTypeEntry interface{}) *MockProductTypesRepository_Update_Call {
+	return &MockProductTypesRepository_Update_Call{Call: _e.mock.On("Update", context1, connection, productTypeEntry)}
+}
+
+func (_c *MockProductTypesRepository_Update_Call) Run(run func(context1 context.Context, connection domain.Connection, productTypeEntry domain.ProductTypeEntry)) *MockProductTypesRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ProductTypeEntry
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductTypeEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductTypesRepository_Update_Call) Return(err error) *MockProductTypesRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductTypesRepository_Update_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, productTypeEntry domain.ProductTypeEntry) error) *MockProductTypesRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnalyticsRepository creates a new instance of MockAnalyticsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsRepository {
+	mock := &MockAnalyticsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsRepository is an autogenerated mock type for the AnalyticsRepository type
+type MockAnalyticsRepository struct {
+	mock.Mock
+}
+
+type MockAnalyticsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsRepository) EXPECT() *MockAnalyticsRepository_Expecter {
+	return &MockAnalyticsRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddProduct provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) AddProduct(context1 context.Context, connection domain.Connection, v domain.ProductID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ProductID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_AddProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProduct'
+type MockAnalyticsRepository_AddProduct_Call struct {
+	*mock.Call
+}
+
+// AddProduct is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ProductID
+func (_e *MockAnalyticsRepository_Expecter) AddProduct(context1 interface{}, connection interface{}, v interface{}) *MockAnalyticsRepository_AddProduct_Call {
+	return &MockAnalyticsRepository_AddProduct_Call{Call: _e.mock.On("AddProduct", context1, connection, v)}
+}
+
+func (_c *MockAnalyticsRepository_AddProduct_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ProductID)) *MockAnalyticsRepository_AddProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_AddProduct_Call) Return(err error) *MockAnalyticsRepository_AddProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_AddProduct_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ProductID) error) *MockAnalyticsRepository_AddProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddProducts provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) AddProducts(context1 context.Context, connection domain.Connection, vs []domain.ProductID) error {
+	ret := _mock.Called(context1, connection, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProducts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, []domain.ProductID) error); ok {
+		r0 = returnFunc(context1, connection, vs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_AddProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProducts'
+type MockAnalyticsRepository_AddProducts_Call struct {
+	*mock.Call
+}
+
+// AddProducts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - vs []domain.ProductID
+func (_e *MockAnalyticsRepository_Expecter) AddProducts(context1 interface{}, connection interface{}, vs interface{}) *MockAnalyticsRepository_AddProducts_Call {
+	return &MockAnalyticsRepository_AddProducts_Call{Call: _e.mock.On("AddProducts", context1, connection, vs)}
+}
+
+func (_c *MockAnalyticsRepository_AddProducts_Call) Run(run func(context1 context.Context, connection domain.Connection, vs []domain.ProductID)) *MockAnalyticsRepository_AddProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 []domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].([]domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_AddProducts_Call) Return(err error) *MockAnalyticsRepository_AddProducts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_AddProducts_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, vs []domain.ProductID) error) *MockAnalyticsRepository_AddProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddReception provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) AddReception(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReception")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_AddReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReception'
+type MockAnalyticsRepository_AddReception_Call struct {
+	*mock.Call
+}
+
+// AddReception is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+func (_e *MockAnalyticsRepository_Expecter) AddReception(context1 interface{}, connection interface{}, v interface{}) *MockAnalyticsRepository_AddReception_Call {
+	return &MockAnalyticsRepository_AddReception_Call{Call: _e.mock.On("AddReception", context1, connection, v)}
+}
+
+func (_c *MockAnalyticsRepository_AddReception_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockAnalyticsRepository_AddReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_AddReception_Call) Return(err error) *MockAnalyticsRepository_AddReception_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_AddReception_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error) *MockAnalyticsRepository_AddReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cities provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Cities(ctx context.Context, connection domain.Connection, from time.Time, to time.Time, busiest int) ([]domain.CityStats, error) {
+	ret := _mock.Called(ctx, connection, from, to, busiest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cities")
+	}
+
+	var r0 []domain.CityStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, int) ([]domain.CityStats, error)); ok {
+		return returnFunc(ctx, connection, from, to, busiest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, time.Time, time.Time, int) []domain.CityStats); ok {
+		r0 = returnFunc(ctx, connection, from, to, busiest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CityStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, time.Time, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, connection, from, to, busiest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Cities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cities'
+type MockAnalyticsRepository_Cities_Call struct {
+	*mock.Call
+}
+
+// Cities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - from time.Time
+//   - to time.Time
+//   - busiest int
+func (_e *MockAnalyticsRepository_Expecter) Cities(ctx interface{}, connection interface{}, from interface{}, to interface{}, busiest interface{}) *MockAnalyticsRepository_Cities_Call {
+	return &MockAnalyticsRepository_Cities_Call{Call: _e.mock.On("Cities", ctx, connection, from, to, busiest)}
+}
+
+func (_c *MockAnalyticsRepository_Cities_Call) Run(run func(ctx context.Context, connection domain.Connection, from time.Time, to time.Time, busiest int)) *MockAnalyticsRepository_Cities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Cities_Call) Return(cityStatss []domain.CityStats, err error) *MockAnalyticsRepository_Cities_Call {
+	_c.Call.Return(cityStatss, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Cities_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, from time.Time, to time.Time, busiest int) ([]domain.CityStats, error)) *MockAnalyticsRepository_Cities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloseReception provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) CloseReception(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseReception")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_CloseReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseReception'
+type MockAnalyticsRepository_CloseReception_Call struct {
+	*mock.Call
+}
+
+// CloseReception is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+func (_e *MockAnalyticsRepository_Expecter) CloseReception(context1 interface{}, connection interface{}, v interface{}) *MockAnalyticsRepository_CloseReception_Call {
+	return &MockAnalyticsRepository_CloseReception_Call{Call: _e.mock.On("CloseReception", context1, connection, v)}
+}
+
+func (_c *MockAnalyticsRepository_CloseReception_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockAnalyticsRepository_CloseReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_CloseReception_Call) Return(err error) *MockAnalyticsRepository_CloseReception_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_CloseReception_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error) *MockAnalyticsRepository_CloseReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Daily provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Daily(ctx context.Context, connection domain.Connection, pvzID *domain.PVZID, from time.Time, to time.Time) ([]domain.DailyStats, error) {
+	ret := _mock.Called(ctx, connection, pvzID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Daily")
+	}
+
+	var r0 []domain.DailyStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.PVZID, time.Time, time.Time) ([]domain.DailyStats, error)); ok {
+		return returnFunc(ctx, connection, pvzID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, *domain.PVZID, time.Time, time.Time) []domain.DailyStats); ok {
+		r0 = returnFunc(ctx, connection, pvzID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DailyStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Connection, *domain.PVZID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, connection, pvzID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Daily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Daily'
+type MockAnalyticsRepository_Daily_Call struct {
+	*mock.Call
+}
+
+// Daily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection domain.Connection
+//   - pvzID *domain.PVZID
+//   - from time.Time
+//   - to time.Time
+func (_e *MockAnalyticsRepository_Expecter) Daily(ctx interface{}, connection interface{}, pvzID interface{}, from interface{}, to interface{}) *MockAnalyticsRepository_Daily_Call {
+	return &MockAnalyticsRepository_Daily_Call{Call: _e.mock.On("Daily", ctx, connection, pvzID, from, to)}
+}
+
+func (_c *MockAnalyticsRepository_Daily_Call) Run(run func(ctx context.Context, connection domain.Connection, pvzID *domain.PVZID, from time.Time, to time.Time)) *MockAnalyticsRepository_Daily_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 *domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(*domain.PVZID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Daily_Call) Return(dailyStatss []domain.DailyStats, err error) *MockAnalyticsRepository_Daily_Call {
+	_c.Call.Return(dailyStatss, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Daily_Call) RunAndReturn(run func(ctx context.Context, connection domain.Connection, pvzID *domain.PVZID, from time.Time, to time.Time) ([]domain.DailyStats, error)) *MockAnalyticsRepository_Daily_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveProduct provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) RemoveProduct(context1 context.Context, connection domain.Connection, product domain.Product) error {
+	ret := _mock.Called(context1, connection, product)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.Product) error); ok {
+		r0 = returnFunc(context1, connection, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_RemoveProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProduct'
+type MockAnalyticsRepository_RemoveProduct_Call struct {
+	*mock.Call
+}
+
+// RemoveProduct is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - product domain.Product
+func (_e *MockAnalyticsRepository_Expecter) RemoveProduct(context1 interface{}, connection interface{}, product interface{}) *MockAnalyticsRepository_RemoveProduct_Call {
+	return &MockAnalyticsRepository_RemoveProduct_Call{Call: _e.mock.On("RemoveProduct", context1, connection, product)}
+}
+
+func (_c *MockAnalyticsRepository_RemoveProduct_Call) Run(run func(context1 context.Context, connection domain.Connection, product domain.Product)) *MockAnalyticsRepository_RemoveProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.Product
+		if args[2] != nil {
+			arg2 = args[2].(domain.Product)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_RemoveProduct_Call) Return(err error) *MockAnalyticsRepository_RemoveProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_RemoveProduct_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, product domain.Product) error) *MockAnalyticsRepository_RemoveProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReception provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) ReopenReception(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error {
+	ret := _mock.Called(context1, connection, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReception")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Connection, domain.ReceptionID) error); ok {
+		r0 = returnFunc(context1, connection, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_ReopenReception_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReception'
+type MockAnalyticsRepository_ReopenReception_Call struct {
+	*mock.Call
+}
+
+// ReopenReception is a helper method to define mock.On call
+//   - context1 context.Context
+//   - connection domain.Connection
+//   - v domain.ReceptionID
+func (_e *MockAnalyticsRepository_Expecter) ReopenReception(context1 interface{}, connection interface{}, v interface{}) *MockAnalyticsRepository_ReopenReception_Call {
+	return &MockAnalyticsRepository_ReopenReception_Call{Call: _e.mock.On("ReopenReception", context1, connection, v)}
+}
+
+func (_c *MockAnalyticsRepository_ReopenReception_Call) Run(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID)) *MockAnalyticsRepository_ReopenReception_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Connection
+		if args[1] != nil {
+			arg1 = args[1].(domain.Connection)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_ReopenReception_Call) Return(err error) *MockAnalyticsRepository_ReopenReception_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_ReopenReception_Call) RunAndReturn(run func(context1 context.Context, connection domain.Connection, v domain.ReceptionID) error) *MockAnalyticsRepository_ReopenReception_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMetrics creates a new instance of MockMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMetrics(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMetrics {
+	mock := &MockMetrics{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMetrics is an autogenerated mock type for the Metrics type
+type MockMetrics struct {
+	mock.Mock
+}
+
+type MockMetrics_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMetrics) EXPECT() *MockMetrics_Expecter {
+	return &MockMetrics_Expecter{mock: &_m.Mock}
+}
+
+// IncPVZs provides a mock function for the type MockMetrics
+func (_mock *MockMetrics) IncPVZs() {
+	_mock.Called()
+	return
+}
+
+// MockMetrics_IncPVZs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncPVZs'
+type MockMetrics_IncPVZs_Call struct {
+	*mock.Call
+}
+
+// IncPVZs is a helper method to define mock.On call
+func (_e *MockMetrics_Expecter) IncPVZs() *MockMetrics_IncPVZs_Call {
+	return &MockMetrics_IncPVZs_Call{Call: _e.mock.On("IncPVZs")}
+}
+
+func (_c *MockMetrics_IncPVZs_Call) Run(run func()) *MockMetrics_IncPVZs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMetrics_IncPVZs_Call) Return() *MockMetrics_IncPVZs_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetrics_IncPVZs_Call) RunAndReturn(run func()) *MockMetrics_IncPVZs_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncProducts provides a mock function for the type MockMetrics
+func (_mock *MockMetrics) IncProducts() {
+	_mock.Called()
+	return
+}
+
+// MockMetrics_IncProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncProducts'
+type MockMetrics_IncProducts_Call struct {
+	*mock.Call
+}
+
+// IncProducts is a helper method to define mock.On call
+func (_e *MockMetrics_Expecter) IncProducts() *MockMetrics_IncProducts_Call {
+	return &MockMetrics_IncProducts_Call{Call: _e.mock.On("IncProducts")}
+}
+
+func (_c *MockMetrics_IncProducts_Call) Run(run func()) *MockMetrics_IncProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMetrics_IncProducts_Call) Return() *MockMetrics_IncProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetrics_IncProducts_Call) RunAndReturn(run func()) *MockMetrics_IncProducts_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncReceptions provides a mock function for the type MockMetrics
+func (_mock *MockMetrics) IncReceptions() {
+	_mock.Called()
+	return
+}
+
+// MockMetrics_IncReceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncReceptions'
+type MockMetrics_IncReceptions_Call struct {
+	*mock.Call
+}
+
+// IncReceptions is a helper method to define mock.On call
+func (_e *MockMetrics_Expecter) IncReceptions() *MockMetrics_IncReceptions_Call {
+	return &MockMetrics_IncReceptions_Call{Call: _e.mock.On("IncReceptions")}
+}
+
+func (_c *MockMetrics_IncReceptions_Call) Run(run func()) *MockMetrics_IncReceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMetrics_IncReceptions_Call) Return() *MockMetrics_IncReceptions_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetrics_IncReceptions_Call) RunAndReturn(run func()) *MockMetrics_IncReceptions_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncStaleReceptionsClosed provides a mock function for the type MockMetrics
+func (_mock *MockMetrics) IncStaleReceptionsClosed() {
+	_mock.Called()
+	return
+}
+
+// MockMetrics_IncStaleReceptionsClosed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncStaleReceptionsClosed'
+type MockMetrics_IncStaleReceptionsClosed_Call struct {
+	*mock.Call
+}
+
+// IncStaleReceptionsClosed is a helper method to define mock.On call
+func (_e *MockMetrics_Expecter) IncStaleReceptionsClosed() *MockMetrics_IncStaleReceptionsClosed_Call {
+	return &MockMetrics_IncStaleReceptionsClosed_Call{Call: _e.mock.On("IncStaleReceptionsClosed")}
+}
+
+func (_c *MockMetrics_IncStaleReceptionsClosed_Call) Run(run func()) *MockMetrics_IncStaleReceptionsClosed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMetrics_IncStaleReceptionsClosed_Call) Return() *MockMetrics_IncStaleReceptionsClosed_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetrics_IncStaleReceptionsClosed_Call) RunAndReturn(run func()) *MockMetrics_IncStaleReceptionsClosed_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncUsers provides a mock function for the type MockMetrics
+func (_mock *MockMetrics) IncUsers() {
+	_mock.Called()
+	return
+}
+
+// MockMetrics_IncUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncUsers'
+type MockMetrics_IncUsers_Call struct {
+	*mock.Call
+}
+
+// IncUsers is a helper method to define mock.On call
+func (_e *MockMetrics_Expecter) IncUsers() *MockMetrics_IncUsers_Call {
+	return &MockMetrics_IncUsers_Call{Call: _e.mock.On("IncUsers")}
+}
+
+func (_c *MockMetrics_IncUsers_Call) Run(run func()) *MockMetrics_IncUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMetrics_IncUsers_Call) Return() *MockMetrics_IncUsers_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetrics_IncUsers_Call) RunAndReturn(run func()) *MockMetrics_IncUsers_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockAuthenticatedUser creates a new instance of MockAuthenticatedUser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthenticatedUser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthenticatedUser {
+	mock := &MockAuthenticatedUser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuthenticatedUser is an autogenerated mock type for the AuthenticatedUser type
+type MockAuthenticatedUser struct {
+	mock.Mock
+}
+
+type MockAuthenticatedUser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthenticatedUser) EXPECT() *MockAuthenticatedUser_Expecter {
+	return &MockAuthenticatedUser_Expecter{mock: &_m.Mock}
+}
+
+// GetUserID provides a mock function for the type MockAuthenticatedUser
+func (_mock *MockAuthenticatedUser) GetUserID() domain.UserID {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserID")
+	}
+
+	var r0 domain.UserID
+	if returnFunc, ok := ret.Get(0).(func() domain.UserID); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.UserID)
+		}
+	}
+	return r0
+}
+
+// MockAuthenticatedUser_GetUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserID'
+type MockAuthenticatedUser_GetUserID_Call struct {
+	*mock.Call
+}
+
+// GetUserID is a helper method to define mock.On call
+func (_e *MockAuthenticatedUser_Expecter) GetUserID() *MockAuthenticatedUser_GetUserID_Call {
+	return &MockAuthenticatedUser_GetUserID_Call{Call: _e.mock.On("GetUserID")}
+}
+
+func (_c *MockAuthenticatedUser_GetUserID_Call) Run(run func()) *MockAuthenticatedUser_GetUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAuthenticatedUser_GetUserID_Call) Return(v domain.UserID) *MockAuthenticatedUser_GetUserID_Call {
+	_c.Call.Return(v)
+	return _c
+}
+
+func (_c *MockAuthenticatedUser_GetUserID_Call) RunAndReturn(run func() domain.UserID) *MockAuthenticatedUser_GetUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRole provides a mock function for the type MockAuthenticatedUser
+func (_mock *MockAuthenticatedUser) GetUserRole() domain.UserRole {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRole")
+	}
+
+	var r0 domain.UserRole
+	if returnFunc, ok := ret.Get(0).(func() domain.UserRole); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(domain.UserRole)
+	}
+	return r0
+}
+
+// MockAuthenticatedUser_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
+type MockAuthenticatedUser_GetUserRole_Call struct {
+	*mock.Call
+}
+
+// GetUserRole is a helper method to define mock.On call
+func (_e *MockAuthenticatedUser_Expecter) GetUserRole() *MockAuthenticatedUser_GetUserRole_Call {
+	return &MockAuthenticatedUser_GetUserRole_Call{Call: _e.mock.On("GetUserRole")}
+}
+
+func (_c *MockAuthenticatedUser_GetUserRole_Call) Run(run func()) *MockAuthenticatedUser_GetUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAuthenticatedUser_GetUserRole_Call) Return(userRole domain.UserRole) *MockAuthenticatedUser_GetUserRole_Call {
+	_c.Call.Return(userRole)
+	return _c
+}
+
+func (_c *MockAuthenticatedUser_GetUserRole_Call) RunAndReturn(run func() domain.UserRole) *MockAuthenticatedUser_GetUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUsersInterface creates a new instance of MockUsersInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUsersInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUsersInterface {
+	mock := &MockUsersInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUsersInterface is an autogenerated mock type for the UsersInterface type
+type MockUsersInterface struct {
+	mock.Mock
+}
+
+type MockUsersInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUsersInterface) EXPECT() *MockUsersInterface_Expecter {
+	return &MockUsersInterface_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockUsersInterface
+func (_mock *MockUsersInterface) Create(context1 context.Context, s string, s1 string, userRole domain.UserRole) (domain.User, error) {
+	ret := _mock.Called(context1, s, s1, userRole)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.UserRole) (domain.User, error)); ok {
+		return returnFunc(context1, s, s1, userRole)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.UserRole) domain.User); ok {
+		r0 = returnFunc(context1, s, s1, userRole)
+	} else {
+		r0 = ret.Get(0).(domain.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.UserRole) error); ok {
+		r1 = returnFunc(context1, s, s1, userRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUsersInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockUsersInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+//   - s1 string
+//   - userRole domain.UserRole
+func (_e *MockUsersInterface_Expecter) Create(context1 interface{}, s interface{}, s1 interface{}, userRole interface{}) *MockUsersInterface_Create_Call {
+	return &MockUsersInterface_Create_Call{Call: _e.mock.On("Create", context1, s, s1, userRole)}
+}
+
+func (_c *MockUsersInterface_Create_Call) Run(run func(context1 context.Context, s string, s1 string, userRole domain.UserRole)) *MockUsersInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.UserRole
+		if args[3] != nil {
+			arg3 = args[3].(domain.UserRole)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersInterface_Create_Call) Return(user domain.User, err error) *MockUsersInterface_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUsersInterface_Create_Call) RunAndReturn(run func(context1 context.Context, s string, s1 string, userRole domain.UserRole) (domain.User, error)) *MockUsersInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTokenByEmailAndPassword provides a mock function for the type MockUsersInterface
+func (_mock *MockUsersInterface) FindTokenByEmailAndPassword(context1 context.Context, s string, s1 string) (string, error) {
+	ret := _mock.Called(context1, s, s1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTokenByEmailAndPassword")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(context1, s, s1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(context1, s, s1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(context1, s, s1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUsersInterface_FindTokenByEmailAndPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTokenByEmailAndPassword'
+type MockUsersInterface_FindTokenByEmailAndPassword_Call struct {
+	*mock.Call
+}
+
+// FindTokenByEmailAndPassword is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+//   - s1 string
+func (_e *MockUsersInterface_Expecter) FindTokenByEmailAndPassword(context1 interface{}, s interface{}, s1 interface{}) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
+	return &MockUsersInterface_FindTokenByEmailAndPassword_Call{Call: _e.mock.On("FindTokenByEmailAndPassword", context1, s, s1)}
+}
+
+func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) Run(run func(context1 context.Context, s string, s1 string)) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) Return(s2 string, err error) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
+	_c.Call.Return(s2, err)
+	return _c
+}
+
+func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) RunAndReturn(run func(context1 context.Context, s string, s1 string) (string, error)) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginByToken provides a mock function for the type MockUsersInterface
+func (_mock *MockUsersInterface) LoginByToken(context1 context.Context, s string) (domain.AuthenticatedUser, error) {
+	ret := _mock.Called(context1, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginByToken")
+	}
+
+	var r0 domain.AuthenticatedUser
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.AuthenticatedUser, error)); ok {
+		return returnFunc(context1, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.AuthenticatedUser); ok {
+		r0 = returnFunc(context1, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(domain.AuthenticatedUser)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(context1, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUsersInterface_LoginByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginByToken'
+type MockUsersInterface_LoginByToken_Call struct {
+	*mock.Call
+}
+
+// LoginByToken is a helper method to define mock.On call
+//   - context1 context.Context
+//   - s string
+func (_e *MockUsersInterface_Expecter) LoginByToken(context1 interface{}, s interface{}) *MockUsersInterface_LoginByToken_Call {
+	return &MockUsersInterface_LoginByToken_Call{Call: _e.mock.On("LoginByToken", context1, s)}
+}
+
+func (_c *MockUsersInterface_LoginByToken_Call) Run(run func(context1 context.Context, s string)) *MockUsersInterface_LoginByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUsersInterface_LoginByToken_Call) Return(authenticatedUser domain.AuthenticatedUser, err error) *MockUsersInterface_LoginByToken_Call {
+	_c.Call.Return(authenticatedUser, err)
+	return _c
+}
+
+func (_c *MockUsersInterface_LoginByToken_Call) RunAndReturn(run func(context1 context.Context, s string) (domain.AuthenticatedUser, error)) *MockUsersInterface_LoginByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPVZsInterface creates a new instance of MockPVZsInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPVZsInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPVZsInterface {
+	mock := &MockPVZsInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPVZsInterface is an autogenerated mock type for the PVZsInterface type
+type MockPVZsInterface struct {
+	mock.Mock
+}
+
+type MockPVZsInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPVZsInterface) EXPECT() *MockPVZsInterface_Expecter {
+	return &MockPVZsInterface_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockPVZsInterface
+func (_mock *MockPVZsInterface) Create(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZCity domain.PVZCity, pVZSchedule domain.PVZSchedule) (domain.PVZ, error) {
+	ret := _mock.Called(context1, authenticatedUser, pVZCity, pVZSchedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZCity, domain.PVZSchedule) (domain.PVZ, error)); ok {
+		return returnFunc(context1, authenticatedUser, pVZCity, pVZSchedule)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZCity, domain.PVZSchedule) domain.PVZ); ok {
+		r0 = returnFunc(context1, authenticatedUser, pVZCity, pVZSchedule)
+	} else {
+		r0 = ret.Get(0).(domain.PVZ)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZCity, domain.PVZSchedule) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, pVZCity, pVZSchedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZsInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockPVZsInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - pVZCity domain.PVZCity
+//   - pVZSchedule domain.PVZSchedule
+func (_e *MockPVZsInterface_Expecter) Create(context1 interface{}, authenticatedUser interface{}, pVZCity interface{}, pVZSchedule interface{}) *MockPVZsInterface_Create_Call {
+	return &MockPVZsInterface_Create_Call{Call: _e.mock.On("Create", context1, authenticatedUser, pVZCity, pVZSchedule)}
+}
+
+func (_c *MockPVZsInterface_Create_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZCity domain.PVZCity, pVZSchedule domain.PVZSchedule)) *MockPVZsInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZCity
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZCity)
+		}
+		var arg3 domain.PVZSchedule
+		if args[3] != nil {
+			arg3 = args[3].(domain.PVZSchedule)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsInterface_Create_Call) Return(pVZ domain.PVZ, err error) *MockPVZsInterface_Create_Call {
+	_c.Call.Return(pVZ, err)
+	return _c
+}
+
+func (_c *MockPVZsInterface_Create_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZCity domain.PVZCity, pVZSchedule domain.PVZSchedule) (domain.PVZ, error)) *MockPVZsInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function for the type MockPVZsInterface
+func (_mock *MockPVZsInterface) Export(ctx context.Context, authUser domain.AuthenticatedUser, filter domain.PVZFilter, each func(domain.ExportRow) error) error {
+	ret := _mock.Called(ctx, authUser, filter, each)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZFilter, func(domain.ExportRow) error) error); ok {
+		r0 = returnFunc(ctx, authUser, filter, each)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZsInterface_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockPVZsInterface_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - filter domain.PVZFilter
+//   - each func(domain.ExportRow) error
+func (_e *MockPVZsInterface_Expecter) Export(ctx interface{}, authUser interface{}, filter interface{}, each interface{}) *MockPVZsInterface_Export_Call {
+	return &MockPVZsInterface_Export_Call{Call: _e.mock.On("Export", ctx, authUser, filter, each)}
+}
+
+func (_c *MockPVZsInterface_Export_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, filter domain.PVZFilter, each func(domain.ExportRow) error)) *MockPVZsInterface_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		var arg3 func(domain.ExportRow) error
+		if args[3] != nil {
+			arg3 = args[3].(func(domain.ExportRow) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsInterface_Export_Call) Return(err error) *MockPVZsInterface_Export_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZsInterface_Export_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, filter domain.PVZFilter, each func(domain.ExportRow) error) error) *MockPVZsInterface_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockPVZsInterface
+func (_mock *MockPVZsInterface) FindAll(context1 context.Context) ([]domain.PVZ, error) {
+	ret := _mock.Called(context1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []domain.PVZ
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.PVZ, error)); ok {
+		return returnFunc(context1)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.PVZ); ok {
+		r0 = returnFunc(context1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PVZ)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(context1)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZsInterface_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockPVZsInterface_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - context1 context.Context
+func (_e *MockPVZsInterface_Expecter) FindAll(context1 interface{}) *MockPVZsInterface_FindAll_Call {
+	return &MockPVZsInterface_FindAll_Call{Call: _e.mock.On("FindAll", context1)}
+}
+
+func (_c *MockPVZsInterface_FindAll_Call) Run(run func(context1 context.Context)) *MockPVZsInterface_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsInterface_FindAll_Call) Return(pVZs []domain.PVZ, err error) *MockPVZsInterface_FindAll_Call {
+	_c.Call.Return(pVZs, err)
+	return _c
+}
+
+func (_c *MockPVZsInterface_FindAll_Call) RunAndReturn(run func(context1 context.Context) ([]domain.PVZ, error)) *MockPVZsInterface_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDetails provides a mock function for the type MockPVZsInterface
+func (_mock *MockPVZsInterface) FindDetails(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.PVZDetails, error) {
+	ret := _mock.Called(context1, authenticatedUser, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDetails")
+	}
+
+	var r0 domain.PVZDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) (domain.PVZDetails, error)); ok {
+		return returnFunc(context1, authenticatedUser, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) domain.PVZDetails); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
+	} else {
+		r0 = ret.Get(0).(domain.PVZDetails)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZsInterface_FindDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDetails'
+type MockPVZsInterface_FindDetails_Call struct {
+	*mock.Call
+}
+
+// FindDetails is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+func (_e *MockPVZsInterface_Expecter) FindDetails(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockPVZsInterface_FindDetails_Call {
+	return &MockPVZsInterface_FindDetails_Call{Call: _e.mock.On("FindDetails", context1, authenticatedUser, v)}
+}
+
+func (_c *MockPVZsInterface_FindDetails_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockPVZsInterface_FindDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsInterface_FindDetails_Call) Return(pVZDetails domain.PVZDetails, err error) *MockPVZsInterface_FindDetails_Call {
+	_c.Call.Return(pVZDetails, err)
+	return _c
+}
+
+func (_c *MockPVZsInterface_FindDetails_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.PVZDetails, error)) *MockPVZsInterface_FindDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPVZReceptionProducts provides a mock function for the type MockPVZsInterface
+func (_mock *MockPVZsInterface) FindPVZReceptionProducts(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZFilter domain.PVZFilter, n *int, n1 *int, s *string) (domain.PVZPage, error) {
+	ret := _mock.Called(context1, authenticatedUser, pVZFilter, n, n1, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPVZReceptionProducts")
+	}
+
+	var r0 domain.PVZPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZFilter, *int, *int, *string) (domain.PVZPage, error)); ok {
+		return returnFunc(context1, authenticatedUser, pVZFilter, n, n1, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZFilter, *int, *int, *string) domain.PVZPage); ok {
+		r0 = returnFunc(context1, authenticatedUser, pVZFilter, n, n1, s)
+	} else {
+		r0 = ret.Get(0).(domain.PVZPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZFilter, *int, *int, *string) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, pVZFilter, n, n1, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPVZsInterface_FindPVZReceptionProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPVZReceptionProducts'
+type MockPVZsInterface_FindPVZReceptionProducts_Call struct {
+	*mock.Call
+}
+
+// FindPVZReceptionProducts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - pVZFilter domain.PVZFilter
+//   - n *int
+//   - n1 *int
+//   - s *string
+func (_e *MockPVZsInterface_Expecter) FindPVZReceptionProducts(context1 interface{}, authenticatedUser interface{}, pVZFilter interface{}, n interface{}, n1 interface{}, s interface{}) *MockPVZsInterface_FindPVZReceptionProducts_Call {
+	return &MockPVZsInterface_FindPVZReceptionProducts_Call{Call: _e.mock.On("FindPVZReceptionProducts", context1, authenticatedUser, pVZFilter, n, n1, s)}
+}
+
+func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZFilter domain.PVZFilter, n *int, n1 *int, s *string)) *MockPVZsInterface_FindPVZReceptionProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		var arg3 *int
+		if args[3] != nil {
+			arg3 = args[3].(*int)
+		}
+		var arg4 *int
+		if args[4] != nil {
+			arg4 = args[4].(*int)
+		}
+		var arg5 *string
+		if args[5] != nil {
+			arg5 = args[5].(*string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) Return(pVZPage domain.PVZPage, err error) *MockPVZsInterface_FindPVZReceptionProducts_Call {
+	_c.Call.Return(pVZPage, err)
+	return _c
+}
+
+func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZFilter domain.PVZFilter, n *int, n1 *int, s *string) (domain.PVZPage, error)) *MockPVZsInterface_FindPVZReceptionProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockPVZsInterface
+func (_mock *MockPVZsInterface) Stream(ctx context.Context, authUser domain.AuthenticatedUser, filter domain.PVZFilter, each func(domain.PVZReceptionsProducts) error) error {
+	ret := _mock.Called(ctx, authUser, filter, each)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZFilter, func(domain.PVZReceptionsProducts) error) error); ok {
+		r0 = returnFunc(ctx, authUser, filter, each)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockPVZsInterface_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockPVZsInterface_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - filter domain.PVZFilter
+//   - each func(domain.PVZReceptionsProducts) error
+func (_e *MockPVZsInterface_Expecter) Stream(ctx interface{}, authUser interface{}, filter interface{}, each interface{}) *MockPVZsInterface_Stream_Call {
+	return &MockPVZsInterface_Stream_Call{Call: _e.mock.On("Stream", ctx, authUser, filter, each)}
+}
+
+func (_c *MockPVZsInterface_Stream_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, filter domain.PVZFilter, each func(domain.PVZReceptionsProducts) error)) *MockPVZsInterface_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZFilter)
+		}
+		var arg3 func(domain.PVZReceptionsProducts) error
+		if args[3] != nil {
+			arg3 = args[3].(func(domain.PVZReceptionsProducts) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPVZsInterface_Stream_Call) Return(err error) *MockPVZsInterface_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockPVZsInterface_Stream_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, filter domain.PVZFilter, each func(domain.PVZReceptionsProducts) error) error) *MockPVZsInterface_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReceptionsInterface creates a new instance of MockReceptionsInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReceptionsInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReceptionsInterface {
+	mock := &MockReceptionsInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReceptionsInterface is an autogenerated mock type for the ReceptionsInterface type
+type MockReceptionsInterface struct {
+	mock.Mock
+}
+
+type MockReceptionsInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReceptionsInterface) EXPECT() *MockReceptionsInterface_Expecter {
+	return &MockReceptionsInterface_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) Close(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.ClosedReception, error) {
+	ret := _mock.Called(context1, authenticatedUser, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 domain.ClosedReception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) (domain.ClosedReception, error)); ok {
+		return returnFunc(context1, authenticatedUser, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) domain.ClosedReception); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
+	} else {
+		r0 = ret.Get(0).(domain.ClosedReception)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsInterface_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockReceptionsInterface_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+func (_e *MockReceptionsInterface_Expecter) Close(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_Close_Call {
+	return &MockReceptionsInterface_Close_Call{Call: _e.mock.On("Close", context1, authenticatedUser, v)}
+}
+
+func (_c *MockReceptionsInterface_Close_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockReceptionsInterface_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsInterface_Close_Call) Return(closedReception domain.ClosedReception, err error) *MockReceptionsInterface_Close_Call {
+	_c.Call.Return(closedReception, err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_Close_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.ClosedReception, error)) *MockReceptionsInterface_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) Create(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, b bool, receptionManifest domain.ReceptionManifest) (domain.Reception, error) {
+	ret := _mock.Called(context1, authenticatedUser, v, b, receptionManifest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 domain.Reception
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, bool, domain.ReceptionManifest) (domain.Reception, error)); ok {
+		return returnFunc(context1, authenticatedUser, v, b, receptionManifest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, bool, domain.ReceptionManifest) domain.Reception); ok {
+		r0 = returnFunc(context1, authenticatedUser, v, b, receptionManifest)
+	} else {
+		r0 = ret.Get(0).(domain.Reception)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, bool, domain.ReceptionManifest) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v, b, receptionManifest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReceptionsInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+//   - b bool
+//   - receptionManifest domain.ReceptionManifest
+func (_e *MockReceptionsInterface_Expecter) Create(context1 interface{}, authenticatedUser interface{}, v interface{}, b interface{}, receptionManifest interface{}) *MockReceptionsInterface_Create_Call {
+	return &MockReceptionsInterface_Create_Call{Call: _e.mock.On("Create", context1, authenticatedUser, v, b, receptionManifest)}
+}
+
+func (_c *MockReceptionsInterface_Create_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, b bool, receptionManifest domain.ReceptionManifest)) *MockReceptionsInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		var arg4 domain.ReceptionManifest
+		if args[4] != nil {
+			arg4 = args[4].(domain.ReceptionManifest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsInterface_Create_Call) Return(reception domain.Reception, err error) *MockReceptionsInterface_Create_Call {
+	_c.Call.Return(reception, err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_Create_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, b bool, receptionManifest domain.ReceptionManifest) (domain.Reception, error)) *MockReceptionsInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrder provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) CreateOrder(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, vs []domain.ProductID) (domain.OrderProducts, error) {
+	ret := _mock.Called(context1, authenticatedUser, v, vs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 domain.OrderProducts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, []domain.ProductID) (domain.OrderProducts, error)); ok {
+		return returnFunc(context1, authenticatedUser, v, vs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, []domain.ProductID) domain.OrderProducts); ok {
+		r0 = returnFunc(context1, authenticatedUser, v, vs)
+	} else {
+		r0 = ret.Get(0).(domain.OrderProducts)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, []domain.ProductID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v, vs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsInterface_CreateOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrder'
+type MockReceptionsInterface_CreateOrder_Call struct {
+	*mock.Call
+}
+
+// CreateOrder is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+//   - vs []domain.ProductID
+func (_e *MockReceptionsInterface_Expecter) CreateOrder(context1 interface{}, authenticatedUser interface{}, v interface{}, vs interface{}) *MockReceptionsInterface_CreateOrder_Call {
+	return &MockReceptionsInterface_CreateOrder_Call{Call: _e.mock.On("CreateOrder", context1, authenticatedUser, v, vs)}
+}
+
+func (_c *MockReceptionsInterface_CreateOrder_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, vs []domain.ProductID)) *MockReceptionsInterface_CreateOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 []domain.ProductID
+		if args[3] != nil {
+			arg3 = args[3].([]domain.ProductID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsInterface_CreateOrder_Call) Return(orderProducts domain.OrderProducts, err error) *MockReceptionsInterface_CreateOrder_Call {
+	_c.Call.Return(orderProducts, err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_CreateOrder_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, vs []domain.ProductID) (domain.OrderProducts, error)) *MockReceptionsInterface_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProduct provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) CreateProduct(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productDraft domain.ProductDraft) (domain.AddedProduct, error) {
+	ret := _mock.Called(context1, authenticatedUser, v, productDraft)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProduct")
+	}
+
+	var r0 domain.AddedProduct
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ProductDraft) (domain.AddedProduct, error)); ok {
+		return returnFunc(context1, authenticatedUser, v, productDraft)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ProductDraft) domain.AddedProduct); ok {
+		r0 = returnFunc(context1, authenticatedUser, v, productDraft)
+	} else {
+		r0 = ret.Get(0).(domain.AddedProduct)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ProductDraft) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v, productDraft)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsInterface_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
+type MockReceptionsInterface_CreateProduct_Call struct {
+	*mock.Call
+}
+
+// CreateProduct is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+//   - productDraft domain.ProductDraft
+func (_e *MockReceptionsInterface_Expecter) CreateProduct(context1 interface{}, authenticatedUser interface{}, v interface{}, productDraft interface{}) *MockReceptionsInterface_CreateProduct_Call {
+	return &MockReceptionsInterface_CreateProduct_Call{Call: _e.mock.On("CreateProduct", context1, authenticatedUser, v, productDraft)}
+}
+
+func (_c *MockReceptionsInterface_CreateProduct_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productDraft domain.ProductDraft)) *MockReceptionsInterface_CreateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 domain.ProductDraft
+		if args[3] != nil {
+			arg3 = args[3].(domain.ProductDraft)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsInterface_CreateProduct_Call) Return(addedProduct domain.AddedProduct, err error) *MockReceptionsInterface_CreateProduct_Call {
+	_c.Call.Return(addedProduct, err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_CreateProduct_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productDraft domain.ProductDraft) (domain.AddedProduct, error)) *MockReceptionsInterface_CreateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProducts provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) CreateProducts(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productDrafts []domain.ProductDraft) ([]domain.AddedProduct, error) {
+	ret := _mock.Called(context1, authenticatedUser, v, productDrafts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProducts")
+	}
+
+	var r0 []domain.AddedProduct
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, []domain.ProductDraft) ([]domain.AddedProduct, error)); ok {
+		return returnFunc(context1, authenticatedUser, v, productDrafts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, []domain.ProductDraft) []domain.AddedProduct); ok {
+		r0 = returnFunc(context1, authenticatedUser, v, productDrafts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AddedProduct)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, []domain.ProductDraft) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v, productDrafts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsInterface_CreateProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProducts'
+type MockReceptionsInterface_CreateProducts_Call struct {
+	*mock.Call
+}
+
+// CreateProducts is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+//   - productDrafts []domain.ProductDraft
+func (_e *MockReceptionsInterface_Expecter) CreateProducts(context1 interface{}, authenticatedUser interface{}, v interface{}, productDrafts interface{}) *MockReceptionsInterface_CreateProducts_Call {
+	return &MockReceptionsInterface_CreateProducts_Call{Call: _e.mock.On("CreateProducts", context1, authenticatedUser, v, productDrafts)}
+}
+
+func (_c *MockReceptionsInterface_CreateProducts_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productDrafts []domain.ProductDraft)) *MockReceptionsInterface_CreateProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 []domain.ProductDraft
+		if args[3] != nil {
+			arg3 = args[3].([]domain.ProductDraft)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsInterface_CreateProducts_Call) Return(addedProducts []domain.AddedProduct, err error) *MockReceptionsInterface_CreateProducts_Call {
+	_c.Call.Return(addedProducts, err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_CreateProducts_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productDrafts []domain.ProductDraft) ([]domain.AddedProduct, error)) *MockReceptionsInterface_CreateProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLastProduct provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) DeleteLastProduct(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) error {
+	ret := _mock.Called(context1, authenticatedUser, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLastProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReceptionsInterface_DeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProduct'
+type MockReceptionsInterface_DeleteLastProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteLastProduct is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+func (_e *MockReceptionsInterface_Expecter) DeleteLastProduct(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_DeleteLastProduct_Call {
+	return &MockReceptionsInterface_DeleteLastProduct_Call{Call: _e.mock.On("DeleteLastProduct", context1, authenticatedUser, v)}
+}
+
+func (_c *MockReceptionsInterface_DeleteLastProduct_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockReceptionsInterface_DeleteLastProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReceptionsInterface_DeleteLastProduct_Call) Return(err error) *MockReceptionsInterface_DeleteLastProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_DeleteLastProduct_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) error) *MockReceptionsInterface_DeleteLastProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByPVZ provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) FindByPVZ(ctx context.Context, authUser domain.AuthenticatedUser, pvzID domain.PVZID, filter domain.ReceptionFilter, page *int, limit *int) (domain.ReceptionPage, error) {
+	ret := _mock.Called(ctx, authUser, pvzID, filter, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPVZ")
+	}
+
+	var r0 domain.ReceptionPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ReceptionFilter, *int, *int) (domain.ReceptionPage, error)); ok {
+		return returnFunc(ctx, authUser, pvzID, filter, page, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ReceptionFilter, *int, *int) domain.ReceptionPage); ok {
+		r0 = returnFunc(ctx, authUser, pvzID, filter, page, limit)
+	} else {
+		r0 = ret.Get(0).(domain.ReceptionPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ReceptionFilter, *int, *int) error); ok {
+		r1 = returnFunc(ctx, authUser, pvzID, filter, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReceptionsInterface_FindByPVZ_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByPVZ'
+type MockReceptionsInterface_FindByPVZ_Call struct {
+	*mock.Call
+}
+
+// FindByPVZ is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - pvzID domain.PVZID
+//   - filter domain.ReceptionFilter
+//   - page *int
+//   - limit *int
+func (_e *MockReceptionsInterface_Expecter) FindByPVZ(ctx interface{}, authUser interface{}, pvzID interface{}, filter interface{}, page interface{}, limit interface{}) *MockReceptionsInterface_FindByPVZ_Call {
+	return &MockReceptionsInterface_FindByPVZ_Call{Call: _e.mock.On("FindByPVZ", ctx, authUser, pvzID, filter, page, limit)}
+}
+
+func (_c *MockReceptionsInterface_FindByPVZ_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, pvzID domain.PVZID, filter domain.ReceptionFilter, page *int, limit *int)) *MockReceptionsInterface_FindByPVZ_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 domain.ReceptionFilter
+		if args[3] != nil {
+			arg3 = args[3].(domain.ReceptionFilter)
+		}
+		var arg4 *int
+		if args[4] != nil {
+			arg4 = args[4].(*int)
+		}
+		var arg5 *int
+		if args[5] != nil {
+			arg5 = args[5].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
 	})
 	return _c
 }
 
-func (_c *MockAuthenticatedUser_GetUserID_Call) Return(v domain.UserID) *MockAuthenticatedUser_GetUserID_Call {
-	_c.Call.Return(v)
+func (_c *MockReceptionsInterface_FindByPVZ_Call) Return(receptionPage domain.ReceptionPage, err error) *MockReceptionsInterface_FindByPVZ_Call {
+	_c.Call.Return(receptionPage, err)
 	return _c
 }
 
-func (_c *MockAuthenticatedUser_GetUserID_Call) RunAndReturn(run func() domain.UserID) *MockAuthenticatedUser_GetUserID_Call {
+func (_c *MockReceptionsInterface_FindByPVZ_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, pvzID domain.PVZID, filter domain.ReceptionFilter, page *int, limit *int) (domain.ReceptionPage, error)) *MockReceptionsInterface_FindByPVZ_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserRole provides a mock function for the type MockAuthenticatedUser
-func (_mock *MockAuthenticatedUser) GetUserRole() domain.UserRole {
-	ret := _mock.Called()
+// FindDetails provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) FindDetails(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ReceptionID) (domain.ReceptionDetails, error) {
+	ret := _mock.Called(context1, authenticatedUser, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserRole")
+		panic("no return value specified for FindDetails")
 	}
 
-	var r0 domain.UserRole
-	if returnFunc, ok := ret.Get(0).(func() domain.UserRole); ok {
-		r0 = returnFunc()
+	var r0 domain.ReceptionDetails
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ReceptionID) (domain.ReceptionDetails, error)); ok {
+		return returnFunc(context1, authenticatedUser, v)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ReceptionID) domain.ReceptionDetails); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
 	} else {
-		r0 = ret.Get(0).(domain.UserRole)
+		r0 = ret.Get(0).(domain.ReceptionDetails)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.ReceptionID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockAuthenticatedUser_GetUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRole'
-type MockAuthenticatedUser_GetUserRole_Call struct {
+// MockReceptionsInterface_FindDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDetails'
+type MockReceptionsInterface_FindDetails_Call struct {
 	*mock.Call
 }
 
-// GetUserRole is a helper method to define mock.On call
-func (_e *MockAuthenticatedUser_Expecter) GetUserRole() *MockAuthenticatedUser_GetUserRole_Call {
-	return &MockAuthenticatedUser_GetUserRole_Call{Call: _e.mock.On("GetUserRole")}
+// FindDetails is a helper method to define mock.On call
+//   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.ReceptionID
+func (_e *MockReceptionsInterface_Expecter) FindDetails(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_FindDetails_Call {
+	return &MockReceptionsInterface_FindDetails_Call{Call: _e.mock.On("FindDetails", context1, authenticatedUser, v)}
 }
 
-func (_c *MockAuthenticatedUser_GetUserRole_Call) Run(run func()) *MockAuthenticatedUser_GetUserRole_Call {
+func (_c *MockReceptionsInterface_FindDetails_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ReceptionID)) *MockReceptionsInterface_FindDetails_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.ReceptionID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
 	})
 	return _c
 }
 
-func (_c *MockAuthenticatedUser_GetUserRole_Call) Return(userRole domain.UserRole) *MockAuthenticatedUser_GetUserRole_Call {
-	_c.Call.Return(userRole)
+func (_c *MockReceptionsInterface_FindDetails_Call) Return(receptionDetails domain.ReceptionDetails, err error) *MockReceptionsInterface_FindDetails_Call {
+	_c.Call.Return(receptionDetails, err)
 	return _c
 }
 
-func (_c *MockAuthenticatedUser_GetUserRole_Call) RunAndReturn(run func() domain.UserRole) *MockAuthenticatedUser_GetUserRole_Call {
+func (_c *MockReceptionsInterface_FindDetails_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ReceptionID) (domain.ReceptionDetails, error)) *MockReceptionsInterface_FindDetails_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockUsersInterface creates a new instance of MockUsersInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockUsersInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockUsersInterface {
-	mock := &MockUsersInterface{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockUsersInterface is an autogenerated mock type for the UsersInterface type
-type MockUsersInterface struct {
-	mock.Mock
-}
-
-type MockUsersInterface_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockUsersInterface) EXPECT() *MockUsersInterface_Expecter {
-	return &MockUsersInterface_Expecter{mock: &_m.Mock}
-}
-
-// Create provides a mock function for the type MockUsersInterface
-func (_mock *MockUsersInterface) Create(context1 context.Context, s string, s1 string, userRole domain.UserRole) (domain.User, error) {
-	ret := _mock.Called(context1, s, s1, userRole)
+// FindProductsByBarcode provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) FindProductsByBarcode(context1 context.Context, authenticatedUser domain.AuthenticatedUser, s string) ([]domain.ProductLocation, error) {
+	ret := _mock.Called(context1, authenticatedUser, s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindProductsByBarcode")
 	}
 
-	var r0 domain.User
+	var r0 []domain.ProductLocation
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.UserRole) (domain.User, error)); ok {
-		return returnFunc(context1, s, s1, userRole)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, string) ([]domain.ProductLocation, error)); ok {
+		return returnFunc(context1, authenticatedUser, s)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.UserRole) domain.User); ok {
-		r0 = returnFunc(context1, s, s1, userRole)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, string) []domain.ProductLocation); ok {
+		r0 = returnFunc(context1, authenticatedUser, s)
 	} else {
-		r0 = ret.Get(0).(domain.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductLocation)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.UserRole) error); ok {
-		r1 = returnFunc(context1, s, s1, userRole)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, string) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, s)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUsersInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockUsersInterface_Create_Call struct {
+// MockReceptionsInterface_FindProductsByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProductsByBarcode'
+type MockReceptionsInterface_FindProductsByBarcode_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// FindProductsByBarcode is a helper method to define mock.On call
 //   - context1 context.Context
+//   - authenticatedUser domain.AuthenticatedUser
 //   - s string
-//   - s1 string
-//   - userRole domain.UserRole
-func (_e *MockUsersInterface_Expecter) Create(context1 interface{}, s interface{}, s1 interface{}, userRole interface{}) *MockUsersInterface_Create_Call {
-	return &MockUsersInterface_Create_Call{Call: _e.mock.On("Create", context1, s, s1, userRole)}
+func (_e *MockReceptionsInterface_Expecter) FindProductsByBarcode(context1 interface{}, authenticatedUser interface{}, s interface{}) *MockReceptionsInterface_FindProductsByBarcode_Call {
+	return &MockReceptionsInterface_FindProductsByBarcode_Call{Call: _e.mock.On("FindProductsByBarcode", context1, authenticatedUser, s)}
 }
 
-func (_c *MockUsersInterface_Create_Call) Run(run func(context1 context.Context, s string, s1 string, userRole domain.UserRole)) *MockUsersInterface_Create_Call {
+func (_c *MockReceptionsInterface_FindProductsByBarcode_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, s string)) *MockReceptionsInterface_FindProductsByBarcode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.AuthenticatedUser
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.AuthenticatedUser)
 		}
 		var arg2 string
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 domain.UserRole
-		if args[3] != nil {
-			arg3 = args[3].(domain.UserRole)
-		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUsersInterface_Create_Call) Return(user domain.User, err error) *MockUsersInterface_Create_Call {
-	_c.Call.Return(user, err)
+func (_c *MockReceptionsInterface_FindProductsByBarcode_Call) Return(productLocations []domain.ProductLocation, err error) *MockReceptionsInterface_FindProductsByBarcode_Call {
+	_c.Call.Return(productLocations, err)
 	return _c
 }
 
-func (_c *MockUsersInterface_Create_Call) RunAndReturn(run func(context1 context.Context, s string, s1 string, userRole domain.UserRole) (domain.User, error)) *MockUsersInterface_Create_Call {
+func (_c *MockReceptionsInterface_FindProductsByBarcode_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, s string) ([]domain.ProductLocation, error)) *MockReceptionsInterface_FindProductsByBarcode_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTokenByEmailAndPassword provides a mock function for the type MockUsersInterface
-func (_mock *MockUsersInterface) FindTokenByEmailAndPassword(context1 context.Context, s string, s1 string) (string, error) {
-	ret := _mock.Called(context1, s, s1)
+// FindStorageLayout provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) FindStorageLayout(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.StorageLayout, error) {
+	ret := _mock.Called(context1, authenticatedUser, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTokenByEmailAndPassword")
+		panic("no return value specified for FindStorageLayout")
 	}
 
-	var r0 string
+	var r0 domain.StorageLayout
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
-		return returnFunc(context1, s, s1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) (domain.StorageLayout, error)); ok {
+		return returnFunc(context1, authenticatedUser, v)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = returnFunc(context1, s, s1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) domain.StorageLayout); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(domain.StorageLayout)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(context1, s, s1)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUsersInterface_FindTokenByEmailAndPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTokenByEmailAndPassword'
-type MockUsersInterface_FindTokenByEmailAndPassword_Call struct {
+// MockReceptionsInterface_FindStorageLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStorageLayout'
+type MockReceptionsInterface_FindStorageLayout_Call struct {
 	*mock.Call
 }
 
-// FindTokenByEmailAndPassword is a helper method to define mock.On call
+// FindStorageLayout is a helper method to define mock.On call
 //   - context1 context.Context
-//   - s string
-//   - s1 string
-func (_e *MockUsersInterface_Expecter) FindTokenByEmailAndPassword(context1 interface{}, s interface{}, s1 interface{}) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
-	return &MockUsersInterface_FindTokenByEmailAndPassword_Call{Call: _e.mock.On("FindTokenByEmailAndPassword", context1, s, s1)}
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.PVZID
+func (_e *MockReceptionsInterface_Expecter) FindStorageLayout(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_FindStorageLayout_Call {
+	return &MockReceptionsInterface_FindStorageLayout_Call{Call: _e.mock.On("FindStorageLayout", context1, authenticatedUser, v)}
 }
 
-func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) Run(run func(context1 context.Context, s string, s1 string)) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
+func (_c *MockReceptionsInterface_FindStorageLayout_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockReceptionsInterface_FindStorageLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.AuthenticatedUser
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 string
+		var arg2 domain.PVZID
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(domain.PVZID)
 		}
 		run(
 			arg0,
@@ -1975,151 +6575,207 @@ func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) Run(run func(cont
 	return _c
 }
 
-func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) Return(s2 string, err error) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
-	_c.Call.Return(s2, err)
+func (_c *MockReceptionsInterface_FindStorageLayout_Call) Return(storageLayout domain.StorageLayout, err error) *MockReceptionsInterface_FindStorageLayout_Call {
+	_c.Call.Return(storageLayout, err)
 	return _c
 }
 
-func (_c *MockUsersInterface_FindTokenByEmailAndPassword_Call) RunAndReturn(run func(context1 context.Context, s string, s1 string) (string, error)) *MockUsersInterface_FindTokenByEmailAndPassword_Call {
+func (_c *MockReceptionsInterface_FindStorageLayout_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.StorageLayout, error)) *MockReceptionsInterface_FindStorageLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LoginByToken provides a mock function for the type MockUsersInterface
-func (_mock *MockUsersInterface) LoginByToken(context1 context.Context, s string) (domain.AuthenticatedUser, error) {
-	ret := _mock.Called(context1, s)
+// IssueProduct provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) IssueProduct(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ProductID) (domain.Product, error) {
+	ret := _mock.Called(context1, authenticatedUser, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LoginByToken")
+		panic("no return value specified for IssueProduct")
 	}
 
-	var r0 domain.AuthenticatedUser
+	var r0 domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.AuthenticatedUser, error)); ok {
-		return returnFunc(context1, s)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductID) (domain.Product, error)); ok {
+		return returnFunc(context1, authenticatedUser, v)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.AuthenticatedUser); ok {
-		r0 = returnFunc(context1, s)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductID) domain.Product); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(domain.AuthenticatedUser)
-		}
+		r0 = ret.Get(0).(domain.Product)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(context1, s)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.ProductID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockUsersInterface_LoginByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginByToken'
-type MockUsersInterface_LoginByToken_Call struct {
+// MockReceptionsInterface_IssueProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueProduct'
+type MockReceptionsInterface_IssueProduct_Call struct {
 	*mock.Call
 }
 
-// LoginByToken is a helper method to define mock.On call
+// IssueProduct is a helper method to define mock.On call
 //   - context1 context.Context
-//   - s string
-func (_e *MockUsersInterface_Expecter) LoginByToken(context1 interface{}, s interface{}) *MockUsersInterface_LoginByToken_Call {
-	return &MockUsersInterface_LoginByToken_Call{Call: _e.mock.On("LoginByToken", context1, s)}
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.ProductID
+func (_e *MockReceptionsInterface_Expecter) IssueProduct(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_IssueProduct_Call {
+	return &MockReceptionsInterface_IssueProduct_Call{Call: _e.mock.On("IssueProduct", context1, authenticatedUser, v)}
 }
 
-func (_c *MockUsersInterface_LoginByToken_Call) Run(run func(context1 context.Context, s string)) *MockUsersInterface_LoginByToken_Call {
+func (_c *MockReceptionsInterface_IssueProduct_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ProductID)) *MockReceptionsInterface_IssueProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 domain.AuthenticatedUser
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductID)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUsersInterface_LoginByToken_Call) Return(authenticatedUser domain.AuthenticatedUser, err error) *MockUsersInterface_LoginByToken_Call {
-	_c.Call.Return(authenticatedUser, err)
+func (_c *MockReceptionsInterface_IssueProduct_Call) Return(product domain.Product, err error) *MockReceptionsInterface_IssueProduct_Call {
+	_c.Call.Return(product, err)
 	return _c
 }
 
-func (_c *MockUsersInterface_LoginByToken_Call) RunAndReturn(run func(context1 context.Context, s string) (domain.AuthenticatedUser, error)) *MockUsersInterface_LoginByToken_Call {
+func (_c *MockReceptionsInterface_IssueProduct_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ProductID) (domain.Product, error)) *MockReceptionsInterface_IssueProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockPVZsInterface creates a new instance of MockPVZsInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockPVZsInterface(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockPVZsInterface {
-	mock := &MockPVZsInterface{}
-	mock.Mock.Test(t)
+// Pickup provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) Pickup(ctx context.Context, authUser domain.AuthenticatedUser, pvzID domain.PVZID, code string) (domain.OrderProducts, error) {
+	ret := _mock.Called(ctx, authUser, pvzID, code)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for Pickup")
+	}
 
-	return mock
+	var r0 domain.OrderProducts
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, string) (domain.OrderProducts, error)); ok {
+		return returnFunc(ctx, authUser, pvzID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, string) domain.OrderProducts); ok {
+		r0 = returnFunc(ctx, authUser, pvzID, code)
+	} else {
+		r0 = ret.Get(0).(domain.OrderProducts)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, string) error); ok {
+		r1 = returnFunc(ctx, authUser, pvzID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockPVZsInterface is an autogenerated mock type for the PVZsInterface type
-type MockPVZsInterface struct {
-	mock.Mock
+// MockReceptionsInterface_Pickup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pickup'
+type MockReceptionsInterface_Pickup_Call struct {
+	*mock.Call
 }
 
-type MockPVZsInterface_Expecter struct {
-	mock *mock.Mock
+// Pickup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - pvzID domain.PVZID
+//   - code string
+func (_e *MockReceptionsInterface_Expecter) Pickup(ctx interface{}, authUser interface{}, pvzID interface{}, code interface{}) *MockReceptionsInterface_Pickup_Call {
+	return &MockReceptionsInterface_Pickup_Call{Call: _e.mock.On("Pickup", ctx, authUser, pvzID, code)}
 }
 
-func (_m *MockPVZsInterface) EXPECT() *MockPVZsInterface_Expecter {
-	return &MockPVZsInterface_Expecter{mock: &_m.Mock}
+func (_c *MockReceptionsInterface_Pickup_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, pvzID domain.PVZID, code string)) *MockReceptionsInterface_Pickup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.PVZID
+		if args[2] != nil {
+			arg2 = args[2].(domain.PVZID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
 }
 
-// Create provides a mock function for the type MockPVZsInterface
-func (_mock *MockPVZsInterface) Create(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZCity domain.PVZCity) (domain.PVZ, error) {
-	ret := _mock.Called(context1, authenticatedUser, pVZCity)
+func (_c *MockReceptionsInterface_Pickup_Call) Return(orderProducts domain.OrderProducts, err error) *MockReceptionsInterface_Pickup_Call {
+	_c.Call.Return(orderProducts, err)
+	return _c
+}
+
+func (_c *MockReceptionsInterface_Pickup_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, pvzID domain.PVZID, code string) (domain.OrderProducts, error)) *MockReceptionsInterface_Pickup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reopen provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) Reopen(ctx context.Context, authUser domain.AuthenticatedUser, receptionID domain.ReceptionID, reason string) (domain.Reception, error) {
+	ret := _mock.Called(ctx, authUser, receptionID, reason)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Reopen")
 	}
 
-	var r0 domain.PVZ
+	var r0 domain.Reception
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZCity) (domain.PVZ, error)); ok {
-		return returnFunc(context1, authenticatedUser, pVZCity)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ReceptionID, string) (domain.Reception, error)); ok {
+		return returnFunc(ctx, authUser, receptionID, reason)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZCity) domain.PVZ); ok {
-		r0 = returnFunc(context1, authenticatedUser, pVZCity)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ReceptionID, string) domain.Reception); ok {
+		r0 = returnFunc(ctx, authUser, receptionID, reason)
 	} else {
-		r0 = ret.Get(0).(domain.PVZ)
+		r0 = ret.Get(0).(domain.Reception)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZCity) error); ok {
-		r1 = returnFunc(context1, authenticatedUser, pVZCity)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.ReceptionID, string) error); ok {
+		r1 = returnFunc(ctx, authUser, receptionID, reason)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZsInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockPVZsInterface_Create_Call struct {
+// MockReceptionsInterface_Reopen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reopen'
+type MockReceptionsInterface_Reopen_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - context1 context.Context
-//   - authenticatedUser domain.AuthenticatedUser
-//   - pVZCity domain.PVZCity
-func (_e *MockPVZsInterface_Expecter) Create(context1 interface{}, authenticatedUser interface{}, pVZCity interface{}) *MockPVZsInterface_Create_Call {
-	return &MockPVZsInterface_Create_Call{Call: _e.mock.On("Create", context1, authenticatedUser, pVZCity)}
+// Reopen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - receptionID domain.ReceptionID
+//   - reason string
+func (_e *MockReceptionsInterface_Expecter) Reopen(ctx interface{}, authUser interface{}, receptionID interface{}, reason interface{}) *MockReceptionsInterface_Reopen_Call {
+	return &MockReceptionsInterface_Reopen_Call{Call: _e.mock.On("Reopen", ctx, authUser, receptionID, reason)}
 }
 
-func (_c *MockPVZsInterface_Create_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZCity domain.PVZCity)) *MockPVZsInterface_Create_Call {
+func (_c *MockReceptionsInterface_Reopen_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, receptionID domain.ReceptionID, reason string)) *MockReceptionsInterface_Reopen_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2129,136 +6785,146 @@ func (_c *MockPVZsInterface_Create_Call) Run(run func(context1 context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 domain.PVZCity
+		var arg2 domain.ReceptionID
 		if args[2] != nil {
-			arg2 = args[2].(domain.PVZCity)
+			arg2 = args[2].(domain.ReceptionID)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPVZsInterface_Create_Call) Return(pVZ domain.PVZ, err error) *MockPVZsInterface_Create_Call {
-	_c.Call.Return(pVZ, err)
+func (_c *MockReceptionsInterface_Reopen_Call) Return(reception domain.Reception, err error) *MockReceptionsInterface_Reopen_Call {
+	_c.Call.Return(reception, err)
 	return _c
 }
 
-func (_c *MockPVZsInterface_Create_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, pVZCity domain.PVZCity) (domain.PVZ, error)) *MockPVZsInterface_Create_Call {
+func (_c *MockReceptionsInterface_Reopen_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, receptionID domain.ReceptionID, reason string) (domain.Reception, error)) *MockReceptionsInterface_Reopen_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAll provides a mock function for the type MockPVZsInterface
-func (_mock *MockPVZsInterface) FindAll(context1 context.Context) ([]domain.PVZ, error) {
-	ret := _mock.Called(context1)
+// ReturnProduct provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) ReturnProduct(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ProductID) (domain.Product, error) {
+	ret := _mock.Called(context1, authenticatedUser, v)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindAll")
+		panic("no return value specified for ReturnProduct")
 	}
 
-	var r0 []domain.PVZ
+	var r0 domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.PVZ, error)); ok {
-		return returnFunc(context1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductID) (domain.Product, error)); ok {
+		return returnFunc(context1, authenticatedUser, v)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.PVZ); ok {
-		r0 = returnFunc(context1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductID) domain.Product); ok {
+		r0 = returnFunc(context1, authenticatedUser, v)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZ)
-		}
+		r0 = ret.Get(0).(domain.Product)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(context1)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.ProductID) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, v)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZsInterface_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
-type MockPVZsInterface_FindAll_Call struct {
+// MockReceptionsInterface_ReturnProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReturnProduct'
+type MockReceptionsInterface_ReturnProduct_Call struct {
 	*mock.Call
 }
 
-// FindAll is a helper method to define mock.On call
+// ReturnProduct is a helper method to define mock.On call
 //   - context1 context.Context
-func (_e *MockPVZsInterface_Expecter) FindAll(context1 interface{}) *MockPVZsInterface_FindAll_Call {
-	return &MockPVZsInterface_FindAll_Call{Call: _e.mock.On("FindAll", context1)}
+//   - authenticatedUser domain.AuthenticatedUser
+//   - v domain.ProductID
+func (_e *MockReceptionsInterface_Expecter) ReturnProduct(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_ReturnProduct_Call {
+	return &MockReceptionsInterface_ReturnProduct_Call{Call: _e.mock.On("ReturnProduct", context1, authenticatedUser, v)}
 }
 
-func (_c *MockPVZsInterface_FindAll_Call) Run(run func(context1 context.Context)) *MockPVZsInterface_FindAll_Call {
+func (_c *MockReceptionsInterface_ReturnProduct_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ProductID)) *MockReceptionsInterface_ReturnProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 domain.ProductID
+		if args[2] != nil {
+			arg2 = args[2].(domain.ProductID)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPVZsInterface_FindAll_Call) Return(pVZs []domain.PVZ, err error) *MockPVZsInterface_FindAll_Call {
-	_c.Call.Return(pVZs, err)
+func (_c *MockReceptionsInterface_ReturnProduct_Call) Return(product domain.Product, err error) *MockReceptionsInterface_ReturnProduct_Call {
+	_c.Call.Return(product, err)
 	return _c
 }
 
-func (_c *MockPVZsInterface_FindAll_Call) RunAndReturn(run func(context1 context.Context) ([]domain.PVZ, error)) *MockPVZsInterface_FindAll_Call {
+func (_c *MockReceptionsInterface_ReturnProduct_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.ProductID) (domain.Product, error)) *MockReceptionsInterface_ReturnProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindPVZReceptionProducts provides a mock function for the type MockPVZsInterface
-func (_mock *MockPVZsInterface) FindPVZReceptionProducts(context1 context.Context, authenticatedUser domain.AuthenticatedUser, time1 *time.Time, time11 *time.Time, n *int, n1 *int) ([]domain.PVZReceptionsProducts, error) {
-	ret := _mock.Called(context1, authenticatedUser, time1, time11, n, n1)
+// SaveStorageLayout provides a mock function for the type MockReceptionsInterface
+func (_mock *MockReceptionsInterface) SaveStorageLayout(context1 context.Context, authenticatedUser domain.AuthenticatedUser, storageLayout domain.StorageLayout) (domain.StorageLayout, error) {
+	ret := _mock.Called(context1, authenticatedUser, storageLayout)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindPVZReceptionProducts")
+		panic("no return value specified for SaveStorageLayout")
 	}
 
-	var r0 []domain.PVZReceptionsProducts
+	var r0 domain.StorageLayout
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, *time.Time, *time.Time, *int, *int) ([]domain.PVZReceptionsProducts, error)); ok {
-		return returnFunc(context1, authenticatedUser, time1, time11, n, n1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.StorageLayout) (domain.StorageLayout, error)); ok {
+		return returnFunc(context1, authenticatedUser, storageLayout)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, *time.Time, *time.Time, *int, *int) []domain.PVZReceptionsProducts); ok {
-		r0 = returnFunc(context1, authenticatedUser, time1, time11, n, n1)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.StorageLayout) domain.StorageLayout); ok {
+		r0 = returnFunc(context1, authenticatedUser, storageLayout)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.PVZReceptionsProducts)
-		}
+		r0 = ret.Get(0).(domain.StorageLayout)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, *time.Time, *time.Time, *int, *int) error); ok {
-		r1 = returnFunc(context1, authenticatedUser, time1, time11, n, n1)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.StorageLayout) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, storageLayout)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockPVZsInterface_FindPVZReceptionProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPVZReceptionProducts'
-type MockPVZsInterface_FindPVZReceptionProducts_Call struct {
+// MockReceptionsInterface_SaveStorageLayout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveStorageLayout'
+type MockReceptionsInterface_SaveStorageLayout_Call struct {
 	*mock.Call
 }
 
-// FindPVZReceptionProducts is a helper method to define mock.On call
+// SaveStorageLayout is a helper method to define mock.On call
 //   - context1 context.Context
 //   - authenticatedUser domain.AuthenticatedUser
-//   - time1 *time.Time
-//   - time11 *time.Time
-//   - n *int
-//   - n1 *int
-func (_e *MockPVZsInterface_Expecter) FindPVZReceptionProducts(context1 interface{}, authenticatedUser interface{}, time1 interface{}, time11 interface{}, n interface{}, n1 interface{}) *MockPVZsInterface_FindPVZReceptionProducts_Call {
-	return &MockPVZsInterface_FindPVZReceptionProducts_Call{Call: _e.mock.On("FindPVZReceptionProducts", context1, authenticatedUser, time1, time11, n, n1)}
+//   - storageLayout domain.StorageLayout
+func (_e *MockReceptionsInterface_Expecter) SaveStorageLayout(context1 interface{}, authenticatedUser interface{}, storageLayout interface{}) *MockReceptionsInterface_SaveStorageLayout_Call {
+	return &MockReceptionsInterface_SaveStorageLayout_Call{Call: _e.mock.On("SaveStorageLayout", context1, authenticatedUser, storageLayout)}
 }
 
-func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, time1 *time.Time, time11 *time.Time, n *int, n1 *int)) *MockPVZsInterface_FindPVZReceptionProducts_Call {
+func (_c *MockReceptionsInterface_SaveStorageLayout_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, storageLayout domain.StorageLayout)) *MockReceptionsInterface_SaveStorageLayout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2268,51 +6934,36 @@ func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) Run(run func(context1
 		if args[1] != nil {
 			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 *time.Time
+		var arg2 domain.StorageLayout
 		if args[2] != nil {
-			arg2 = args[2].(*time.Time)
-		}
-		var arg3 *time.Time
-		if args[3] != nil {
-			arg3 = args[3].(*time.Time)
-		}
-		var arg4 *int
-		if args[4] != nil {
-			arg4 = args[4].(*int)
-		}
-		var arg5 *int
-		if args[5] != nil {
-			arg5 = args[5].(*int)
+			arg2 = args[2].(domain.StorageLayout)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
-			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) Return(pVZReceptionsProductss []domain.PVZReceptionsProducts, err error) *MockPVZsInterface_FindPVZReceptionProducts_Call {
-	_c.Call.Return(pVZReceptionsProductss, err)
+func (_c *MockReceptionsInterface_SaveStorageLayout_Call) Return(storageLayout1 domain.StorageLayout, err error) *MockReceptionsInterface_SaveStorageLayout_Call {
+	_c.Call.Return(storageLayout1, err)
 	return _c
 }
 
-func (_c *MockPVZsInterface_FindPVZReceptionProducts_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, time1 *time.Time, time11 *time.Time, n *int, n1 *int) ([]domain.PVZReceptionsProducts, error)) *MockPVZsInterface_FindPVZReceptionProducts_Call {
+func (_c *MockReceptionsInterface_SaveStorageLayout_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, storageLayout domain.StorageLayout) (domain.StorageLayout, error)) *MockReceptionsInterface_SaveStorageLayout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockReceptionsInterface creates a new instance of MockReceptionsInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockProductTypesInterface creates a new instance of MockProductTypesInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockReceptionsInterface(t interface {
+func NewMockProductTypesInterface(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockReceptionsInterface {
-	mock := &MockReceptionsInterface{}
+}) *MockProductTypesInterface {
+	mock := &MockProductTypesInterface{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -2320,59 +6971,59 @@ func NewMockReceptionsInterface(t interface {
 	return mock
 }
 
-// MockReceptionsInterface is an autogenerated mock type for the ReceptionsInterface type
-type MockReceptionsInterface struct {
+// MockProductTypesInterface is an autogenerated mock type for the ProductTypesInterface type
+type MockProductTypesInterface struct {
 	mock.Mock
 }
 
-type MockReceptionsInterface_Expecter struct {
+type MockProductTypesInterface_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockReceptionsInterface) EXPECT() *MockReceptionsInterface_Expecter {
-	return &MockReceptionsInterface_Expecter{mock: &_m.Mock}
+func (_m *MockProductTypesInterface) EXPECT() *MockProductTypesInterface_Expecter {
+	return &MockProductTypesInterface_Expecter{mock: &_m.Mock}
 }
 
-// Close provides a mock function for the type MockReceptionsInterface
-func (_mock *MockReceptionsInterface) Close(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.Reception, error) {
-	ret := _mock.Called(context1, authenticatedUser, v)
+// Create provides a mock function for the type MockProductTypesInterface
+func (_mock *MockProductTypesInterface) Create(context1 context.Context, authenticatedUser domain.AuthenticatedUser, productTypeEntry domain.ProductTypeEntry) (domain.ProductTypeEntry, error) {
+	ret := _mock.Called(context1, authenticatedUser, productTypeEntry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for Create")
 	}
 
-	var r0 domain.Reception
+	var r0 domain.ProductTypeEntry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) (domain.Reception, error)); ok {
-		return returnFunc(context1, authenticatedUser, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductTypeEntry) (domain.ProductTypeEntry, error)); ok {
+		return returnFunc(context1, authenticatedUser, productTypeEntry)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) domain.Reception); ok {
-		r0 = returnFunc(context1, authenticatedUser, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductTypeEntry) domain.ProductTypeEntry); ok {
+		r0 = returnFunc(context1, authenticatedUser, productTypeEntry)
 	} else {
-		r0 = ret.Get(0).(domain.Reception)
+		r0 = ret.Get(0).(domain.ProductTypeEntry)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
-		r1 = returnFunc(context1, authenticatedUser, v)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.ProductTypeEntry) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, productTypeEntry)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionsInterface_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockReceptionsInterface_Close_Call struct {
+// MockProductTypesInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProductTypesInterface_Create_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
+// Create is a helper method to define mock.On call
 //   - context1 context.Context
 //   - authenticatedUser domain.AuthenticatedUser
-//   - v domain.PVZID
-func (_e *MockReceptionsInterface_Expecter) Close(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_Close_Call {
-	return &MockReceptionsInterface_Close_Call{Call: _e.mock.On("Close", context1, authenticatedUser, v)}
+//   - productTypeEntry domain.ProductTypeEntry
+func (_e *MockProductTypesInterface_Expecter) Create(context1 interface{}, authenticatedUser interface{}, productTypeEntry interface{}) *MockProductTypesInterface_Create_Call {
+	return &MockProductTypesInterface_Create_Call{Call: _e.mock.On("Create", context1, authenticatedUser, productTypeEntry)}
 }
 
-func (_c *MockReceptionsInterface_Close_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockReceptionsInterface_Close_Call {
+func (_c *MockProductTypesInterface_Create_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, productTypeEntry domain.ProductTypeEntry)) *MockProductTypesInterface_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2382,9 +7033,9 @@ func (_c *MockReceptionsInterface_Close_Call) Run(run func(context1 context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 domain.PVZID
+		var arg2 domain.ProductTypeEntry
 		if args[2] != nil {
-			arg2 = args[2].(domain.PVZID)
+			arg2 = args[2].(domain.ProductTypeEntry)
 		}
 		run(
 			arg0,
@@ -2395,56 +7046,58 @@ func (_c *MockReceptionsInterface_Close_Call) Run(run func(context1 context.Cont
 	return _c
 }
 
-func (_c *MockReceptionsInterface_Close_Call) Return(reception domain.Reception, err error) *MockReceptionsInterface_Close_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockProductTypesInterface_Create_Call) Return(productTypeEntry1 domain.ProductTypeEntry, err error) *MockProductTypesInterface_Create_Call {
+	_c.Call.Return(productTypeEntry1, err)
 	return _c
 }
 
-func (_c *MockReceptionsInterface_Close_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.Reception, error)) *MockReceptionsInterface_Close_Call {
+func (_c *MockProductTypesInterface_Create_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, productTypeEntry domain.ProductTypeEntry) (domain.ProductTypeEntry, error)) *MockProductTypesInterface_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function for the type MockReceptionsInterface
-func (_mock *MockReceptionsInterface) Create(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.Reception, error) {
-	ret := _mock.Called(context1, authenticatedUser, v)
+// FindAll provides a mock function for the type MockProductTypesInterface
+func (_mock *MockProductTypesInterface) FindAll(ctx context.Context, authUser domain.AuthenticatedUser, includeInactive bool) ([]domain.ProductTypeEntry, error) {
+	ret := _mock.Called(ctx, authUser, includeInactive)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for FindAll")
 	}
 
-	var r0 domain.Reception
+	var r0 []domain.ProductTypeEntry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) (domain.Reception, error)); ok {
-		return returnFunc(context1, authenticatedUser, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, bool) ([]domain.ProductTypeEntry, error)); ok {
+		return returnFunc(ctx, authUser, includeInactive)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) domain.Reception); ok {
-		r0 = returnFunc(context1, authenticatedUser, v)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, bool) []domain.ProductTypeEntry); ok {
+		r0 = returnFunc(ctx, authUser, includeInactive)
 	} else {
-		r0 = ret.Get(0).(domain.Reception)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTypeEntry)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
-		r1 = returnFunc(context1, authenticatedUser, v)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, bool) error); ok {
+		r1 = returnFunc(ctx, authUser, includeInactive)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionsInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockReceptionsInterface_Create_Call struct {
+// MockProductTypesInterface_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockProductTypesInterface_FindAll_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - context1 context.Context
-//   - authenticatedUser domain.AuthenticatedUser
-//   - v domain.PVZID
-func (_e *MockReceptionsInterface_Expecter) Create(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_Create_Call {
-	return &MockReceptionsInterface_Create_Call{Call: _e.mock.On("Create", context1, authenticatedUser, v)}
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - includeInactive bool
+func (_e *MockProductTypesInterface_Expecter) FindAll(ctx interface{}, authUser interface{}, includeInactive interface{}) *MockProductTypesInterface_FindAll_Call {
+	return &MockProductTypesInterface_FindAll_Call{Call: _e.mock.On("FindAll", ctx, authUser, includeInactive)}
 }
 
-func (_c *MockReceptionsInterface_Create_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockReceptionsInterface_Create_Call {
+func (_c *MockProductTypesInterface_FindAll_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, includeInactive bool)) *MockProductTypesInterface_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2454,9 +7107,9 @@ func (_c *MockReceptionsInterface_Create_Call) Run(run func(context1 context.Con
 		if args[1] != nil {
 			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 domain.PVZID
+		var arg2 bool
 		if args[2] != nil {
-			arg2 = args[2].(domain.PVZID)
+			arg2 = args[2].(bool)
 		}
 		run(
 			arg0,
@@ -2467,57 +7120,56 @@ func (_c *MockReceptionsInterface_Create_Call) Run(run func(context1 context.Con
 	return _c
 }
 
-func (_c *MockReceptionsInterface_Create_Call) Return(reception domain.Reception, err error) *MockReceptionsInterface_Create_Call {
-	_c.Call.Return(reception, err)
+func (_c *MockProductTypesInterface_FindAll_Call) Return(productTypeEntrys []domain.ProductTypeEntry, err error) *MockProductTypesInterface_FindAll_Call {
+	_c.Call.Return(productTypeEntrys, err)
 	return _c
 }
 
-func (_c *MockReceptionsInterface_Create_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) (domain.Reception, error)) *MockReceptionsInterface_Create_Call {
+func (_c *MockProductTypesInterface_FindAll_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, includeInactive bool) ([]domain.ProductTypeEntry, error)) *MockProductTypesInterface_FindAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateProduct provides a mock function for the type MockReceptionsInterface
-func (_mock *MockReceptionsInterface) CreateProduct(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productType domain.ProductType) (domain.Product, error) {
-	ret := _mock.Called(context1, authenticatedUser, v, productType)
+// Update provides a mock function for the type MockProductTypesInterface
+func (_mock *MockProductTypesInterface) Update(context1 context.Context, authenticatedUser domain.AuthenticatedUser, productTypeEntry domain.ProductTypeEntry) (domain.ProductTypeEntry, error) {
+	ret := _mock.Called(context1, authenticatedUser, productTypeEntry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateProduct")
+		panic("no return value specified for Update")
 	}
 
-	var r0 domain.Product
+	var r0 domain.ProductTypeEntry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ProductType) (domain.Product, error)); ok {
-		return returnFunc(context1, authenticatedUser, v, productType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductTypeEntry) (domain.ProductTypeEntry, error)); ok {
+		return returnFunc(context1, authenticatedUser, productTypeEntry)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ProductType) domain.Product); ok {
-		r0 = returnFunc(context1, authenticatedUser, v, productType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.ProductTypeEntry) domain.ProductTypeEntry); ok {
+		r0 = returnFunc(context1, authenticatedUser, productTypeEntry)
 	} else {
-		r0 = ret.Get(0).(domain.Product)
+		r0 = ret.Get(0).(domain.ProductTypeEntry)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.PVZID, domain.ProductType) error); ok {
-		r1 = returnFunc(context1, authenticatedUser, v, productType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, domain.ProductTypeEntry) error); ok {
+		r1 = returnFunc(context1, authenticatedUser, productTypeEntry)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockReceptionsInterface_CreateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProduct'
-type MockReceptionsInterface_CreateProduct_Call struct {
+// MockProductTypesInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProductTypesInterface_Update_Call struct {
 	*mock.Call
 }
 
-// CreateProduct is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
 //   - context1 context.Context
 //   - authenticatedUser domain.AuthenticatedUser
-//   - v domain.PVZID
-//   - productType domain.ProductType
-func (_e *MockReceptionsInterface_Expecter) CreateProduct(context1 interface{}, authenticatedUser interface{}, v interface{}, productType interface{}) *MockReceptionsInterface_CreateProduct_Call {
-	return &MockReceptionsInterface_CreateProduct_Call{Call: _e.mock.On("CreateProduct", context1, authenticatedUser, v, productType)}
+//   - productTypeEntry domain.ProductTypeEntry
+func (_e *MockProductTypesInterface_Expecter) Update(context1 interface{}, authenticatedUser interface{}, productTypeEntry interface{}) *MockProductTypesInterface_Update_Call {
+	return &MockProductTypesInterface_Update_Call{Call: _e.mock.On("Update", context1, authenticatedUser, productTypeEntry)}
 }
 
-func (_c *MockReceptionsInterface_CreateProduct_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productType domain.ProductType)) *MockReceptionsInterface_CreateProduct_Call {
+func (_c *MockProductTypesInterface_Update_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, productTypeEntry domain.ProductTypeEntry)) *MockProductTypesInterface_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2527,13 +7179,113 @@ func (_c *MockReceptionsInterface_CreateProduct_Call) Run(run func(context1 cont
 		if args[1] != nil {
 			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 domain.PVZID
+		var arg2 domain.ProductTypeEntry
 		if args[2] != nil {
-			arg2 = args[2].(domain.PVZID)
+			arg2 = args[2].(domain.ProductTypeEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductTypesInterface_Update_Call) Return(productTypeEntry1 domain.ProductTypeEntry, err error) *MockProductTypesInterface_Update_Call {
+	_c.Call.Return(productTypeEntry1, err)
+	return _c
+}
+
+func (_c *MockProductTypesInterface_Update_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, productTypeEntry domain.ProductTypeEntry) (domain.ProductTypeEntry, error)) *MockProductTypesInterface_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAnalyticsInterface creates a new instance of MockAnalyticsInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAnalyticsInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAnalyticsInterface {
+	mock := &MockAnalyticsInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAnalyticsInterface is an autogenerated mock type for the AnalyticsInterface type
+type MockAnalyticsInterface struct {
+	mock.Mock
+}
+
+type MockAnalyticsInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAnalyticsInterface) EXPECT() *MockAnalyticsInterface_Expecter {
+	return &MockAnalyticsInterface_Expecter{mock: &_m.Mock}
+}
+
+// Cities provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Cities(ctx context.Context, authUser domain.AuthenticatedUser, from time.Time, to time.Time) (domain.CitiesReport, error) {
+	ret := _mock.Called(ctx, authUser, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cities")
+	}
+
+	var r0 domain.CitiesReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, time.Time, time.Time) (domain.CitiesReport, error)); ok {
+		return returnFunc(ctx, authUser, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, time.Time, time.Time) domain.CitiesReport); ok {
+		r0 = returnFunc(ctx, authUser, from, to)
+	} else {
+		r0 = ret.Get(0).(domain.CitiesReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, authUser, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsInterface_Cities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cities'
+type MockAnalyticsInterface_Cities_Call struct {
+	*mock.Call
+}
+
+// Cities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - from time.Time
+//   - to time.Time
+func (_e *MockAnalyticsInterface_Expecter) Cities(ctx interface{}, authUser interface{}, from interface{}, to interface{}) *MockAnalyticsInterface_Cities_Call {
+	return &MockAnalyticsInterface_Cities_Call{Call: _e.mock.On("Cities", ctx, authUser, from, to)}
+}
+
+func (_c *MockAnalyticsInterface_Cities_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, from time.Time, to time.Time)) *MockAnalyticsInterface_Cities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AuthenticatedUser
+		if args[1] != nil {
+			arg1 = args[1].(domain.AuthenticatedUser)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
-		var arg3 domain.ProductType
+		var arg3 time.Time
 		if args[3] != nil {
-			arg3 = args[3].(domain.ProductType)
+			arg3 = args[3].(time.Time)
 		}
 		run(
 			arg0,
@@ -2545,47 +7297,60 @@ func (_c *MockReceptionsInterface_CreateProduct_Call) Run(run func(context1 cont
 	return _c
 }
 
-func (_c *MockReceptionsInterface_CreateProduct_Call) Return(product domain.Product, err error) *MockReceptionsInterface_CreateProduct_Call {
-	_c.Call.Return(product, err)
+func (_c *MockAnalyticsInterface_Cities_Call) Return(citiesReport domain.CitiesReport, err error) *MockAnalyticsInterface_Cities_Call {
+	_c.Call.Return(citiesReport, err)
 	return _c
 }
 
-func (_c *MockReceptionsInterface_CreateProduct_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID, productType domain.ProductType) (domain.Product, error)) *MockReceptionsInterface_CreateProduct_Call {
+func (_c *MockAnalyticsInterface_Cities_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, from time.Time, to time.Time) (domain.CitiesReport, error)) *MockAnalyticsInterface_Cities_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteLastProduct provides a mock function for the type MockReceptionsInterface
-func (_mock *MockReceptionsInterface) DeleteLastProduct(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) error {
-	ret := _mock.Called(context1, authenticatedUser, v)
+// Daily provides a mock function for the type MockAnalyticsInterface
+func (_mock *MockAnalyticsInterface) Daily(ctx context.Context, authUser domain.AuthenticatedUser, pvzID *domain.PVZID, from time.Time, to time.Time) ([]domain.DailyStats, error) {
+	ret := _mock.Called(ctx, authUser, pvzID, from, to)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteLastProduct")
+		panic("no return value specified for Daily")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, domain.PVZID) error); ok {
-		r0 = returnFunc(context1, authenticatedUser, v)
+	var r0 []domain.DailyStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, *domain.PVZID, time.Time, time.Time) ([]domain.DailyStats, error)); ok {
+		return returnFunc(ctx, authUser, pvzID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AuthenticatedUser, *domain.PVZID, time.Time, time.Time) []domain.DailyStats); ok {
+		r0 = returnFunc(ctx, authUser, pvzID, from, to)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DailyStats)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AuthenticatedUser, *domain.PVZID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, authUser, pvzID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockReceptionsInterface_DeleteLastProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLastProduct'
-type MockReceptionsInterface_DeleteLastProduct_Call struct {
+// MockAnalyticsInterface_Daily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Daily'
+type MockAnalyticsInterface_Daily_Call struct {
 	*mock.Call
 }
 
-// DeleteLastProduct is a helper method to define mock.On call
-//   - context1 context.Context
-//   - authenticatedUser domain.AuthenticatedUser
-//   - v domain.PVZID
-func (_e *MockReceptionsInterface_Expecter) DeleteLastProduct(context1 interface{}, authenticatedUser interface{}, v interface{}) *MockReceptionsInterface_DeleteLastProduct_Call {
-	return &MockReceptionsInterface_DeleteLastProduct_Call{Call: _e.mock.On("DeleteLastProduct", context1, authenticatedUser, v)}
+// Daily is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authUser domain.AuthenticatedUser
+//   - pvzID *domain.PVZID
+//   - from time.Time
+//   - to time.Time
+func (_e *MockAnalyticsInterface_Expecter) Daily(ctx interface{}, authUser interface{}, pvzID interface{}, from interface{}, to interface{}) *MockAnalyticsInterface_Daily_Call {
+	return &MockAnalyticsInterface_Daily_Call{Call: _e.mock.On("Daily", ctx, authUser, pvzID, from, to)}
 }
 
-func (_c *MockReceptionsInterface_DeleteLastProduct_Call) Run(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID)) *MockReceptionsInterface_DeleteLastProduct_Call {
+func (_c *MockAnalyticsInterface_Daily_Call) Run(run func(ctx context.Context, authUser domain.AuthenticatedUser, pvzID *domain.PVZID, from time.Time, to time.Time)) *MockAnalyticsInterface_Daily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -2595,25 +7360,35 @@ func (_c *MockReceptionsInterface_DeleteLastProduct_Call) Run(run func(context1
 		if args[1] != nil {
 			arg1 = args[1].(domain.AuthenticatedUser)
 		}
-		var arg2 domain.PVZID
+		var arg2 *domain.PVZID
 		if args[2] != nil {
-			arg2 = args[2].(domain.PVZID)
+			arg2 = args[2].(*domain.PVZID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockReceptionsInterface_DeleteLastProduct_Call) Return(err error) *MockReceptionsInterface_DeleteLastProduct_Call {
-	_c.Call.Return(err)
+func (_c *MockAnalyticsInterface_Daily_Call) Return(dailyStatss []domain.DailyStats, err error) *MockAnalyticsInterface_Daily_Call {
+	_c.Call.Return(dailyStatss, err)
 	return _c
 }
 
-func (_c *MockReceptionsInterface_DeleteLastProduct_Call) RunAndReturn(run func(context1 context.Context, authenticatedUser domain.AuthenticatedUser, v domain.PVZID) error) *MockReceptionsInterface_DeleteLastProduct_Call {
+func (_c *MockAnalyticsInterface_Daily_Call) RunAndReturn(run func(ctx context.Context, authUser domain.AuthenticatedUser, pvzID *domain.PVZID, from time.Time, to time.Time) ([]domain.DailyStats, error)) *MockAnalyticsInterface_Daily_Call {
 	_c.Call.Return(run)
 	return _c
 }
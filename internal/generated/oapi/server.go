@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,24 +28,51 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CellAllocation.
+const (
+	FillFirst   CellAllocation = "fill_first"
+	TypeGrouped CellAllocation = "type_grouped"
+)
+
+// Defines values for CityReportCity.
+const (
+	CityReportCityКазань         CityReportCity = "Казань"
+	CityReportCityМосква         CityReportCity = "Москва"
+	CityReportCityСанктПетербург CityReportCity = "Санкт-Петербург"
+)
+
+// Defines values for ClosedReceptionStatus.
+const (
+	ClosedReceptionStatusClose      ClosedReceptionStatus = "close"
+	ClosedReceptionStatusInProgress ClosedReceptionStatus = "in_progress"
+)
+
+// Defines values for InspectionStatus.
+const (
+	Accepted InspectionStatus = "accepted"
+	Damaged  InspectionStatus = "damaged"
+	Rejected InspectionStatus = "rejected"
+)
+
 // Defines values for PVZCity.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	PVZCityКазань         PVZCity = "Казань"
+	PVZCityМосква         PVZCity = "Москва"
+	PVZCityСанктПетербург PVZCity = "Санкт-Петербург"
 )
 
-// Defines values for ProductType.
+// Defines values for ProductStatus.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	Issued   ProductStatus = "issued"
+	Received ProductStatus = "received"
+	Returned ProductStatus = "returned"
+	Stored   ProductStatus = "stored"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusClose      ReceptionStatus = "close"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
 )
 
 // Defines values for UserRole.
@@ -53,17 +81,155 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv  ExportFormat = "csv"
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// Defines values for PVZListOrder.
+const (
+	PVZListOrderAsc  PVZListOrder = "asc"
+	PVZListOrderDesc PVZListOrder = "desc"
+)
+
+// Defines values for PVZListReceptionStatus.
+const (
+	PVZListReceptionStatusClose      PVZListReceptionStatus = "close"
+	PVZListReceptionStatusInProgress PVZListReceptionStatus = "in_progress"
+)
+
+// Defines values for PVZListSort.
+const (
+	PVZListSortLastReceptionAt PVZListSort = "lastReceptionAt"
+	PVZListSortProductCount    PVZListSort = "productCount"
+	PVZListSortRegisteredAt    PVZListSort = "registeredAt"
+)
+
+// Defines values for PVZListStatus.
+const (
+	PVZListStatusClosed PVZListStatus = "closed"
+	PVZListStatusOpen   PVZListStatus = "open"
+)
+
+// Defines values for ReceptionListStatus.
+const (
+	ReceptionListStatusClose      ReceptionListStatus = "close"
+	ReceptionListStatusInProgress ReceptionListStatus = "in_progress"
+)
+
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
 	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for GetPvzParamsCity.
+const (
+	GetPvzParamsCityКазань         GetPvzParamsCity = "Казань"
+	GetPvzParamsCityМосква         GetPvzParamsCity = "Москва"
+	GetPvzParamsCityСанктПетербург GetPvzParamsCity = "Санкт-Петербург"
+)
+
+// Defines values for GetPvzParamsStatus.
+const (
+	GetPvzParamsStatusClosed GetPvzParamsStatus = "closed"
+	GetPvzParamsStatusOpen   GetPvzParamsStatus = "open"
+)
+
+// Defines values for GetPvzParamsReceptionStatus.
+const (
+	GetPvzParamsReceptionStatusClose      GetPvzParamsReceptionStatus = "close"
+	GetPvzParamsReceptionStatusInProgress GetPvzParamsReceptionStatus = "in_progress"
+)
+
+// Defines values for GetPvzParamsSort.
+const (
+	GetPvzParamsSortLastReceptionAt GetPvzParamsSort = "lastReceptionAt"
+	GetPvzParamsSortProductCount    GetPvzParamsSort = "productCount"
+	GetPvzParamsSortRegisteredAt    GetPvzParamsSort = "registeredAt"
+)
+
+// Defines values for GetPvzParamsOrder.
+const (
+	GetPvzParamsOrderAsc  GetPvzParamsOrder = "asc"
+	GetPvzParamsOrderDesc GetPvzParamsOrder = "desc"
+)
+
+// Defines values for GetPvzExportParamsFormat.
+const (
+	GetPvzExportParamsFormatCsv  GetPvzExportParamsFormat = "csv"
+	GetPvzExportParamsFormatXlsx GetPvzExportParamsFormat = "xlsx"
+)
+
+// Defines values for GetPvzExportParamsCity.
+const (
+	GetPvzExportParamsCityКазань         GetPvzExportParamsCity = "Казань"
+	GetPvzExportParamsCityМосква         GetPvzExportParamsCity = "Москва"
+	GetPvzExportParamsCityСанктПетербург GetPvzExportParamsCity = "Санкт-Петербург"
+)
+
+// Defines values for GetPvzExportParamsStatus.
+const (
+	GetPvzExportParamsStatusClosed GetPvzExportParamsStatus = "closed"
+	GetPvzExportParamsStatusOpen   GetPvzExportParamsStatus = "open"
+)
+
+// Defines values for GetPvzExportParamsReceptionStatus.
+const (
+	GetPvzExportParamsReceptionStatusClose      GetPvzExportParamsReceptionStatus = "close"
+	GetPvzExportParamsReceptionStatusInProgress GetPvzExportParamsReceptionStatus = "in_progress"
+)
+
+// Defines values for GetPvzExportParamsSort.
+const (
+	GetPvzExportParamsSortLastReceptionAt GetPvzExportParamsSort = "lastReceptionAt"
+	GetPvzExportParamsSortProductCount    GetPvzExportParamsSort = "productCount"
+	GetPvzExportParamsSortRegisteredAt    GetPvzExportParamsSort = "registeredAt"
+)
+
+// Defines values for GetPvzExportParamsOrder.
+const (
+	GetPvzExportParamsOrderAsc  GetPvzExportParamsOrder = "asc"
+	GetPvzExportParamsOrderDesc GetPvzExportParamsOrder = "desc"
+)
+
+// Defines values for GetPvzStreamParamsCity.
+const (
+	Казань         GetPvzStreamParamsCity = "Казань"
+	Москва         GetPvzStreamParamsCity = "Москва"
+	СанктПетербург GetPvzStreamParamsCity = "Санкт-Петербург"
+)
+
+// Defines values for GetPvzStreamParamsStatus.
+const (
+	GetPvzStreamParamsStatusClosed GetPvzStreamParamsStatus = "closed"
+	GetPvzStreamParamsStatusOpen   GetPvzStreamParamsStatus = "open"
+)
+
+// Defines values for GetPvzStreamParamsReceptionStatus.
+const (
+	GetPvzStreamParamsReceptionStatusClose      GetPvzStreamParamsReceptionStatus = "close"
+	GetPvzStreamParamsReceptionStatusInProgress GetPvzStreamParamsReceptionStatus = "in_progress"
+)
+
+// Defines values for GetPvzStreamParamsSort.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	GetPvzStreamParamsSortLastReceptionAt GetPvzStreamParamsSort = "lastReceptionAt"
+	GetPvzStreamParamsSortProductCount    GetPvzStreamParamsSort = "productCount"
+	GetPvzStreamParamsSortRegisteredAt    GetPvzStreamParamsSort = "registeredAt"
+)
+
+// Defines values for GetPvzStreamParamsOrder.
+const (
+	GetPvzStreamParamsOrderAsc  GetPvzStreamParamsOrder = "asc"
+	GetPvzStreamParamsOrderDesc GetPvzStreamParamsOrder = "desc"
+)
+
+// Defines values for GetPvzPvzIdReceptionsParamsStatus.
+const (
+	GetPvzPvzIdReceptionsParamsStatusClose      GetPvzPvzIdReceptionsParamsStatus = "close"
+	GetPvzPvzIdReceptionsParamsStatusInProgress GetPvzPvzIdReceptionsParamsStatus = "in_progress"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
@@ -72,43 +238,447 @@ const (
 	Moderator PostRegisterJSONBodyRole = "moderator"
 )
 
+// AddedProduct defines model for AddedProduct.
+type AddedProduct struct {
+	// Barcode Штрихкод или номер заказа на посылке
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Cell Ячейка хранения ПВЗ, в ней лежит не больше одного товара
+	Cell *StorageCell `json:"cell,omitempty"`
+
+	// CreatedBy Пользователь, добавивший товар
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  *time.Time          `json:"dateTime,omitempty"`
+
+	// DeclaredValue Объявленная стоимость в копейках
+	DeclaredValue *int64 `json:"declaredValue,omitempty"`
+
+	// Dimensions Габариты в сантиметрах
+	Dimensions *Dimensions `json:"dimensions,omitempty"`
+
+	// Duplicates Товары с тем же штрихкодом в других незакрытых приемках, обычно посылка доставлена не в тот ПВЗ
+	Duplicates []Product           `json:"duplicates"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+
+	// Inspection Результат осмотра товара при приемке. Для отклоненного товара причина обязательна, для принятого без замечаний ее быть не должно.
+	Inspection  *Inspection        `json:"inspection,omitempty"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Status Статус товара: принят в приемку, хранится после закрытия приемки, выдан покупателю или возвращен отправителю. Отбракованный при осмотре товар возвращается при закрытии приемки
+	Status ProductStatus `json:"status"`
+
+	// Type Код типа товара из каталога
+	Type string `json:"type"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty"`
+}
+
+// BusyPVZ defines model for BusyPVZ.
+type BusyPVZ struct {
+	Products   int                `json:"products"`
+	PvzId      openapi_types.UUID `json:"pvzId"`
+	Receptions int                `json:"receptions"`
+}
+
+// CellAllocation Выбор свободной ячейки для принятого товара: fill_first берет ячейку с наименьшим номером по порядку, type_grouped сначала ячейки типа товара, затем ячейки без типа
+type CellAllocation string
+
+// CitiesReport defines model for CitiesReport.
+type CitiesReport struct {
+	Cities []CityReport       `json:"cities"`
+	Fleet  ReportComparison   `json:"fleet"`
+	From   openapi_types.Date `json:"from"`
+
+	// PreviousFrom Первый день сравниваемого периода
+	PreviousFrom openapi_types.Date `json:"previousFrom"`
+
+	// PreviousTo Последний день сравниваемого периода (включительно)
+	PreviousTo openapi_types.Date `json:"previousTo"`
+	To         openapi_types.Date `json:"to"`
+}
+
+// CityReport defines model for CityReport.
+type CityReport struct {
+	City CityReportCity `json:"city"`
+
+	// Current Приемки, открытые за период по местному времени ПВЗ, и товары в них
+	Current ReportStats `json:"current"`
+
+	// PreviousWeek Сравниваемый период previousFrom - previousTo
+	PreviousWeek ReportStats `json:"previousWeek"`
+}
+
+// CityReportCity defines model for CityReport.City.
+type CityReportCity string
+
+// ClosedReception defines model for ClosedReception.
+type ClosedReception struct {
+	// ClosedBy Пользователь, закрывший приемку
+	ClosedBy *openapi_types.UUID `json:"closedBy,omitempty"`
+
+	// CreatedBy Пользователь, открывший приемку
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  time.Time           `json:"dateTime"`
+
+	// Discrepancy Расхождения принятых товаров с ожидаемыми по типам
+	Discrepancy *DiscrepancyReport    `json:"discrepancy,omitempty"`
+	Id          *openapi_types.UUID   `json:"id,omitempty"`
+	PvzId       openapi_types.UUID    `json:"pvzId"`
+	Status      ClosedReceptionStatus `json:"status"`
+
+	// Summary Сводка по товарам приемки. Итоги веса, объема и стоимости считаются по товарам, для которых они указаны.
+	Summary ProductSummary `json:"summary"`
+}
+
+// ClosedReceptionStatus defines model for ClosedReception.Status.
+type ClosedReceptionStatus string
+
+// DailyStats Приемки и товары за день по местному времени ПВЗ
+type DailyStats struct {
+	// AverageReceptionSeconds Средняя длительность закрытых приемок в секундах
+	AverageReceptionSeconds float32 `json:"averageReceptionSeconds"`
+
+	// ClosedReceptions Сколько из них уже закрыто
+	ClosedReceptions int                `json:"closedReceptions"`
+	Date             openapi_types.Date `json:"date"`
+
+	// Products Количество принятых товаров по типам
+	Products map[string]int `json:"products"`
+
+	// Receptions Количество приемок, открытых за день
+	Receptions int `json:"receptions"`
+}
+
+// DeletedProduct defines model for DeletedProduct.
+type DeletedProduct struct {
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// DeletedBy Пользователь, удаливший товар
+	DeletedBy *openapi_types.UUID `json:"deletedBy,omitempty"`
+	Product   Product             `json:"product"`
+}
+
+// Dimensions Габариты в сантиметрах
+type Dimensions struct {
+	Height int `json:"height"`
+	Length int `json:"length"`
+	Width  int `json:"width"`
+}
+
+// DiscrepancyReport Расхождения принятых товаров с ожидаемыми по типам
+type DiscrepancyReport struct {
+	// Extra Приняты сверх ожидаемого
+	Extra []ManifestItem `json:"extra"`
+
+	// Missing Ожидались, но не приняты
+	Missing []ManifestItem `json:"missing"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// Inspection Результат осмотра товара при приемке. Для отклоненного товара причина обязательна, для принятого без замечаний ее быть не должно.
+type Inspection struct {
+	Reason *string          `json:"reason,omitempty"`
+	Status InspectionStatus `json:"status"`
+}
+
+// InspectionStatus defines model for Inspection.Status.
+type InspectionStatus string
+
+// ManifestItem Количество товаров одного типа
+type ManifestItem struct {
+	Count int `json:"count"`
+
+	// Type Код типа товара из каталога
+	Type string `json:"type"`
+}
+
+// Order Заказ покупателя из товаров, принятых на ПВЗ
+type Order struct {
+	// CreatedBy Сотрудник, создавший заказ
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  time.Time           `json:"dateTime"`
+	Id        openapi_types.UUID  `json:"id"`
+
+	// IssuedAt Время выдачи заказа, нет у ожидающего заказа
+	IssuedAt *time.Time `json:"issuedAt,omitempty"`
+
+	// IssuedBy Сотрудник, выдавший заказ
+	IssuedBy *openapi_types.UUID `json:"issuedBy,omitempty"`
+
+	// PickupCode Код получения, который сообщают покупателю
+	PickupCode string             `json:"pickupCode"`
+	Products   []Product          `json:"products"`
+	PvzId      openapi_types.UUID `json:"pvzId"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+	City PVZCity `json:"city"`
+
+	// ClosesAt Время закрытия ПВЗ по местному времени (HH:MM)
+	ClosesAt *string             `json:"closesAt,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// OpensAt Время открытия ПВЗ по местному времени (HH:MM)
+	OpensAt          *string    `json:"opensAt,omitempty"`
+	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+
+	// TimeZone Часовой пояс ПВЗ в формате IANA
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 // PVZCity defines model for PVZ.City.
 type PVZCity string
 
+// PVZDetails defines model for PVZDetails.
+type PVZDetails struct {
+	ActiveReception *ReceptionProducts `json:"activeReception,omitempty"`
+	Pvz             PVZ                `json:"pvz"`
+	Summary         struct {
+		ProductsByType   map[string]int `json:"productsByType"`
+		ReceptionsClosed int            `json:"receptionsClosed"`
+		ReceptionsTotal  int            `json:"receptionsTotal"`
+	} `json:"summary"`
+}
+
+// PVZFilters Примененные фильтры и сортировка
+type PVZFilters struct {
+	City               *[]string           `json:"city,omitempty"`
+	EndDate            *time.Time          `json:"endDate,omitempty"`
+	EndLocalDate       *openapi_types.Date `json:"endLocalDate,omitempty"`
+	HasActiveReception *bool               `json:"hasActiveReception,omitempty"`
+	Order              string              `json:"order"`
+	ProductStatus      *[]string           `json:"productStatus,omitempty"`
+	ProductType        *[]string           `json:"productType,omitempty"`
+	ReceptionCreatedBy *openapi_types.UUID `json:"receptionCreatedBy,omitempty"`
+	ReceptionStatus    *string             `json:"receptionStatus,omitempty"`
+	Sort               string              `json:"sort"`
+	StartDate          *time.Time          `json:"startDate,omitempty"`
+	StartLocalDate     *openapi_types.Date `json:"startLocalDate,omitempty"`
+	Status             *string             `json:"status,omitempty"`
+}
+
+// PVZPage defines model for PVZPage.
+type PVZPage struct {
+	// Filters Примененные фильтры и сортировка
+	Filters PVZFilters      `json:"filters"`
+	Items   []PVZReceptions `json:"items"`
+	Limit   int             `json:"limit"`
+	Links   struct {
+		// Next Ссылка на следующую страницу, отсутствует если страница последняя
+		Next *string `json:"next,omitempty"`
+	} `json:"links"`
+
+	// Page Номер страницы, отсутствует при переходе по курсору
+	Page *int `json:"page,omitempty"`
+
+	// Total Количество ПВЗ, подходящих под фильтры
+	Total int `json:"total"`
+}
+
+// PVZReceptions defines model for PVZReceptions.
+type PVZReceptions struct {
+	Pvz        PVZ                 `json:"pvz"`
+	Receptions []ReceptionProducts `json:"receptions"`
+}
+
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+	// Barcode Штрихкод или номер заказа на посылке
+	Barcode *string `json:"barcode,omitempty"`
+
+	// CreatedBy Пользователь, добавивший товар
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  *time.Time          `json:"dateTime,omitempty"`
+
+	// DeclaredValue Объявленная стоимость в копейках
+	DeclaredValue *int64 `json:"declaredValue,omitempty"`
+
+	// Dimensions Габариты в сантиметрах
+	Dimensions *Dimensions         `json:"dimensions,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+
+	// Inspection Результат осмотра товара при приемке. Для отклоненного товара причина обязательна, для принятого без замечаний ее быть не должно.
+	Inspection  *Inspection        `json:"inspection,omitempty"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Status Статус товара: принят в приемку, хранится после закрытия приемки, выдан покупателю или возвращен отправителю. Отбракованный при осмотре товар возвращается при закрытии приемки
+	Status ProductStatus `json:"status"`
+
+	// Type Код типа товара из каталога
+	Type string `json:"type"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty"`
+}
+
+// ProductBatch defines model for ProductBatch.
+type ProductBatch struct {
+	// Products Добавленные товары в порядке запроса
+	Products []AddedProduct `json:"products"`
+}
+
+// ProductLocation Товар с приемкой и ПВЗ, в которые он принят
+type ProductLocation struct {
+	Product   Product   `json:"product"`
+	Pvz       PVZ       `json:"pvz"`
+	Reception Reception `json:"reception"`
+}
+
+// ProductStatus Статус товара: принят в приемку, хранится после закрытия приемки, выдан покупателю или возвращен отправителю. Отбракованный при осмотре товар возвращается при закрытии приемки
+type ProductStatus string
+
+// ProductSummary Сводка по товарам приемки. Итоги веса, объема и стоимости считаются по товарам, для которых они указаны.
+type ProductSummary struct {
+	Accepted int `json:"accepted"`
+	Damaged  int `json:"damaged"`
+
+	// DeclaredValue Общая объявленная стоимость в копейках
+	DeclaredValue int64 `json:"declaredValue"`
+	Products      int   `json:"products"`
+	Rejected      int   `json:"rejected"`
+
+	// Uninspected Товары без результата осмотра
+	Uninspected int `json:"uninspected"`
+
+	// Volume Общий объем в кубических сантиметрах
+	Volume int64 `json:"volume"`
+
+	// Weight Общий вес в граммах
+	Weight int64 `json:"weight"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductTypeEntry Тип товара из каталога. Неактивные типы остаются у принятых товаров, но новые товары с ними добавить нельзя.
+type ProductTypeEntry struct {
+	Active bool   `json:"active"`
+	Code   string `json:"code"`
+
+	// Names Названия по двухбуквенным кодам языков
+	Names map[string]string `json:"names"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
-	DateTime time.Time           `json:"dateTime"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	PvzId    openapi_types.UUID  `json:"pvzId"`
-	Status   ReceptionStatus     `json:"status"`
+	// ClosedBy Пользователь, закрывший приемку
+	ClosedBy *openapi_types.UUID `json:"closedBy,omitempty"`
+
+	// CreatedBy Пользователь, открывший приемку
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  time.Time           `json:"dateTime"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	PvzId     openapi_types.UUID  `json:"pvzId"`
+	Status    ReceptionStatus     `json:"status"`
 }
 
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionDetails defines model for ReceptionDetails.
+type ReceptionDetails struct {
+	// DeletedProducts Удаленные товары в порядке удаления
+	DeletedProducts []DeletedProduct `json:"deletedProducts"`
+
+	// Products Товары в порядке добавления
+	Products  []Product `json:"products"`
+	Pvz       PVZ       `json:"pvz"`
+	Reception Reception `json:"reception"`
+
+	// Timeline Когда и кем приемка была открыта и закрыта
+	Timeline ReceptionTimeline `json:"timeline"`
+}
+
+// ReceptionPage defines model for ReceptionPage.
+type ReceptionPage struct {
+	Items []ReceptionSummary `json:"items"`
+	Limit int                `json:"limit"`
+	Page  int                `json:"page"`
+
+	// Total Количество приемок, подходящих под фильтры
+	Total int `json:"total"`
+}
+
+// ReceptionProducts defines model for ReceptionProducts.
+type ReceptionProducts struct {
+	Products  []Product `json:"products"`
+	Reception Reception `json:"reception"`
+}
+
+// ReceptionReopening Повторное открытие закрытой приемки и отмененное им закрытие
+type ReceptionReopening struct {
+	ClosedAt   *time.Time          `json:"closedAt,omitempty"`
+	ClosedBy   *openapi_types.UUID `json:"closedBy,omitempty"`
+	Id         openapi_types.UUID  `json:"id"`
+	Reason     string              `json:"reason"`
+	ReopenedAt time.Time           `json:"reopenedAt"`
+	ReopenedBy openapi_types.UUID  `json:"reopenedBy"`
+}
+
+// ReceptionSummary defines model for ReceptionSummary.
+type ReceptionSummary struct {
+	ProductsCount int       `json:"productsCount"`
+	Reception     Reception `json:"reception"`
+}
+
+// ReceptionTimeline Когда и кем приемка была открыта и закрыта
+type ReceptionTimeline struct {
+	// ClosedAt Отсутствует, пока приемка не закрыта
+	ClosedAt *time.Time          `json:"closedAt,omitempty"`
+	ClosedBy *openapi_types.UUID `json:"closedBy,omitempty"`
+	OpenedAt time.Time           `json:"openedAt"`
+	OpenedBy *openapi_types.UUID `json:"openedBy,omitempty"`
+
+	// Reopenings Повторные открытия приемки модераторами, от старых к новым
+	Reopenings []ReceptionReopening `json:"reopenings"`
+}
+
+// ReportComparison defines model for ReportComparison.
+type ReportComparison struct {
+	// Current Приемки, открытые за период по местному времени ПВЗ, и товары в них
+	Current ReportStats `json:"current"`
+
+	// PreviousWeek Сравниваемый период previousFrom - previousTo
+	PreviousWeek ReportStats `json:"previousWeek"`
+}
+
+// ReportStats Приемки, открытые за период по местному времени ПВЗ, и товары в них
+type ReportStats struct {
+	// Busiest ПВЗ с наибольшим количеством принятых товаров
+	Busiest []BusyPVZ `json:"busiest"`
+
+	// Products Количество принятых товаров по типам
+	Products map[string]int `json:"products"`
+
+	// Pvzs Количество ПВЗ, зарегистрированных к концу периода
+	Pvzs             int     `json:"pvzs"`
+	Receptions       int     `json:"receptions"`
+	ReceptionsPerDay float32 `json:"receptionsPerDay"`
+}
+
+// StorageCell Ячейка хранения ПВЗ, в ней лежит не больше одного товара
+type StorageCell struct {
+	// Code Обозначение ячейки на стеллаже
+	Code string             `json:"code"`
+	Id   openapi_types.UUID `json:"id"`
+
+	// ProductId Товар в ячейке, нет у свободной ячейки
+	ProductId *openapi_types.UUID `json:"productId,omitempty"`
+
+	// Type Код типа товара, для которого отведена ячейка
+	Type *string `json:"type,omitempty"`
+}
+
+// StorageLayout Ячейки хранения ПВЗ в порядке заполнения
+type StorageLayout struct {
+	// Allocation Выбор свободной ячейки для принятого товара: fill_first берет ячейку с наименьшим номером по порядку, type_grouped сначала ячейки типа товара, затем ячейки без типа
+	Allocation CellAllocation `json:"allocation"`
+	Cells      []StorageCell  `json:"cells"`
+}
+
 // Token defines model for Token.
 type Token = string
 
@@ -122,6 +692,99 @@ type User struct {
 // UserRole defines model for User.Role.
 type UserRole string
 
+// AnalyticsFrom defines model for AnalyticsFrom.
+type AnalyticsFrom = openapi_types.Date
+
+// AnalyticsTo defines model for AnalyticsTo.
+type AnalyticsTo = openapi_types.Date
+
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
+// PVZListCity defines model for PVZListCity.
+type PVZListCity = []string
+
+// PVZListCursor defines model for PVZListCursor.
+type PVZListCursor = string
+
+// PVZListEndDate defines model for PVZListEndDate.
+type PVZListEndDate = time.Time
+
+// PVZListEndLocalDate defines model for PVZListEndLocalDate.
+type PVZListEndLocalDate = openapi_types.Date
+
+// PVZListHasActiveReception defines model for PVZListHasActiveReception.
+type PVZListHasActiveReception = bool
+
+// PVZListLimit defines model for PVZListLimit.
+type PVZListLimit = int
+
+// PVZListOrder defines model for PVZListOrder.
+type PVZListOrder string
+
+// PVZListPage defines model for PVZListPage.
+type PVZListPage = int
+
+// PVZListProductStatus defines model for PVZListProductStatus.
+type PVZListProductStatus = []ProductStatus
+
+// PVZListProductType defines model for PVZListProductType.
+type PVZListProductType = []string
+
+// PVZListReceptionCreatedBy defines model for PVZListReceptionCreatedBy.
+type PVZListReceptionCreatedBy = openapi_types.UUID
+
+// PVZListReceptionStatus defines model for PVZListReceptionStatus.
+type PVZListReceptionStatus string
+
+// PVZListSort defines model for PVZListSort.
+type PVZListSort string
+
+// PVZListStartDate defines model for PVZListStartDate.
+type PVZListStartDate = time.Time
+
+// PVZListStartLocalDate defines model for PVZListStartLocalDate.
+type PVZListStartLocalDate = openapi_types.Date
+
+// PVZListStatus defines model for PVZListStatus.
+type PVZListStatus string
+
+// ReceptionListClosedBy defines model for ReceptionListClosedBy.
+type ReceptionListClosedBy = openapi_types.UUID
+
+// ReceptionListCreatedBy defines model for ReceptionListCreatedBy.
+type ReceptionListCreatedBy = openapi_types.UUID
+
+// ReceptionListStatus defines model for ReceptionListStatus.
+type ReceptionListStatus string
+
+// GetAnalyticsCitiesParams defines parameters for GetAnalyticsCities.
+type GetAnalyticsCitiesParams struct {
+	// From Первый день периода
+	From AnalyticsFrom `form:"from" json:"from"`
+
+	// To Последний день периода (включительно)
+	To AnalyticsTo `form:"to" json:"to"`
+}
+
+// GetAnalyticsPvzDailyParams defines parameters for GetAnalyticsPvzDaily.
+type GetAnalyticsPvzDailyParams struct {
+	// From Первый день периода
+	From AnalyticsFrom `form:"from" json:"from"`
+
+	// To Последний день периода (включительно)
+	To AnalyticsTo `form:"to" json:"to"`
+}
+
+// GetAnalyticsPvzPvzIdDailyParams defines parameters for GetAnalyticsPvzPvzIdDaily.
+type GetAnalyticsPvzPvzIdDailyParams struct {
+	// From Первый день периода
+	From AnalyticsFrom `form:"from" json:"from"`
+
+	// To Последний день периода (включительно)
+	To AnalyticsTo `form:"to" json:"to"`
+}
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
 	Role PostDummyLoginJSONBodyRole `json:"role"`
@@ -136,43 +799,331 @@ type PostLoginJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// GetProductTypesParams defines parameters for GetProductTypes.
+type GetProductTypesParams struct {
+	// IncludeInactive Показывать и неактивные типы
+	IncludeInactive *bool `form:"includeInactive,omitempty" json:"includeInactive,omitempty"`
+}
+
+// PutProductTypesCodeJSONBody defines parameters for PutProductTypesCode.
+type PutProductTypesCodeJSONBody struct {
+	Active bool              `json:"active"`
+	Names  map[string]string `json:"names"`
+}
+
+// GetProductsParams defines parameters for GetProducts.
+type GetProductsParams struct {
+	Barcode string `form:"barcode" json:"barcode"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	Barcode *string `json:"barcode,omitempty"`
+
+	// DeclaredValue Объявленная стоимость в копейках
+	DeclaredValue *int64 `json:"declaredValue,omitempty"`
+
+	// Dimensions Габариты в сантиметрах
+	Dimensions *Dimensions `json:"dimensions,omitempty"`
+
+	// Inspection Результат осмотра товара при приемке. Для отклоненного товара причина обязательна, для принятого без замечаний ее быть не должно.
+	Inspection *Inspection        `json:"inspection,omitempty"`
+	PvzId      openapi_types.UUID `json:"pvzId"`
+
+	// Type Код типа товара из каталога
+	Type string `json:"type"`
+
+	// Weight Вес в граммах
+	Weight *int `json:"weight,omitempty"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Products []struct {
+		Barcode *string `json:"barcode,omitempty"`
+
+		// DeclaredValue Объявленная стоимость в копейках
+		DeclaredValue *int64 `json:"declaredValue,omitempty"`
+
+		// Dimensions Габариты в сантиметрах
+		Dimensions *Dimensions `json:"dimensions,omitempty"`
+
+		// Inspection Результат осмотра товара при приемке. Для отклоненного товара причина обязательна, для принятого без замечаний ее быть не должно.
+		Inspection *Inspection `json:"inspection,omitempty"`
+
+		// Type Код типа товара из каталога
+		Type string `json:"type"`
+
+		// Weight Вес в граммах
+		Weight *int `json:"weight,omitempty"`
+	} `json:"products"`
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
 	// StartDate Начальная дата диапазона
-	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+	StartDate *PVZListStartDate `form:"startDate,omitempty" json:"startDate,omitempty"`
 
 	// EndDate Конечная дата диапазона
-	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+	EndDate *PVZListEndDate `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// StartLocalDate Начальная дата диапазона по местному времени ПВЗ
+	StartLocalDate *PVZListStartLocalDate `form:"startLocalDate,omitempty" json:"startLocalDate,omitempty"`
+
+	// EndLocalDate Конечная дата диапазона по местному времени ПВЗ (включительно)
+	EndLocalDate *PVZListEndLocalDate `form:"endLocalDate,omitempty" json:"endLocalDate,omitempty"`
 
 	// Page Номер страницы
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Page *PVZListPage `form:"page,omitempty" json:"page,omitempty"`
 
 	// Limit Количество элементов на странице
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
-}
+	Limit *PVZListLimit `form:"limit,omitempty" json:"limit,omitempty"`
 
-// PostReceptionsJSONBody defines parameters for PostReceptions.
-type PostReceptionsJSONBody struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
-}
+	// City Города ПВЗ
+	City *PVZListCity `form:"city,omitempty" json:"city,omitempty"`
 
-// PostRegisterJSONBody defines parameters for PostRegister.
-type PostRegisterJSONBody struct {
-	Email    openapi_types.Email      `json:"email"`
-	Password string                   `json:"password"`
-	Role     PostRegisterJSONBodyRole `json:"role"`
-}
+	// Status Работает ли ПВЗ сейчас по своему графику
+	Status *GetPvzParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-// PostRegisterJSONBodyRole defines parameters for PostRegister.
+	// ProductType Показывать только приемки с товарами этих типов
+	ProductType *PVZListProductType `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// ProductStatus Показывать только приемки с товарами в этих статусах
+	ProductStatus *PVZListProductStatus `form:"productStatus,omitempty" json:"productStatus,omitempty"`
+
+	// ReceptionStatus Показывать только приемки в этом статусе
+	ReceptionStatus *GetPvzParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ReceptionCreatedBy Показывать только приемки, открытые этим пользователем
+	ReceptionCreatedBy *PVZListReceptionCreatedBy `form:"receptionCreatedBy,omitempty" json:"receptionCreatedBy,omitempty"`
+
+	// HasActiveReception Есть ли у ПВЗ незакрытая приемка
+	HasActiveReception *PVZListHasActiveReception `form:"hasActiveReception,omitempty" json:"hasActiveReception,omitempty"`
+
+	// Sort Поле сортировки: дата регистрации ПВЗ, время последней приемки или количество товаров. Курсор поддерживается только при сортировке по дате регистрации
+	Sort *GetPvzParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *GetPvzParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор следующей страницы из заголовка X-Next-Cursor предыдущего ответа. Нельзя передавать вместе с page
+	Cursor *PVZListCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPvzParamsCity defines parameters for GetPvz.
+type GetPvzParamsCity string
+
+// GetPvzParamsStatus defines parameters for GetPvz.
+type GetPvzParamsStatus string
+
+// GetPvzParamsReceptionStatus defines parameters for GetPvz.
+type GetPvzParamsReceptionStatus string
+
+// GetPvzParamsSort defines parameters for GetPvz.
+type GetPvzParamsSort string
+
+// GetPvzParamsOrder defines parameters for GetPvz.
+type GetPvzParamsOrder string
+
+// GetPvzExportParams defines parameters for GetPvzExport.
+type GetPvzExportParams struct {
+	// Format Формат файла
+	Format *GetPvzExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *PVZListStartDate `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *PVZListEndDate `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// StartLocalDate Начальная дата диапазона по местному времени ПВЗ
+	StartLocalDate *PVZListStartLocalDate `form:"startLocalDate,omitempty" json:"startLocalDate,omitempty"`
+
+	// EndLocalDate Конечная дата диапазона по местному времени ПВЗ (включительно)
+	EndLocalDate *PVZListEndLocalDate `form:"endLocalDate,omitempty" json:"endLocalDate,omitempty"`
+
+	// City Города ПВЗ
+	City *PVZListCity `form:"city,omitempty" json:"city,omitempty"`
+
+	// Status Работает ли ПВЗ сейчас по своему графику
+	Status *GetPvzExportParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// ProductType Показывать только приемки с товарами этих типов
+	ProductType *PVZListProductType `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// ProductStatus Показывать только приемки с товарами в этих статусах
+	ProductStatus *PVZListProductStatus `form:"productStatus,omitempty" json:"productStatus,omitempty"`
+
+	// ReceptionStatus Показывать только приемки в этом статусе
+	ReceptionStatus *GetPvzExportParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ReceptionCreatedBy Показывать только приемки, открытые этим пользователем
+	ReceptionCreatedBy *PVZListReceptionCreatedBy `form:"receptionCreatedBy,omitempty" json:"receptionCreatedBy,omitempty"`
+
+	// HasActiveReception Есть ли у ПВЗ незакрытая приемка
+	HasActiveReception *PVZListHasActiveReception `form:"hasActiveReception,omitempty" json:"hasActiveReception,omitempty"`
+
+	// Sort Поле сортировки: дата регистрации ПВЗ, время последней приемки или количество товаров. Курсор поддерживается только при сортировке по дате регистрации
+	Sort *GetPvzExportParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *GetPvzExportParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetPvzExportParamsFormat defines parameters for GetPvzExport.
+type GetPvzExportParamsFormat string
+
+// GetPvzExportParamsCity defines parameters for GetPvzExport.
+type GetPvzExportParamsCity string
+
+// GetPvzExportParamsStatus defines parameters for GetPvzExport.
+type GetPvzExportParamsStatus string
+
+// GetPvzExportParamsReceptionStatus defines parameters for GetPvzExport.
+type GetPvzExportParamsReceptionStatus string
+
+// GetPvzExportParamsSort defines parameters for GetPvzExport.
+type GetPvzExportParamsSort string
+
+// GetPvzExportParamsOrder defines parameters for GetPvzExport.
+type GetPvzExportParamsOrder string
+
+// GetPvzStreamParams defines parameters for GetPvzStream.
+type GetPvzStreamParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *PVZListStartDate `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *PVZListEndDate `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// StartLocalDate Начальная дата диапазона по местному времени ПВЗ
+	StartLocalDate *PVZListStartLocalDate `form:"startLocalDate,omitempty" json:"startLocalDate,omitempty"`
+
+	// EndLocalDate Конечная дата диапазона по местному времени ПВЗ (включительно)
+	EndLocalDate *PVZListEndLocalDate `form:"endLocalDate,omitempty" json:"endLocalDate,omitempty"`
+
+	// City Города ПВЗ
+	City *PVZListCity `form:"city,omitempty" json:"city,omitempty"`
+
+	// Status Работает ли ПВЗ сейчас по своему графику
+	Status *GetPvzStreamParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// ProductType Показывать только приемки с товарами этих типов
+	ProductType *PVZListProductType `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// ProductStatus Показывать только приемки с товарами в этих статусах
+	ProductStatus *PVZListProductStatus `form:"productStatus,omitempty" json:"productStatus,omitempty"`
+
+	// ReceptionStatus Показывать только приемки в этом статусе
+	ReceptionStatus *GetPvzStreamParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ReceptionCreatedBy Показывать только приемки, открытые этим пользователем
+	ReceptionCreatedBy *PVZListReceptionCreatedBy `form:"receptionCreatedBy,omitempty" json:"receptionCreatedBy,omitempty"`
+
+	// HasActiveReception Есть ли у ПВЗ незакрытая приемка
+	HasActiveReception *PVZListHasActiveReception `form:"hasActiveReception,omitempty" json:"hasActiveReception,omitempty"`
+
+	// Sort Поле сортировки: дата регистрации ПВЗ, время последней приемки или количество товаров. Курсор поддерживается только при сортировке по дате регистрации
+	Sort *GetPvzStreamParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *GetPvzStreamParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetPvzStreamParamsCity defines parameters for GetPvzStream.
+type GetPvzStreamParamsCity string
+
+// GetPvzStreamParamsStatus defines parameters for GetPvzStream.
+type GetPvzStreamParamsStatus string
+
+// GetPvzStreamParamsReceptionStatus defines parameters for GetPvzStream.
+type GetPvzStreamParamsReceptionStatus string
+
+// GetPvzStreamParamsSort defines parameters for GetPvzStream.
+type GetPvzStreamParamsSort string
+
+// GetPvzStreamParamsOrder defines parameters for GetPvzStream.
+type GetPvzStreamParamsOrder string
+
+// PutPvzPvzIdCellsJSONBody defines parameters for PutPvzPvzIdCells.
+type PutPvzPvzIdCellsJSONBody struct {
+	// Allocation Выбор свободной ячейки для принятого товара: fill_first берет ячейку с наименьшим номером по порядку, type_grouped сначала ячейки типа товара, затем ячейки без типа
+	Allocation CellAllocation `json:"allocation"`
+	Cells      []struct {
+		Code string `json:"code"`
+
+		// Type Код типа товара из каталога
+		Type *string `json:"type,omitempty"`
+	} `json:"cells"`
+}
+
+// PostPvzPvzIdOrdersJSONBody defines parameters for PostPvzPvzIdOrders.
+type PostPvzPvzIdOrdersJSONBody struct {
+	ProductIds []openapi_types.UUID `json:"productIds"`
+}
+
+// PostPvzPvzIdPickupJSONBody defines parameters for PostPvzPvzIdPickup.
+type PostPvzPvzIdPickupJSONBody struct {
+	Code string `json:"code"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	// Status Статус приемки
+	Status *GetPvzPvzIdReceptionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedBy Пользователь, открывший приемку
+	CreatedBy *ReceptionListCreatedBy `form:"createdBy,omitempty" json:"createdBy,omitempty"`
+
+	// ClosedBy Пользователь, закрывший приемку
+	ClosedBy *ReceptionListClosedBy `form:"closedBy,omitempty" json:"closedBy,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *PVZListStartDate `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *PVZListEndDate `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// StartLocalDate Начальная дата диапазона по местному времени ПВЗ
+	StartLocalDate *PVZListStartLocalDate `form:"startLocalDate,omitempty" json:"startLocalDate,omitempty"`
+
+	// EndLocalDate Конечная дата диапазона по местному времени ПВЗ (включительно)
+	EndLocalDate *PVZListEndLocalDate `form:"endLocalDate,omitempty" json:"endLocalDate,omitempty"`
+
+	// Page Номер страницы
+	Page *PVZListPage `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *PVZListLimit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParamsStatus defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParamsStatus string
+
+// PostReceptionsJSONBody defines parameters for PostReceptions.
+type PostReceptionsJSONBody struct {
+	// Manifest Ожидаемый состав поставки по упаковочному листу, не больше одной позиции на тип
+	Manifest *[]ManifestItem `json:"manifest,omitempty"`
+
+	// Override Открыть приемку вне рабочего времени ПВЗ (только для модераторов)
+	Override *bool              `json:"override,omitempty"`
+	PvzId    openapi_types.UUID `json:"pvzId"`
+}
+
+// PostReceptionsReceptionIdReopenJSONBody defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// PostRegisterJSONBody defines parameters for PostRegister.
+type PostRegisterJSONBody struct {
+	Email    openapi_types.Email      `json:"email"`
+	Password string                   `json:"password"`
+	Role     PostRegisterJSONBodyRole `json:"role"`
+}
+
+// PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
@@ -181,44 +1132,125 @@ type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody = ProductTypeEntry
+
+// PutProductTypesCodeJSONRequestBody defines body for PutProductTypesCode for application/json ContentType.
+type PutProductTypesCodeJSONRequestBody PutProductTypesCodeJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PutPvzPvzIdCellsJSONRequestBody defines body for PutPvzPvzIdCells for application/json ContentType.
+type PutPvzPvzIdCellsJSONRequestBody PutPvzPvzIdCellsJSONBody
+
+// PostPvzPvzIdOrdersJSONRequestBody defines body for PostPvzPvzIdOrders for application/json ContentType.
+type PostPvzPvzIdOrdersJSONRequestBody PostPvzPvzIdOrdersJSONBody
+
+// PostPvzPvzIdPickupJSONRequestBody defines body for PostPvzPvzIdPickup for application/json ContentType.
+type PostPvzPvzIdPickupJSONRequestBody PostPvzPvzIdPickupJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody PostReceptionsReceptionIdReopenJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Сводка по городам и по всей сети (только для модераторов)
+	// (GET /analytics/cities)
+	GetAnalyticsCities(c *gin.Context, params GetAnalyticsCitiesParams)
+	// Приемки и товары по дням по всем ПВЗ
+	// (GET /analytics/pvz/daily)
+	GetAnalyticsPvzDaily(c *gin.Context, params GetAnalyticsPvzDailyParams)
+	// Приемки и товары по дням в ПВЗ
+	// (GET /analytics/pvz/{pvzId}/daily)
+	GetAnalyticsPvzPvzIdDaily(c *gin.Context, pvzId openapi_types.UUID, params GetAnalyticsPvzPvzIdDailyParams)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(c *gin.Context)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(c *gin.Context)
+	// Каталог типов товаров
+	// (GET /product_types)
+	GetProductTypes(c *gin.Context, params GetProductTypesParams)
+	// Добавление типа товара в каталог (только для модераторов)
+	// (POST /product_types)
+	PostProductTypes(c *gin.Context)
+	// Изменение названий и активности типа товара (только для модераторов)
+	// (PUT /product_types/{code})
+	PutProductTypesCode(c *gin.Context, code string)
+	// Поиск товаров по штрихкоду во всех ПВЗ
+	// (GET /products)
+	GetProducts(c *gin.Context, params GetProductsParams)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(c *gin.Context)
+	// Добавление нескольких товаров в текущую приемку одним запросом (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(c *gin.Context)
+	// Выдача товара покупателю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(c *gin.Context, productId openapi_types.UUID)
+	// Возврат товара отправителю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/return)
+	PostProductsProductIdReturn(c *gin.Context, productId openapi_types.UUID)
 	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
 	// (GET /pvz)
 	GetPvz(c *gin.Context, params GetPvzParams)
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(c *gin.Context)
+	// Выгрузка товаров с данными приемок и ПВЗ в CSV или XLSX
+	// (GET /pvz/export)
+	GetPvzExport(c *gin.Context, params GetPvzExportParams)
+	// Потоковая выгрузка ПВЗ с приемками и товарами в NDJSON
+	// (GET /pvz/stream)
+	GetPvzStream(c *gin.Context, params GetPvzStreamParams)
+	// Получение ПВЗ с текущей приемкой и сводной статистикой
+	// (GET /pvz/{pvzId})
+	GetPvzPvzId(c *gin.Context, pvzId openapi_types.UUID)
+	// Ячейки хранения ПВЗ с лежащими в них товарами
+	// (GET /pvz/{pvzId}/cells)
+	GetPvzPvzIdCells(c *gin.Context, pvzId openapi_types.UUID)
+	// Замена ячеек хранения ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/cells)
+	PutPvzPvzIdCells(c *gin.Context, pvzId openapi_types.UUID)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openapi_types.UUID)
+	// Создание заказа покупателя из принятых на ПВЗ товаров (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/orders)
+	PostPvzPvzIdOrders(c *gin.Context, pvzId openapi_types.UUID)
+	// Выдача заказа покупателю по коду получения (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/pickup)
+	PostPvzPvzIdPickup(c *gin.Context, pvzId openapi_types.UUID)
+	// История приемок ПВЗ, начиная с последней
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(c *gin.Context, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(c *gin.Context)
+	// Получение приемки с ПВЗ, историей статусов и товарами
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(c *gin.Context, receptionId openapi_types.UUID)
+	// Повторное открытие закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(c *gin.Context, receptionId openapi_types.UUID)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(c *gin.Context)
@@ -233,21 +1265,45 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
+// GetAnalyticsCities operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsCities(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsCitiesParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.PostDummyLogin(c)
-}
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -256,14 +1312,49 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.GetAnalyticsCities(c, params)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+// GetAnalyticsPvzDaily operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsPvzDaily(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsPvzDailyParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -271,48 +1362,55 @@ func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProducts(c)
+	siw.Handler.GetAnalyticsPvzDaily(c, params)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+// GetAnalyticsPvzPvzIdDaily operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsPvzPvzIdDaily(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
+	var params GetAnalyticsPvzPvzIdDailyParams
 
-	// ------------- Optional query parameter "startDate" -------------
+	// ------------- Required query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Required query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -323,13 +1421,11 @@ func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPvz(c, params)
+	siw.Handler.GetAnalyticsPvzPvzIdDaily(c, pvzId, params)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -338,24 +1434,11 @@ func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPvz(c)
+	siw.Handler.PostDummyLogin(c)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	c.Set(BearerAuthScopes, []string{})
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -364,25 +1447,27 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+	siw.Handler.PostLogin(c)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductTypesParams
+
+	// ------------- Optional query parameter "includeInactive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeInactive", c.Request.URL.Query(), &params.IncludeInactive)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeInactive: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -390,11 +1475,11 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+	siw.Handler.GetProductTypes(c, params)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -405,11 +1490,24 @@ func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostReceptions(c)
+	siw.Handler.PostProductTypes(c)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+// PutProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) PutProductTypesCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -418,400 +1516,2746 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.PutProductTypesCode(c, code)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetProducts(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsParams
 
-	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
-	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
-	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
-	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
-	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
-	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
-}
+	// ------------- Required query parameter "barcode" -------------
 
-type PostDummyLoginRequestObject struct {
-	Body *PostDummyLoginJSONRequestBody
-}
+	if paramValue := c.Query("barcode"); paramValue != "" {
 
-type PostDummyLoginResponseObject interface {
-	VisitPostDummyLoginResponse(w http.ResponseWriter) error
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument barcode is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type PostDummyLogin200JSONResponse Token
+	err = runtime.BindQueryParameter("form", true, true, "barcode", c.Request.URL.Query(), &params.Barcode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter barcode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetProducts(c, params)
 }
 
-type PostDummyLogin400JSONResponse Error
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 
-func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
+	siw.Handler.PostProducts(c)
 }
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+// PostProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsBatch(c *gin.Context) {
 
-type PostLogin200JSONResponse Token
+	c.Set(BearerAuthScopes, []string{})
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostProductsBatch(c)
 }
 
-type PostLogin401JSONResponse Error
-
-func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PostProductsProductIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdIssue(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostProductsRequestObject struct {
-	Body *PostProductsJSONRequestBody
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostProductsResponseObject interface {
-	VisitPostProductsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostProducts201JSONResponse Product
+	c.Set(BearerAuthScopes, []string{})
 
-func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostProductsProductIdIssue(c, productId)
 }
 
-type PostProducts400JSONResponse Error
+// PostProductsProductIdReturn operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdReturn(c *gin.Context) {
 
-func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostProducts403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetPvzRequestObject struct {
-	Params GetPvzParams
+	siw.Handler.PostProductsProductIdReturn(c, productId)
 }
 
-type GetPvzResponseObject interface {
-	VisitGetPvzResponse(w http.ResponseWriter) error
-}
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 
-type GetPvz200JSONResponse []struct {
-	Pvz        *PVZ `json:"pvz,omitempty"`
-	Receptions *[]struct {
-		Products  *[]Product `json:"products,omitempty"`
-		Reception *Reception `json:"reception,omitempty"`
-	} `json:"receptions,omitempty"`
-}
+	var err error
 
-func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
 
-type GetPvz400JSONResponse Error
+	// ------------- Optional query parameter "startDate" -------------
 
-func (response GetPvz400JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "endDate" -------------
 
-type GetPvz403JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetPvz403JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "startLocalDate" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "startLocalDate", c.Request.URL.Query(), &params.StartLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvzRequestObject struct {
-	Body *PostPvzJSONRequestBody
-}
+	// ------------- Optional query parameter "endLocalDate" -------------
 
-type PostPvzResponseObject interface {
-	VisitPostPvzResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "endLocalDate", c.Request.URL.Query(), &params.EndLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvz201JSONResponse PVZ
+	// ------------- Optional query parameter "page" -------------
 
-func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type PostPvz400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "city" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvz403JSONResponse Error
+	// ------------- Optional query parameter "status" -------------
 
-func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "productType" -------------
 
-type PostPvzPvzIdCloseLastReceptionRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "productType", c.Request.URL.Query(), &params.ProductType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productType: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvzPvzIdCloseLastReceptionResponseObject interface {
-	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "productStatus" -------------
 
-type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+	err = runtime.BindQueryParameter("form", true, false, "productStatus", c.Request.URL.Query(), &params.ProductStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productStatus: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "receptionStatus" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "receptionStatus", c.Request.URL.Query(), &params.ReceptionStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionStatus: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvzPvzIdCloseLastReception400JSONResponse Error
+	// ------------- Optional query parameter "receptionCreatedBy" -------------
 
-func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "receptionCreatedBy", c.Request.URL.Query(), &params.ReceptionCreatedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionCreatedBy: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "hasActiveReception" -------------
 
-type PostPvzPvzIdCloseLastReception403JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "hasActiveReception", c.Request.URL.Query(), &params.HasActiveReception)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter hasActiveReception: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvz(c, params)
+}
+
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvz(c)
+}
+
+// GetPvzExport operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzExport(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startLocalDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startLocalDate", c.Request.URL.Query(), &params.StartLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endLocalDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endLocalDate", c.Request.URL.Query(), &params.EndLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "productType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productType", c.Request.URL.Query(), &params.ProductType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "productStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productStatus", c.Request.URL.Query(), &params.ProductStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productStatus: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "receptionStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionStatus", c.Request.URL.Query(), &params.ReceptionStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionStatus: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "receptionCreatedBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionCreatedBy", c.Request.URL.Query(), &params.ReceptionCreatedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionCreatedBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "hasActiveReception" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hasActiveReception", c.Request.URL.Query(), &params.HasActiveReception)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter hasActiveReception: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzExport(c, params)
+}
+
+// GetPvzStream operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzStream(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzStreamParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startLocalDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startLocalDate", c.Request.URL.Query(), &params.StartLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endLocalDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endLocalDate", c.Request.URL.Query(), &params.EndLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "productType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productType", c.Request.URL.Query(), &params.ProductType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "productStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "productStatus", c.Request.URL.Query(), &params.ProductStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productStatus: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "receptionStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionStatus", c.Request.URL.Query(), &params.ReceptionStatus)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionStatus: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "receptionCreatedBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionCreatedBy", c.Request.URL.Query(), &params.ReceptionCreatedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionCreatedBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "hasActiveReception" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hasActiveReception", c.Request.URL.Query(), &params.HasActiveReception)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter hasActiveReception: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzStream(c, params)
+}
+
+// GetPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzId(c, pvzId)
+}
+
+// GetPvzPvzIdCells operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdCells(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdCells(c, pvzId)
+}
+
+// PutPvzPvzIdCells operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdCells(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPvzPvzIdCells(c, pvzId)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+}
+
+// PostPvzPvzIdOrders operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdOrders(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdOrders(c, pvzId)
+}
+
+// PostPvzPvzIdPickup operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdPickup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdPickup(c, pvzId)
+}
+
+// GetPvzPvzIdReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdReceptions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdReceptionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "createdBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBy", c.Request.URL.Query(), &params.CreatedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "closedBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "closedBy", c.Request.URL.Query(), &params.ClosedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter closedBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startLocalDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startLocalDate", c.Request.URL.Query(), &params.StartLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endLocalDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endLocalDate", c.Request.URL.Query(), &params.EndLocalDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endLocalDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdReceptions(c, pvzId, params)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptions(c)
+}
+
+// GetReceptionsReceptionId operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReceptionsReceptionId(c, receptionId)
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdReopen(c, receptionId)
+}
+
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostRegister(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/analytics/cities", wrapper.GetAnalyticsCities)
+	router.GET(options.BaseURL+"/analytics/pvz/daily", wrapper.GetAnalyticsPvzDaily)
+	router.GET(options.BaseURL+"/analytics/pvz/:pvzId/daily", wrapper.GetAnalyticsPvzPvzIdDaily)
+	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
+	router.GET(options.BaseURL+"/product_types", wrapper.GetProductTypes)
+	router.POST(options.BaseURL+"/product_types", wrapper.PostProductTypes)
+	router.PUT(options.BaseURL+"/product_types/:code", wrapper.PutProductTypesCode)
+	router.GET(options.BaseURL+"/products", wrapper.GetProducts)
+	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
+	router.POST(options.BaseURL+"/products/batch", wrapper.PostProductsBatch)
+	router.POST(options.BaseURL+"/products/:productId/issue", wrapper.PostProductsProductIdIssue)
+	router.POST(options.BaseURL+"/products/:productId/return", wrapper.PostProductsProductIdReturn)
+	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
+	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
+	router.GET(options.BaseURL+"/pvz/export", wrapper.GetPvzExport)
+	router.GET(options.BaseURL+"/pvz/stream", wrapper.GetPvzStream)
+	router.GET(options.BaseURL+"/pvz/:pvzId", wrapper.GetPvzPvzId)
+	router.GET(options.BaseURL+"/pvz/:pvzId/cells", wrapper.GetPvzPvzIdCells)
+	router.PUT(options.BaseURL+"/pvz/:pvzId/cells", wrapper.PutPvzPvzIdCells)
+	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.POST(options.BaseURL+"/pvz/:pvzId/orders", wrapper.PostPvzPvzIdOrders)
+	router.POST(options.BaseURL+"/pvz/:pvzId/pickup", wrapper.PostPvzPvzIdPickup)
+	router.GET(options.BaseURL+"/pvz/:pvzId/receptions", wrapper.GetPvzPvzIdReceptions)
+	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
+	router.GET(options.BaseURL+"/receptions/:receptionId", wrapper.GetReceptionsReceptionId)
+	router.POST(options.BaseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+}
+
+type GetAnalyticsCitiesRequestObject struct {
+	Params GetAnalyticsCitiesParams
+}
+
+type GetAnalyticsCitiesResponseObject interface {
+	VisitGetAnalyticsCitiesResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsCities200JSONResponse CitiesReport
+
+func (response GetAnalyticsCities200JSONResponse) VisitGetAnalyticsCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCities400JSONResponse Error
+
+func (response GetAnalyticsCities400JSONResponse) VisitGetAnalyticsCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCities403JSONResponse Error
+
+func (response GetAnalyticsCities403JSONResponse) VisitGetAnalyticsCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzDailyRequestObject struct {
+	Params GetAnalyticsPvzDailyParams
+}
+
+type GetAnalyticsPvzDailyResponseObject interface {
+	VisitGetAnalyticsPvzDailyResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsPvzDaily200JSONResponse []DailyStats
+
+func (response GetAnalyticsPvzDaily200JSONResponse) VisitGetAnalyticsPvzDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzDaily400JSONResponse Error
+
+func (response GetAnalyticsPvzDaily400JSONResponse) VisitGetAnalyticsPvzDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzDaily403JSONResponse Error
+
+func (response GetAnalyticsPvzDaily403JSONResponse) VisitGetAnalyticsPvzDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzPvzIdDailyRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params GetAnalyticsPvzPvzIdDailyParams
+}
+
+type GetAnalyticsPvzPvzIdDailyResponseObject interface {
+	VisitGetAnalyticsPvzPvzIdDailyResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsPvzPvzIdDaily200JSONResponse []DailyStats
+
+func (response GetAnalyticsPvzPvzIdDaily200JSONResponse) VisitGetAnalyticsPvzPvzIdDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzPvzIdDaily400JSONResponse Error
+
+func (response GetAnalyticsPvzPvzIdDaily400JSONResponse) VisitGetAnalyticsPvzPvzIdDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzPvzIdDaily403JSONResponse Error
+
+func (response GetAnalyticsPvzPvzIdDaily403JSONResponse) VisitGetAnalyticsPvzPvzIdDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsPvzPvzIdDaily404JSONResponse Error
+
+func (response GetAnalyticsPvzPvzIdDaily404JSONResponse) VisitGetAnalyticsPvzPvzIdDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLoginRequestObject struct {
+	Body *PostDummyLoginJSONRequestBody
+}
+
+type PostDummyLoginResponseObject interface {
+	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostDummyLogin200JSONResponse Token
+
+func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin400JSONResponse Error
+
+func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse Token
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401JSONResponse Error
+
+func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypesRequestObject struct {
+	Params GetProductTypesParams
+}
+
+type GetProductTypesResponseObject interface {
+	VisitGetProductTypesResponse(w http.ResponseWriter) error
+}
+
+type GetProductTypes200JSONResponse []ProductTypeEntry
+
+func (response GetProductTypes200JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes400JSONResponse Error
+
+func (response GetProductTypes400JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes403JSONResponse Error
+
+func (response GetProductTypes403JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypesRequestObject struct {
+	Body *PostProductTypesJSONRequestBody
+}
+
+type PostProductTypesResponseObject interface {
+	VisitPostProductTypesResponse(w http.ResponseWriter) error
+}
+
+type PostProductTypes201JSONResponse ProductTypeEntry
+
+func (response PostProductTypes201JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes400JSONResponse Error
+
+func (response PostProductTypes400JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes403JSONResponse Error
+
+func (response PostProductTypes403JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes409JSONResponse Error
+
+func (response PostProductTypes409JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCodeRequestObject struct {
+	Code string `json:"code"`
+	Body *PutProductTypesCodeJSONRequestBody
+}
+
+type PutProductTypesCodeResponseObject interface {
+	VisitPutProductTypesCodeResponse(w http.ResponseWriter) error
+}
+
+type PutProductTypesCode200JSONResponse ProductTypeEntry
+
+func (response PutProductTypesCode200JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCode400JSONResponse Error
+
+func (response PutProductTypesCode400JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCode403JSONResponse Error
+
+func (response PutProductTypesCode403JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesCode404JSONResponse Error
+
+func (response PutProductTypesCode404JSONResponse) VisitPutProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsRequestObject struct {
+	Params GetProductsParams
+}
+
+type GetProductsResponseObject interface {
+	VisitGetProductsResponse(w http.ResponseWriter) error
+}
+
+type GetProducts200JSONResponse []ProductLocation
+
+func (response GetProducts200JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProducts400JSONResponse Error
+
+func (response GetProducts400JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProducts403JSONResponse Error
+
+func (response GetProducts403JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsRequestObject struct {
+	Body *PostProductsJSONRequestBody
+}
+
+type PostProductsResponseObject interface {
+	VisitPostProductsResponse(w http.ResponseWriter) error
+}
+
+type PostProducts201JSONResponse AddedProduct
+
+func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts400JSONResponse Error
+
+func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts403JSONResponse Error
+
+func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts409JSONResponse Error
+
+func (response PostProducts409JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatchRequestObject struct {
+	Body *PostProductsBatchJSONRequestBody
+}
+
+type PostProductsBatchResponseObject interface {
+	VisitPostProductsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostProductsBatch201JSONResponse ProductBatch
+
+func (response PostProductsBatch201JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch400JSONResponse Error
+
+func (response PostProductsBatch400JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch403JSONResponse Error
+
+func (response PostProductsBatch403JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch409JSONResponse Error
+
+func (response PostProductsBatch409JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssueRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type PostProductsProductIdIssueResponseObject interface {
+	VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdIssue200JSONResponse Product
+
+func (response PostProductsProductIdIssue200JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue400JSONResponse Error
+
+func (response PostProductsProductIdIssue400JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue403JSONResponse Error
+
+func (response PostProductsProductIdIssue403JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue404JSONResponse Error
+
+func (response PostProductsProductIdIssue404JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue409JSONResponse Error
+
+func (response PostProductsProductIdIssue409JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturnRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type PostProductsProductIdReturnResponseObject interface {
+	VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdReturn200JSONResponse Product
+
+func (response PostProductsProductIdReturn200JSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn400JSONResponse Error
+
+func (response PostProductsProductIdReturn400JSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn403JSONResponse Error
+
+func (response PostProductsProductIdReturn403JSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn404JSONResponse Error
+
+func (response PostProductsProductIdReturn404JSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn409JSONResponse Error
+
+func (response PostProductsProductIdReturn409JSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzRequestObject struct {
+	Params GetPvzParams
+}
+
+type GetPvzResponseObject interface {
+	VisitGetPvzResponse(w http.ResponseWriter) error
+}
+
+type GetPvz200ResponseHeaders struct {
+	XNextCursor string
+}
+
+type GetPvz200JSONResponse struct {
+	Body []struct {
+		Pvz        *PVZ `json:"pvz,omitempty"`
+		Receptions *[]struct {
+			Products  *[]Product `json:"products,omitempty"`
+			Reception *Reception `json:"reception,omitempty"`
+		} `json:"receptions,omitempty"`
+	}
+	Headers GetPvz200ResponseHeaders
+}
+
+func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPvz200ApplicationVndAvitoPvzV2PlusJSONResponse struct {
+	Body    PVZPage
+	Headers GetPvz200ResponseHeaders
+}
+
+func (response GetPvz200ApplicationVndAvitoPvzV2PlusJSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.avito-pvz.v2+json")
+	w.Header().Set("X-Next-Cursor", fmt.Sprint(response.Headers.XNextCursor))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPvz400JSONResponse Error
+
+func (response GetPvz400JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz403JSONResponse Error
+
+func (response GetPvz403JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzRequestObject struct {
+	Body *PostPvzJSONRequestBody
+}
+
+type PostPvzResponseObject interface {
+	VisitPostPvzResponse(w http.ResponseWriter) error
+}
+
+type PostPvz201JSONResponse PVZ
+
+func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz400JSONResponse Error
+
+func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz403JSONResponse Error
+
+func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzExportRequestObject struct {
+	Params GetPvzExportParams
+}
+
+type GetPvzExportResponseObject interface {
+	VisitGetPvzExportResponse(w http.ResponseWriter) error
+}
+
+type GetPvzExport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPvzExport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetPvzExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPvzExport200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPvzExport200TextcsvResponse) VisitGetPvzExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPvzExport400JSONResponse Error
+
+func (response GetPvzExport400JSONResponse) VisitGetPvzExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzExport403JSONResponse Error
+
+func (response GetPvzExport403JSONResponse) VisitGetPvzExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzStreamRequestObject struct {
+	Params GetPvzStreamParams
+}
+
+type GetPvzStreamResponseObject interface {
+	VisitGetPvzStreamResponse(w http.ResponseWriter) error
+}
+
+type GetPvzStream200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPvzStream200ApplicationxNdjsonResponse) VisitGetPvzStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPvzStream400JSONResponse Error
+
+func (response GetPvzStream400JSONResponse) VisitGetPvzStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzStream403JSONResponse Error
+
+func (response GetPvzStream403JSONResponse) VisitGetPvzStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdResponseObject interface {
+	VisitGetPvzPvzIdResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzId200JSONResponse PVZDetails
+
+func (response GetPvzPvzId200JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzId400JSONResponse Error
+
+func (response GetPvzPvzId400JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzId403JSONResponse Error
+
+func (response GetPvzPvzId403JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzId404JSONResponse Error
+
+func (response GetPvzPvzId404JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCellsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdCellsResponseObject interface {
+	VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdCells200JSONResponse StorageLayout
+
+func (response GetPvzPvzIdCells200JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells400JSONResponse Error
+
+func (response GetPvzPvzIdCells400JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells403JSONResponse Error
+
+func (response GetPvzPvzIdCells403JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells404JSONResponse Error
+
+func (response GetPvzPvzIdCells404JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCellsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PutPvzPvzIdCellsJSONRequestBody
+}
+
+type PutPvzPvzIdCellsResponseObject interface {
+	VisitPutPvzPvzIdCellsResponse(w http.ResponseWriter) error
+}
+
+type PutPvzPvzIdCells200JSONResponse StorageLayout
+
+func (response PutPvzPvzIdCells200JSONResponse) VisitPutPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCells400JSONResponse Error
+
+func (response PutPvzPvzIdCells400JSONResponse) VisitPutPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCells403JSONResponse Error
+
+func (response PutPvzPvzIdCells403JSONResponse) VisitPutPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCells404JSONResponse Error
+
+func (response PutPvzPvzIdCells404JSONResponse) VisitPutPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCells409JSONResponse Error
+
+func (response PutPvzPvzIdCells409JSONResponse) VisitPutPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdCloseLastReceptionResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReception200JSONResponse ClosedReception
+
+func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception400JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception403JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception409JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception409JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProductRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdDeleteLastProductResponseObject interface {
+	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdDeleteLastProduct200Response struct {
+}
+
+func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct409JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct409JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdOrdersRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdOrdersJSONRequestBody
+}
+
+type PostPvzPvzIdOrdersResponseObject interface {
+	VisitPostPvzPvzIdOrdersResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdOrders201JSONResponse Order
+
+func (response PostPvzPvzIdOrders201JSONResponse) VisitPostPvzPvzIdOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdOrders400JSONResponse Error
+
+func (response PostPvzPvzIdOrders400JSONResponse) VisitPostPvzPvzIdOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdOrders403JSONResponse Error
+
+func (response PostPvzPvzIdOrders403JSONResponse) VisitPostPvzPvzIdOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdOrders404JSONResponse Error
+
+func (response PostPvzPvzIdOrders404JSONResponse) VisitPostPvzPvzIdOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdOrders409JSONResponse Error
+
+func (response PostPvzPvzIdOrders409JSONResponse) VisitPostPvzPvzIdOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdPickupRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdPickupJSONRequestBody
+}
+
+type PostPvzPvzIdPickupResponseObject interface {
+	VisitPostPvzPvzIdPickupResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdPickup200JSONResponse Order
+
+func (response PostPvzPvzIdPickup200JSONResponse) VisitPostPvzPvzIdPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdPickup400JSONResponse Error
+
+func (response PostPvzPvzIdPickup400JSONResponse) VisitPostPvzPvzIdPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdPickup403JSONResponse Error
+
+func (response PostPvzPvzIdPickup403JSONResponse) VisitPostPvzPvzIdPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdPickup404JSONResponse Error
+
+func (response PostPvzPvzIdPickup404JSONResponse) VisitPostPvzPvzIdPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdPickup409JSONResponse Error
+
+func (response PostPvzPvzIdPickup409JSONResponse) VisitPostPvzPvzIdPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptionsRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params GetPvzPvzIdReceptionsParams
+}
+
+type GetPvzPvzIdReceptionsResponseObject interface {
+	VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdReceptions200JSONResponse ReceptionPage
+
+func (response GetPvzPvzIdReceptions200JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptions400JSONResponse Error
+
+func (response GetPvzPvzIdReceptions400JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptions403JSONResponse Error
+
+func (response GetPvzPvzIdReceptions403JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptions404JSONResponse Error
+
+func (response GetPvzPvzIdReceptions404JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsRequestObject struct {
+	Body *PostReceptionsJSONRequestBody
+}
+
+type PostReceptionsResponseObject interface {
+	VisitPostReceptionsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptions201JSONResponse Reception
+
+func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions400JSONResponse Error
+
+func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions403JSONResponse Error
+
+func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions409JSONResponse Error
+
+func (response PostReceptions409JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdResponseObject interface {
+	VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionId200JSONResponse ReceptionDetails
+
+func (response GetReceptionsReceptionId200JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId400JSONResponse Error
+
+func (response GetReceptionsReceptionId400JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId403JSONResponse Error
+
+func (response GetReceptionsReceptionId403JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId404JSONResponse Error
+
+func (response GetReceptionsReceptionId404JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopenRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdReopenJSONRequestBody
+}
+
+type PostReceptionsReceptionIdReopenResponseObject interface {
+	VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdReopen200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdReopen200JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen400JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen400JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen403JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen403JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen404JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen404JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen409JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen409JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse User
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400JSONResponse Error
+
+func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPvzPvzIdDeleteLastProductRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
-}
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Сводка по городам и по всей сети (только для модераторов)
+	// (GET /analytics/cities)
+	GetAnalyticsCities(ctx context.Context, request GetAnalyticsCitiesRequestObject) (GetAnalyticsCitiesResponseObject, error)
+	// Приемки и товары по дням по всем ПВЗ
+	// (GET /analytics/pvz/daily)
+	GetAnalyticsPvzDaily(ctx context.Context, request GetAnalyticsPvzDailyRequestObject) (GetAnalyticsPvzDailyResponseObject, error)
+	// Приемки и товары по дням в ПВЗ
+	// (GET /analytics/pvz/{pvzId}/daily)
+	GetAnalyticsPvzPvzIdDaily(ctx context.Context, request GetAnalyticsPvzPvzIdDailyRequestObject) (GetAnalyticsPvzPvzIdDailyResponseObject, error)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Каталог типов товаров
+	// (GET /product_types)
+	GetProductTypes(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error)
+	// Добавление типа товара в каталог (только для модераторов)
+	// (POST /product_types)
+	PostProductTypes(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error)
+	// Изменение названий и активности типа товара (только для модераторов)
+	// (PUT /product_types/{code})
+	PutProductTypesCode(ctx context.Context, request PutProductTypesCodeRequestObject) (PutProductTypesCodeResponseObject, error)
+	// Поиск товаров по штрихкоду во всех ПВЗ
+	// (GET /products)
+	GetProducts(ctx context.Context, request GetProductsRequestObject) (GetProductsResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
+	// Добавление нескольких товаров в текущую приемку одним запросом (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(ctx context.Context, request PostProductsBatchRequestObject) (PostProductsBatchResponseObject, error)
+	// Выдача товара покупателю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(ctx context.Context, request PostProductsProductIdIssueRequestObject) (PostProductsProductIdIssueResponseObject, error)
+	// Возврат товара отправителю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/return)
+	PostProductsProductIdReturn(ctx context.Context, request PostProductsProductIdReturnRequestObject) (PostProductsProductIdReturnResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+	// (GET /pvz)
+	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
+	// Выгрузка товаров с данными приемок и ПВЗ в CSV или XLSX
+	// (GET /pvz/export)
+	GetPvzExport(ctx context.Context, request GetPvzExportRequestObject) (GetPvzExportResponseObject, error)
+	// Потоковая выгрузка ПВЗ с приемками и товарами в NDJSON
+	// (GET /pvz/stream)
+	GetPvzStream(ctx context.Context, request GetPvzStreamRequestObject) (GetPvzStreamResponseObject, error)
+	// Получение ПВЗ с текущей приемкой и сводной статистикой
+	// (GET /pvz/{pvzId})
+	GetPvzPvzId(ctx context.Context, request GetPvzPvzIdRequestObject) (GetPvzPvzIdResponseObject, error)
+	// Ячейки хранения ПВЗ с лежащими в них товарами
+	// (GET /pvz/{pvzId}/cells)
+	GetPvzPvzIdCells(ctx context.Context, request GetPvzPvzIdCellsRequestObject) (GetPvzPvzIdCellsResponseObject, error)
+	// Замена ячеек хранения ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/cells)
+	PutPvzPvzIdCells(ctx context.Context, request PutPvzPvzIdCellsRequestObject) (PutPvzPvzIdCellsResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
+	// Создание заказа покупателя из принятых на ПВЗ товаров (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/orders)
+	PostPvzPvzIdOrders(ctx context.Context, request PostPvzPvzIdOrdersRequestObject) (PostPvzPvzIdOrdersResponseObject, error)
+	// Выдача заказа покупателю по коду получения (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/pickup)
+	PostPvzPvzIdPickup(ctx context.Context, request PostPvzPvzIdPickupRequestObject) (PostPvzPvzIdPickupResponseObject, error)
+	// История приемок ПВЗ, начиная с последней
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(ctx context.Context, request GetPvzPvzIdReceptionsRequestObject) (GetPvzPvzIdReceptionsResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
+	// Получение приемки с ПВЗ, историей статусов и товарами
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(ctx context.Context, request GetReceptionsReceptionIdRequestObject) (GetReceptionsReceptionIdResponseObject, error)
+	// Повторное открытие закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAnalyticsCities operation middleware
+func (sh *strictHandler) GetAnalyticsCities(ctx *gin.Context, params GetAnalyticsCitiesParams) {
+	var request GetAnalyticsCitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsCities(ctx, request.(GetAnalyticsCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAnalyticsCitiesResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsCitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnalyticsPvzDaily operation middleware
+func (sh *strictHandler) GetAnalyticsPvzDaily(ctx *gin.Context, params GetAnalyticsPvzDailyParams) {
+	var request GetAnalyticsPvzDailyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsPvzDaily(ctx, request.(GetAnalyticsPvzDailyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsPvzDaily")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAnalyticsPvzDailyResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsPvzDailyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnalyticsPvzPvzIdDaily operation middleware
+func (sh *strictHandler) GetAnalyticsPvzPvzIdDaily(ctx *gin.Context, pvzId openapi_types.UUID, params GetAnalyticsPvzPvzIdDailyParams) {
+	var request GetAnalyticsPvzPvzIdDailyRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsPvzPvzIdDaily(ctx, request.(GetAnalyticsPvzPvzIdDailyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsPvzPvzIdDaily")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAnalyticsPvzPvzIdDailyResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsPvzPvzIdDailyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDummyLogin operation middleware
+func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
+	var request PostDummyLoginRequestObject
+
+	var body PostDummyLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDummyLogin")
+	}
+
+	response, err := handler(ctx, request)
 
-type PostPvzPvzIdDeleteLastProductResponseObject interface {
-	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
+		if err := validResponse.VisitPostDummyLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPvzPvzIdDeleteLastProduct200Response struct {
-}
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(ctx *gin.Context) {
+	var request PostLoginRequestObject
 
-func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	var body PostLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
 
-func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+// GetProductTypes operation middleware
+func (sh *strictHandler) GetProductTypes(ctx *gin.Context, params GetProductTypesParams) {
+	var request GetProductTypesRequestObject
 
-func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductTypes(ctx, request.(GetProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductTypes")
+	}
 
-type PostReceptionsRequestObject struct {
-	Body *PostReceptionsJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostReceptionsResponseObject interface {
-	VisitPostReceptionsResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductTypesResponseObject); ok {
+		if err := validResponse.VisitGetProductTypesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions201JSONResponse Reception
+// PostProductTypes operation middleware
+func (sh *strictHandler) PostProductTypes(ctx *gin.Context) {
+	var request PostProductTypesRequestObject
 
-func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var body PostProductTypesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductTypes(ctx, request.(PostProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductTypesResponseObject); ok {
+		if err := validResponse.VisitPostProductTypesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions400JSONResponse Error
+// PutProductTypesCode operation middleware
+func (sh *strictHandler) PutProductTypesCode(ctx *gin.Context, code string) {
+	var request PutProductTypesCodeRequestObject
 
-func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Code = code
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PutProductTypesCodeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostReceptions403JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProductTypesCode(ctx, request.(PutProductTypesCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProductTypesCode")
+	}
 
-func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutProductTypesCodeResponseObject); ok {
+		if err := validResponse.VisitPutProductTypesCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+// GetProducts operation middleware
+func (sh *strictHandler) GetProducts(ctx *gin.Context, params GetProductsParams) {
+	var request GetProductsRequestObject
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProducts(ctx, request.(GetProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductsResponseObject); ok {
+		if err := validResponse.VisitGetProductsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister201JSONResponse User
+// PostProducts operation middleware
+func (sh *strictHandler) PostProducts(ctx *gin.Context) {
+	var request PostProductsRequestObject
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var body PostProductsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
+		if err := validResponse.VisitPostProductsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister400JSONResponse Error
+// PostProductsBatch operation middleware
+func (sh *strictHandler) PostProductsBatch(ctx *gin.Context) {
+	var request PostProductsBatchRequestObject
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var body PostProductsBatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsBatch(ctx, request.(PostProductsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsBatchResponseObject); ok {
+		if err := validResponse.VisitPostProductsBatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
-	// (GET /pvz)
-	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+// PostProductsProductIdIssue operation middleware
+func (sh *strictHandler) PostProductsProductIdIssue(ctx *gin.Context, productId openapi_types.UUID) {
+	var request PostProductsProductIdIssueRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdIssue(ctx, request.(PostProductsProductIdIssueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdIssue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdIssueResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdIssueResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// PostProductsProductIdReturn operation middleware
+func (sh *strictHandler) PostProductsProductIdReturn(ctx *gin.Context, productId openapi_types.UUID) {
+	var request PostProductsProductIdReturnRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	request.ProductId = productId
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdReturn(ctx, request.(PostProductsProductIdReturnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdReturn")
+	}
 
-// PostDummyLogin operation middleware
-func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
-	var request PostDummyLoginRequestObject
+	response, err := handler(ctx, request)
 
-	var body PostDummyLoginJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdReturnResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdReturnResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// GetPvz operation middleware
+func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
+	var request GetPvzRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDummyLogin")
+		handler = middleware(handler, "GetPvz")
 	}
 
 	response, err := handler(ctx, request)
@@ -819,8 +4263,8 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
-		if err := validResponse.VisitPostDummyLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
+		if err := validResponse.VisitGetPvzResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -828,11 +4272,11 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(ctx *gin.Context) {
-	var request PostLoginRequestObject
+// PostPvz operation middleware
+func (sh *strictHandler) PostPvz(ctx *gin.Context) {
+	var request PostPvzRequestObject
 
-	var body PostLoginJSONRequestBody
+	var body PostPvzJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -841,10 +4285,10 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostPvz")
 	}
 
 	response, err := handler(ctx, request)
@@ -852,8 +4296,8 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
+		if err := validResponse.VisitPostPvzResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -861,23 +4305,44 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	}
 }
 
-// PostProducts operation middleware
-func (sh *strictHandler) PostProducts(ctx *gin.Context) {
-	var request PostProductsRequestObject
+// GetPvzExport operation middleware
+func (sh *strictHandler) GetPvzExport(ctx *gin.Context, params GetPvzExportParams) {
+	var request GetPvzExportRequestObject
 
-	var body PostProductsJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzExport(ctx, request.(GetPvzExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzExportResponseObject); ok {
+		if err := validResponse.VisitGetPvzExportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// GetPvzStream operation middleware
+func (sh *strictHandler) GetPvzStream(ctx *gin.Context, params GetPvzStreamParams) {
+	var request GetPvzStreamRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+		return sh.ssi.GetPvzStream(ctx, request.(GetPvzStreamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProducts")
+		handler = middleware(handler, "GetPvzStream")
 	}
 
 	response, err := handler(ctx, request)
@@ -885,8 +4350,8 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
-		if err := validResponse.VisitPostProductsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPvzStreamResponseObject); ok {
+		if err := validResponse.VisitGetPvzStreamResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -894,17 +4359,17 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	}
 }
 
-// GetPvz operation middleware
-func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
-	var request GetPvzRequestObject
+// GetPvzPvzId operation middleware
+func (sh *strictHandler) GetPvzPvzId(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request GetPvzPvzIdRequestObject
 
-	request.Params = params
+	request.PvzId = pvzId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+		return sh.ssi.GetPvzPvzId(ctx, request.(GetPvzPvzIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPvz")
+		handler = middleware(handler, "GetPvzPvzId")
 	}
 
 	response, err := handler(ctx, request)
@@ -912,8 +4377,8 @@ func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
-		if err := validResponse.VisitGetPvzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPvzPvzIdResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -921,11 +4386,40 @@ func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
 	}
 }
 
-// PostPvz operation middleware
-func (sh *strictHandler) PostPvz(ctx *gin.Context) {
-	var request PostPvzRequestObject
+// GetPvzPvzIdCells operation middleware
+func (sh *strictHandler) GetPvzPvzIdCells(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request GetPvzPvzIdCellsRequestObject
 
-	var body PostPvzJSONRequestBody
+	request.PvzId = pvzId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdCells(ctx, request.(GetPvzPvzIdCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdCells")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdCellsResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdCellsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutPvzPvzIdCells operation middleware
+func (sh *strictHandler) PutPvzPvzIdCells(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request PutPvzPvzIdCellsRequestObject
+
+	request.PvzId = pvzId
+
+	var body PutPvzPvzIdCellsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -934,10 +4428,10 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+		return sh.ssi.PutPvzPvzIdCells(ctx, request.(PutPvzPvzIdCellsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvz")
+		handler = middleware(handler, "PutPvzPvzIdCells")
 	}
 
 	response, err := handler(ctx, request)
@@ -945,8 +4439,8 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
-		if err := validResponse.VisitPostPvzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutPvzPvzIdCellsResponseObject); ok {
+		if err := validResponse.VisitPutPvzPvzIdCellsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1008,6 +4502,104 @@ func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId o
 	}
 }
 
+// PostPvzPvzIdOrders operation middleware
+func (sh *strictHandler) PostPvzPvzIdOrders(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdOrdersRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdOrdersJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdOrders(ctx, request.(PostPvzPvzIdOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdOrders")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdOrdersResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdOrdersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdPickup operation middleware
+func (sh *strictHandler) PostPvzPvzIdPickup(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdPickupRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdPickupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdPickup(ctx, request.(PostPvzPvzIdPickupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdPickup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdPickupResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdPickupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdReceptions operation middleware
+func (sh *strictHandler) GetPvzPvzIdReceptions(ctx *gin.Context, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams) {
+	var request GetPvzPvzIdReceptionsRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdReceptions(ctx, request.(GetPvzPvzIdReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdReceptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdReceptionsResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdReceptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostReceptions operation middleware
 func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	var request PostReceptionsRequestObject
@@ -1041,6 +4633,68 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	}
 }
 
+// GetReceptionsReceptionId operation middleware
+func (sh *strictHandler) GetReceptionsReceptionId(ctx *gin.Context, receptionId openapi_types.UUID) {
+	var request GetReceptionsReceptionIdRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionId(ctx, request.(GetReceptionsReceptionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdReopen(ctx *gin.Context, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdReopenRequestObject
+
+	request.ReceptionId = receptionId
+
+	var body PostReceptionsReceptionIdReopenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdReopen(ctx, request.(PostReceptionsReceptionIdReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdReopen")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdReopenResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdReopenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PostRegister operation middleware
 func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 	var request PostRegisterRequestObject
@@ -1077,35 +4731,147 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZ724TRxB/ldO2H6hkcFL6yd/aUioqpEaUUgkUocPeOAe+P+yt0xpkKbZbKCItVYWE",
-	"hIoo5QUOJybGiS+vMPtG1cze2Xf2OXYSKzWon+y7m92dmf3NzG9277Oia3uuwx3ps8J95hfXuW3S36+E",
-	"cAX+8YTrcSEtTq9t7vtmmeNfWfM4KzBfCssps3o9xwS/W7UEL7HCjYHgai4WdG/d5kXJ6jm2cu36+MxF",
-	"S9bwlztVGyeAvyBUDehBGwKWY/AKAuhDTzXPwkvoqCZ01Ca8US21Cdv4/TkEsIsyaiuxaKxdjlklnH3N",
-	"FbYpWYFVq1aJZYgJXrZ8KUxpuc4FU/LUoJIp+Vlp2Xx85Ij5ZE2m7cItVYty3H6c+ypOPeOCR7CoyD00",
-	"59Js8vrFcCPUb7AHHfS82oQQ+tCFnt6SEHagA29hJ358o1rQzvT/iHvoa1q1LGddib+foru8jXszOsqX",
-	"pqz6SVdZzk1PuGXBfZ/lWLHi+ny6LwaWxGsPZs5yyVX3Dncywi/Hvvd5RsBy27QqKXP0mxPgya2k8MFt",
-	"r+LWOOpvuyUuTOmK6VbHWtBs44aie3mxKixZ+w6TkjbmFjcFF59X5frw6WKs7zc/XEXXkTQrRF+HBqxL",
-	"6bE6Tmw5ay5BiPtFYUX4wgSDGaUNXdUwYAf21BNDteBAbUIAbQqBPnTVEwNewp/wzICuQR+70IF96EEI",
-	"7wzVhBDzFQVKG9e2ZIWUMYt3uFMyfC42rCK6aoMLXy+8fG7p3BI61vW4Y3oWK7Dz9CrHPFOuk+H5UtW2",
-	"a5fdsqVDwfUpg+BGm3FosxXXlxeGctrf3JdfuCXKrEXXkdyhgabnVawiDc3f9nV86eQ/jqD57PekfU6J",
-	"SVHl9ML3XMfXy3+6tHQk5T8WfI0V2Ef5YWnLR3Utr4OHFh3Z/NeqAQfQUb9CHwLc5ADauJu0wbsQqAe4",
-	"97hLn81RH11ks/R5AR1oEyD76jG8M6i0IdxC1dDRUbVtU9RQ9iWEsKda6qGGKHQMqo6NCI0hbEOoodkj",
-	"iYAmyFemo2m+QDpCKvJM3//RFaXpPCOeYjDiw8DY8qljrGNoCKlm9IhlHfr6YRRyf2RpbsABIXELdqM0",
-	"2IQO5lGNN08zH/9wyK3EUvNC3ez1/BSJj1bqeFCdHzRiLpoFjn/iSoY4COHNsAguRhI0oAt7WIP7CFmM",
-	"pZ5qQhfa0KdSnKrNXa3z+VPQ+Skqp5rIHIb6dtQj7bkErWGFG2lCc2O1vpoKsqdpv8eZPWYYgQFtyvTQ",
-	"Uy31SLXU7ymrVcs4Q+IYkT0IB6SmASFCWrVgJwJ1CO2I1nwSxerGPXRBmWdE6ddcrmzco5QrTJtLLnyy",
-	"ZWzzAvUQAlo9ync7lBIC/NNFz1DHFlJFQlbGCuxulYsayzHHtHUQmUJSF5ZLbMxs7diYQs9pqY56eGx1",
-	"uFOalzIvIIR9hLZBaNmkVNtVD9TjCWt72EwnFy7xNbNakaywnGO25Vg2Jq3lwdqWI3mZi4me2IMuZXtk",
-	"CW3kBzrZ7SPSNMgwtIIR9aAzQb2KZVtygn5LOWabP2kFzy9N0Xb1hKXZktz2M6vA1Gx47XqqXfYPmy5R",
-	"ywYiM6XagcmmEGYtteC0OYbdcL2e0Rmm551BYjx5vYIDbH+QKUb5YFEY73uYvjNoeSPybw+zjm4jVcNQ",
-	"P2MtU1s60JBLQYcqmM7ZRKNG6pnuPiGAbehSOosGEXeezK0obR+XVk2NnVMmL/GSIzsYuxVC2NUU9n8M",
-	"HxvDr4ZeJARH3s3kFbCveTGBWDcHIbSHhCJ/n1hvPU/nYjcrpi9vpnLfocBdwbFf4sjLpi+HqXCMhlB1",
-	"8ky5nqid0bFaGpyZVTy7NzhxVZo1rWfAORH2gd7OntpUj5G5LBgTP0ipqlrwFhGTofF7FgTPEhZQEBzQ",
-	"zQTSJSTQlKtD1RzIjLcfIweDRNyRU5Gn1C+DWjsaKSVe4TIKFS9xcTA1UC7QQIyUmHj8p3EysbekHiRY",
-	"pL4yN2NHOdJ/jm7w4Px4YN7wbOc9g//rpA1Z8N/WNSDdrPaT546DhrULu4mWNaI5KbeeuXzp4rc547iN",
-	"a5q9Tw6UK0O50z5oGjkRWoyjoKMUoSS3WrgiRB2t2qK4TNceOqBMGvJhMLJ+dMg/reacOX5IlS1fRneL",
-	"hwRUJLVYtwXzvq4cLJU7yY3W/OKWLn2z26Cso/ithWyM0ncLf1NJ6cYHT9PvFur1fwMAAP//cXjmscgi",
-	"AAA=",
+	"H4sIAAAAAAAC/+x9a3McxdnoX5nawweoM7oYCFXom7Hh4JQBlU0cCh+HGnZb9oTdnc3MrLDsoypJG0GI",
+	"HesUSYoU9QK5Vb3vt3e90uK1pF3/he5/9FY/fZnunp7baiXLsB9CLGl65unu5369X6sHrU7QRu04qq3c",
+	"r3W80GuhGIXw08W219yI/Xr0Thi06C8aKKqHfif2g3ZtpYZ/wEOyhQfkAX7q4AM8xGPy0MHP4LcjPMEH",
+	"uF9zaz599nddFG7U3Frba6HaSm2NvtCtheh3XT9EjdpKHHaRW4vqd1DLo19aC8KWF9dWag0vRjW3Fm90",
+	"6LooDv327drmppsA92FgBW1CtvERHuIDPMajbACdl/EAH+Ij8oh8iUdkBw/xEXmIx3jySgbscXBCyN++",
+	"2wnC+B3+XAr0f+MJ2cLHuE92HPJ73MdP8VH2QbK3qAA00JrXbVII6tF6za2hdrdVW7nJf7rbjO7WbtnA",
+	"Wr3x8VU/ii/58YYFqj8DVOzI8A/4a/xNBkR1ul6Fx49RC9BJAIL/Ay7nEA9gW/gfuI/H+JDsLABG7cD1",
+	"PCY9soX36d+/xX38hD5DHlogl7/wwtDb0HbSDaMgtOzlW/pusk135AgsIT3yiHyFh/ipQ7bJDtkCoEbk",
+	"C/LAwSP8xAEQ9vEEH+EJoEzf+WjhfXQ3XmDfcfAzsgVvegBvo+/axxMHT8gOHsC++osO/o4j2BOyJzCR",
+	"4mifHgbZoeg5wMd4SGHAQ4dsOx3vNso6arZB9bAzr/XtduMyRUjLaeAJHuMh+RKPcZ9CdUAhodd8gEe4",
+	"j5/B8dNnspAQ8XdnksFC7LfstJBAdzWoe82Tg0gPdeLIIxzjCT4mPQcP4KCPKQ/AI47B1WkfqXBWo3q+",
+	"03e96GI99tfRNVRHfIOp/f4VUPChg4/wyCE9AS49A0DDQ7JFHpAddhbPgJnRvR1m3tCd9FctWPNpEDSR",
+	"11bBveq3/DjjRo7wiHzJz3mAJw75Ez4SZ0x2KJU4cCM6PeFhBoxN+JSVk11Ydmst767fogzkNfqD32Y/",
+	"XJBH7bdjdBuFKvAfhA1ko//vKMoARAOAmAqIocMYAtnBI2B0FDNGGaAG8GIrqDUvqitMl/1Ev5/LdFcp",
+	"kdsAnQAmb6V4UgZgnFnYjrDsoa2GQaNbj6/HXtyN7JKVIhp+Qh5IngW3TUnnkNKeipAjysIYMuA+bOCY",
+	"kt/AIX+Ck96FncFremQb98lu1s40sKzy5aUQrdVWav9rKdFplthj0ZK+qxyhwR/8EP58KptPdr6DR5Rb",
+	"4UH+ngEW645Li0JJ9pdC5MWo8dbGyTfnMtHGeRF5gId8a/gYmDATc3zzwFvpyoythmkArey12/UbeexV",
+	"bnRm+CtwdYKPdVwdFm3FgquCLfjtTzphcDtEEf17vRlEKJc/XA/COGMvRxmsayWRkiD69vFIMBHyBR5J",
+	"IehK2cg0ElVvptqQeRwjEEr0pNICIEH1CR4sOpqiRV+ND6gWTrbwj3hEH6RaEdkme7YLsG1qyKU729gw",
+	"Y2MZ9xLRM7Tz7BDd9qMYhahxMVaYt/HrpqfgF/yGE+iloNuO8+8v9sI4Q7n5DvfJl7jPVY/pNLBIvn96",
+	"HQxgzNPCKgFaRQ/L29QJ1a1MNvB33MePgYEBFjJVi+tZlLjxU7pXss32QbYpggOR9By8D7j2ezzCh6SX",
+	"DXwW8Qcd1BZU37CjjUQzsGPgwUyWnWKz5KHrJGoiHpA/MANYIeRMsOviW9W4rw5vgYyxAyxlSUWAp5QX",
+	"GsSZWPKPhN8bjLD6tZfk+ZtiLXPBNBqowXUS+rPXbH6wVlu5WUrbqW269ymP6qAw9hG8r46azSJd6Xoc",
+	"hN5tdIk+uunWGt1O0697MbId0D8FxycPuLIzpOL/R8qc/wBMeUR2QVgcgASlNsEB2SI9YNu7KZOGPCC7",
+	"+kn3yS7FDvyYPAD7b8KFFHmAj/Ah4z0TJpilOt+H94Lo3qGIxQn7/1Kyq6IuWlWqxPVzUz2b5CqDT3+L",
+	"6Npbm27trW60sXrj49qKeRFcdKhqnFTG3Vpn/d6VRglEdhNtw/omA1z2Wm2Rm0CS3oFbo0hwsdkM6l6G",
+	"qfo1eQB8dEvwyMdw15TfP3XIHtUP8FOmORzgo8ReHZM9EPr7ut7QX3HW/GbzkzU/jGIHP2YOErKjvIr0",
+	"KKbRW6aqJnPqAcc4dpiYgTUTroXCf8gW2cMHdKnr0C1+cjsMuh3UoDCPhVTDfeUjVP9g6nlfA49xVo7m",
+	"+vYeU0yWqwDXBOknW+JXKACw+rMu+RRJrqEOV/oMCvbFv0phMnXn8VelkNmtrTURiotewZZfClodL/Sj",
+	"oA0LuUe4QBBT7ELrftCt5EIm29w4Hws1ER9zVEl5l0sDUNVNXBqIfC9SIXxxUE6hUemYu8/BE60dsLZd",
+	"V+CKuGcrgSf4YUO1jdN03Na7YYjaJfGPyuhIvdBfI/RZeZGoveSWmxb0qctmOKlctaMetbPgKEdtXhB3",
+	"gosNGkBb7wEUL80pWHZjYkla2jf8qB6ijteubxQd8uXk0YRdRN1Wyws3yjpX+NPmYYi32CXkZc9vbrB7",
+	"sdCnYXsqvJj65Z/gfkKw1QwO/aC8dURVnsR7gOpBu2HXCJnDnsovMICOdJoXntscnWaCD0E1oVbGIenh",
+	"MT7gji9+PO1u61OmB9R1rLAChA9V2xlCFWPmX+qBHqaCgic116JuNLjBV4KZJlqL12j4FAivuaqdpeX1",
+	"xb5jVSuA89J9CdwK47IVvEgpCgrzTinnm/xKTHcW2dXxq+YWaVf80DTlKnWBbiauFWhil1ETxZotYNA6",
+	"+/vFuKzl74ol1Qw10qPoSg9TGmrJXdXcYo21k+yglApu6rD8966yY+t5+S3UjjLQ4c9g/ffBPNkhDzg5",
+	"9iFuAUol9yftpjjFHeTfvgPA57nT3VoTtW/Hd4qf+9xvFD9mHAF/t1jsCqDsx2DydasvhGyTXTzBPzJs",
+	"xyNDUbeRJFXCJ+DJO5ACE5z7KVLVTxDdjUMvk9fzzzFrYki2yK7xFaaDlTXk3vPa/hqK4isxatkU4JYf",
+	"RRQv0+B8Lz96BA5G6qMA6xMsS+1sZgOMcckCMpcfmO1y3w5DFuDWT7iFoogHlPL1SPGg7d1X2lEH1TOs",
+	"vr9TW4f0KEdgHhIHNMNjPGGEo9lMwqGrmfXDRQf/hRmEwHYhrD4G5BtbzEK+mGrYzL6nDoE9aYtxl6Sb",
+	"Y2Ry+4wuAQL/kgXTqI43pDf6GNj+Q36/ByAvfqSwLIIpp59wiLyIHUzLu3uVk/ovlpct7C6SviUZE6xT",
+	"zo8oc2x4Le82YiY5Pfgsf6CmTrEX2u5MQ7CSQVtDzgrjfV8h4tT26+DwLuRtsT2I9i0o01bzmusvh8yt",
+	"DDixD9/PPxL4q8vBsh1MViT4G6oaUSuFhSeoNvZMotQeg0Y/ITfNFxlC2hXLeo4v9B+MWkCgUkw8dCHm",
+	"gZ+wfBDhBX0iYCwjXKm8/5CK+9JKgF/Oz+RHUVdoFykXkAwfUUP+ABwqIwVwIMwxc+P0FHbOM26AOpWH",
+	"a25Z0AGmsmcrgJvyZDt+/bNu51LQyEZpHvXsUQJjQtSFQBk4IbfAnoQLprzrK9j+jg3vHlEs8uIYhfTd",
+	"v7m5vPDmrftvbL5UpJCfzLNZ3uloEB88IjyLyikpyFig2lodpKfufKCaeVSE0KrtBEoRz8QpY2u+/O67",
+	"K++99wpIcK/VadLvv3phZXlZv9+Xby5fuAWX/P9evbm88PqtV1ZuLi/8gv3qpROQLI0zFW5QsXlmssHl",
+	"N2ewQRZ4DcHnfNlmm2ayBPr7j4O2jUb/k2q5wMonzLEzIXtkW254QJMtZeYlHjpXLr5/Udva212KoEvv",
+	"BVE9+LyQLAB/M7D9Moo9vxmlkd5Lp4aV8v2sCvJiZFzIBG58bLh37MGJtzZEHkx5Yz/HLmc+Lvuy5KkP",
+	"g9hrlghmmCssX3LNnaTvIx0hqbk5Hiu4vnf8pkiRttowjD7o/8YsLYaGikFVZm4ra26DRcviHLBsuo8r",
+	"EzFLkwsy8i4LHUB3rOmLZv6gyzPkbDB3zPyy8tvr6LlZ5ReG1vSn8rG1BNjUMxE3qW16f5LyUe42olQG",
+	"RuF9RFmQmUYDS35h15KB1iIJUcfCtQTZC1iKIItNxRwup5Pc+FjxkFnurymyUC1+Fr/9mYWRttHd2Oq9",
+	"VeLGLDdVTf+m/zVTLXvMMUi2SQ/+u4MHpMdSRoawemSs4LkvSVyJeoqt4iJ1CZ2qaaDZsAmTmwdQd8Gw",
+	"E/lTh0leFiRWWLi4YMJl/KgijwwyvNinyB75igX4nzFrT2OBxb5UhjcCDlcmB7PrdiVWZqCy7jE3hFtp",
+	"+ah7lEuhslUi5/p5mMRRPmXdUpbX91MvrNtNkv/Ssy9k4p4MkWs2lyNztiSFDGuu6t1443WbEj1Vqs8B",
+	"pAnQcNu0PuTqZm4D1ZteiBo3vGYXWd19j8kfyZ5MIWEJboDjExDpMrIzYMmPz3jknzmJJRB+O37j9ZqS",
+	"bL1sDbhozun8uJx8soKxrvnu8l6vePlUhC+ZfJJInylyr0/DL+TWPpf+edPmoRwLbm+fp2If87ur4HmH",
+	"P+vn5OY55PjW3/Li+p38HCAD2r9IEjlSFUkt+jnQcltEpI8yfip8+mW90lqKWSG3yrXj2R+vZmcLyXQx",
+	"x0yoA5tMzUnWPSdDh7qHNd9bSl+uGlhypxEFpQVAEaNPjJO8syyVmajnT6lnxJBETaN0HbIrNQiWfJ1o",
+	"KxZ/h5nyL5xoY6vnSkqZAfgxqbugDz6+MfM0iKobETJ/tOjg78kOfgy/P2Tb4Oj+VCoxamhBJQLzM0k+",
+	"uVipbccMQYy0HC16Mf46YgQdhPAP5mCEW4u7YTsjX8tIf7DcFcB5wLTOZ0ZkAx8bUC06+G88agEHSbXM",
+	"Pk+B/CM81HfwKCWa2K9YBhK4FuXdGp9LgiQKgbEw2xjKzYROQG/BFvyQ8Qt7sJ+HNOx/LCGFvwLJiycz",
+	"FshpYPJTMGVAxvrXbpvLWNSwbEPNihV5gVtmyAz3dczuW4FcD5rdVvZRgR9bYgY7C9LDj4WKThEKiqwy",
+	"gtsljilToioQDDKFa+EHsgSMWxAn0+9AgilPzES2HCZLHQpvt2Mr7f6TaiMldBFe2kvZzQ7kkEmJPcLP",
+	"KBrwHGVJmaQn6D4rwp4EnCd4IN6mpVtTgmUxd0WlllFMUWdsp2HqxLH7boRBYSj/Lb8tfrxg4YI0AT43",
+	"L6hgvaVG8wmXBiPByPABNXDJLvXzQyRAqEbHDs8x70NaLhR3HfLKOunAvV9D9NXX7wSQExl2BRL38IA8",
+	"tPguTY8ui2qwjbriCG14pTnHDKfeKRVzFOrq9dOqynh+McnyWfLpIHzpCjwzw0sEtHjAK0f5l1iQ6e5v",
+	"aDldNjXvXzwDpbQNQHrKihHZK2sFGOll2d7XoiqQNEgKc6oMVn648hSVdxZKavptVHrRh2JBZqSC4U1N",
+	"eberCjwTHXKRyu6treZ4TXIQRepuJd9rR08ymtZ9aKZhnqIbkdfHsz3lH6+C7dlW+0lxeAZmpcWgLMCc",
+	"a4hGhu1Zbz+ArgFGAegdQyNCnMontlUIszVKFIy9CCp0dItsmA56gXysksWqStRiz1jZqiqR32X5Ez29",
+	"aiCKNaWAtKVXKB/V3iYhzb3w60Vx3ksinysnLjtbDL2UmaqVZqd2NrLPWhFBKfrQNKL7kM8HNV0a/rIF",
+	"WguVXAw0LR5brIVzrEPcT8EwxsP0104Bq6tjZAV8FAjnt29HRRyDe+rMnBKDQRyzYBQYoWwhdMZg6qZo",
+	"ssCdEoeJ/XNcVmmwcLoiv2aKvGC3dvw0quHSKv5Pt6ypfD2TClhBXY+lkQgLSalAVqjucdOFQgNeE5Mi",
+	"9k+7kY8ie28N1gpAVJo+ZjaSqDS19L/Ax0UWfVkEFlXDBVr4eavA6azfi6rGjJ/AB9QuHiIlhvuBOROg",
+	"5z2m4fh0CWheMlFRstEqCi97G8pTovYq7byPzNqe1Fs0ZV5glo041Ar79Hn9t6wq7ifOelmVoQRIeIMW",
+	"alT9SP0+XOAkmDpM5XNLL5Ylp7uR4WYEHzsrk5a9srTKZ9HkC3r8HOE+LTvTg8evvXoCK5+d6ZVcRys9",
+	"DwWooZpwXFCYXsaFUT1iafGy81sQDQF5qY1Wdd4vpw7CXeUg1lVvI+jGuag1ykKtzLgiUPFYMeANj6LW",
+	"JSC3JF3vKUCVHdRslrenjP4UuXJdgUp8xnZuHwafIbu6/6sIWSpsUMvzmxr2st+cwOIImkj1T6FWpxls",
+	"IETpKGig0IuDsNhBJaCAt1mTHSNU74Z+vHGdHiaXgcgLUXixG99JfhLdSWu//PWHopsJuInhr8kG7sRx",
+	"hzUt8dtrgTX2BQX+lLsLiqAhQ737noJ8o3RYOCVDYz9uAjBe/TPUbjgRCtf9Oj2qdRRG7MMXFpcXl4Wm",
+	"63X82krtNfgVpCbfgY0veaKH7FLSVeE2ijP7FHBVhFcI0RJIatzS8qHX3njDES2zaOMrYIIHTNfaF61T",
+	"YXcD5v3fhjBMus1AEh/Y1iUd9B/jBvePCgA0CYxsU884/NQDDeqYaS2Ubg84f/4CuDM1xCFISOO9rJgN",
+	"HwhvL/kSPvCYPGB7GSl/50mreADin5fFrST1VlpHBAqusRZaqKV7pEKoLwFiT7LN15bleToLDiRki22P",
+	"GZJQPeUhDTkry1mDvS2W9k8FYA0QgGWSUwlS+z8olp2DL4n2CGrb4wyFO3lkSW+LvOmWX0BV6luUYqNO",
+	"0I4Yvr26vMzEbzvmRoPXYU1l/KC99FtuYSTthAqafSSdQ4AqsyPRlDZen+G3WS2i7aM0Lga1nCKsr6Sp",
+	"MCheOwMo/sIDxj38LIFgyJITNM4IKKDyxJu3Nm8lOeG2eH5C4iyez4tgKa3zjsKU2mnFhN7ojhNP2h6e",
+	"4MErAJTCojrr95YatFnC9FwK7zMYc6lidf0e9GR4weiiXJQjaTaR1hws5MIj9SOWYMEuHEzTQ5XBZ7Q+",
+	"nxPYtARW0PaDh4LHlN9rlHYsyjAtpHMfInabZ0VCq/RrGXQEjeOoFqK0epWduQr7ymd5jef0OafP6emT",
+	"QvH6GUCRdDJ3QEV9ym7nNBnEQOMKjW6rtXE1uO3DdjoBc/7ptLwaRPHl5DlGliiK3woaG5XOyOgaMBMb",
+	"L8u22zS5x+YpaprMYLZd8L/INtDZH0SP2L4MD4zA2/cFuA/OCfFt6mil12+zbIshzzkcqF60Q3iiz1Cq",
+	"WYxNs0WkCu6HjhdFnwdho7hETLxCrvhp4NiFM8exocNQiOzwH1nCNPvBRLn/b4M8o3862WP4xj2in9Db",
+	"UX0WKYVEyW+0mLklGqMLF0BWWmNGF1y/XW92G+hKm+fI5c6aOBPFIpXpWUa9+CfbpDUSwvINSW+uRUyt",
+	"5X+rZtAq0xCM8wYulslYDRSflr9Ww50yfPDCKX+/RJ6ykXc3R1VT4X3zDKCwXAwr2aH1LqMkcxn8u7xj",
+	"45CVNlSkJ7NmbCQZdaqabmDkr0/hmNKE0NJ9GpLaBE2lGxdHypj+z5o374mqHdeRrbuZorXNa1HVchY1",
+	"SG1kwy6mbPLVrsYfeFuaYmucZ3pnG+OmJnVrVppdXl7+STPs87PbC9Paz1b5m5rpUTVKph7OWd7zsPFt",
+	"13JCg/9v6q3ikXiZrBDh1aOKnppUxVlZ4NQMr4zCHWVwGUNNFsX7eZymShHOmarSV5X4fQlNWh8TIeZE",
+	"2WZEuGZX/qQAak7O07vU8QSivof2vC7jHiC1TvrVya70oJVQxaOZuTmU5hbVatF+Is0epuziUL4s6oVv",
+	"xMC2Op2uMjsDTe+gkMf+zqlNpjSHgeZBmhhNVXj8/Cw4pWmFYrhZBxwZJpzZgAEPK/LtDKNOs+XAO0h7",
+	"OrEGVnqFpl3TgeZPWoNUJgyAzRsKz9Knsn1JYE2VVqW7iuBkLzHclNbCNKOLpx6y2giY2udyYaPg4khL",
+	"X3VtmYmiv5VIaeLpZBZTUBFQrB3LrKSUtR5sLshmIMhecPFkSzRteXevMByBbuEtv81/vDCzfryyFW92",
+	"MeBz8V8yqiuyDkwBSR7MReRcRFYWkXjMu58IyTeyVLQUSU4ufWTdKkcF2MTJpep9WdiwuQSNhnIk7Ne8",
+	"rzoc1zF0UB+LULSAQeTzs5pp/DQZrAtbduHfyaknf+FMM7+sd9HBP6i9JNle5SeTzksDB/8IXbjHrA0B",
+	"NSRF2dYQ0MN2SMesSUm2zF4Vh3UFjqpUTpVYcqK8qlun7+Qsshlkz625/+P5uDPlTaS9mGfPYQGIdBO3",
+	"ZAhF0oSNkSorDRso/T5dcwgDa+KUDG+oyIG/litTk18sPeJmyzpZa7Y83snRuGdyTyOY9FScWwEjPTec",
+	"8Brb+c+LFZp9Becscc4SpUapNKfM7kN5xIq5z5I9KkDsGAzS2hXzBCxy/Z4Sn0pneNGjOoZX8/lX5JF5",
+	"SEoXzWMYDLdNzRznZXU8hrN+4ZVFh+Zfa33P2Ug41hUwq0beTeL6vOtryT7sSm2gPmABar6UxkCsm0Tm",
+	"ttykcbu49X0AlM1hGDoXoe+g1gjTUfF3vd1Y9Nb9OFjorN9bXH/1f1OEXrQVBKyu30uz54Kk+9UbH/OR",
+	"9Hx8wKZbds3b7UbFFdf1iQOVPjXNMmhaVf7xq9CnqfzzdJhxtd2zjtSlwVcGUFReVflr14zxE1OsTOZd",
+	"lF/8bnrER4UjZZODyz7O5rJVuOBuGFFpMLNA94xHA5y7TmEpB2SqWr74iULeV0HL41NG7LWpz8CJD+OR",
+	"xTS9O8hr8OkjHy28j+7GCxwFLG7hZKiFIU54DWZqdMYz2j1beDuqDfPITgXbnCukJ8lRMMovtjlSHCbW",
+	"Ldk2pD3Ej4Z8ppfD/GR4aPiveFEulfUwQVQsKkhpWL93gjhRIR85Yz/8jY+tNyiOVY6enBtVJ6kNl6cI",
+	"GMxPd4p0s/V7S+iumNhsV+i/xwdcewZKmChzjVR3xb/LjB9LeiyIRhC8d45JgPBCau4dMevlQOu7D7+a",
+	"ME+G0rQLGvfTt8p+H+BzfszqTTJ057fZ9qtq0GwZ72BSURf+yWncc5X4xVSJq2m4VC0LOqh9t9VkTrxo",
+	"IVhb8+uoEdS7LdSOF6NOiLxGdAehuNVchP/XOad0/n3qtz3IUrUMLEN346V6tK6vNJ9L81fJL6gPZR88",
+	"GE+SMOlczkwhZ77WTrJvG86fVB0e622VQMMeKf2+Ll2/ITxmH129/lEigKI4RF5rGgGUOVaV/AlUaVb7",
+	"sYP7mSJmljJLjFLgM+BNPXAkfVkTpiuqXjktjamkQHOVv7GOqNDUFI/ZG0U3TmNaDR+ETx5JDRi62x7h",
+	"kTisDEF5nV3T3NU0F3w/M8F3d6HdqGwFqcNGs+whl9O6SECk3WZ/ef2D9xfktJ1Dysgdg/3NC4JPZv9L",
+	"42HACvsHhphLfAB6r20m4kbGqCvm4n//Mr24RKjxTkC5dTy8gc8Z9e451cBqMm48E9nnOPsCt8JJOc0U",
+	"P5lMb+P+sfTARd4cV2Zmb6daKk3w0xTtLMmOrUUUdAkefPHJSO+sa7vOvN66cwJ7cQmsTM9kKo9YG+4+",
+	"C37z4PLYzDsFoQQ+5+L+zNuQry9MB5shMkk35yaPXKMZljl4Dg+0jCURRie7WiNqsgt5TqLYgpqM/M9D",
+	"fCgD5xyxRI9o7gpUqzToQlv/6EUn2SzYbLoFe5wMssoaaJcu9X9eLGcmhf8zbZ9tb+1e1JD99OotbJP0",
+	"CssjlmfR2/tsuxZUlBIGGZ6XWoe5nDjjTLavJbcje9xDp80oGKpDHhL6qyjIvgHpo80coLw8S6ZNF7KS",
+	"CiKdX/RJ04viT7TUhdxYK2PfdOVVT/Fc/ATUR9hUQ83NsKChPjxKmxvlgoTcgny8XchOPBD3xUcnyZw2",
+	"1Zmojb5mXkuRICkzHOVQILItdAU8OB+MSKZx6nO1RLqndkI/t5KrH4qPxMEH4DrZt5YwTcE9tDGAWloM",
+	"sy9VnLNMBrTUXzGNGPRNrUur1rEZxmIyXqLM+C/mJGym6VVPul2fLyPJS1+WM1vPB+G5JescjapI84Ll",
+	"vA99JO3PjVI1QhlpE030xPOk+p3Ts1nm45bKca9I2eqMYStl7zP5r1dZ2oZKMXMgz+s0cl6+euWdD1zn",
+	"BAnukjEEocgNzCj9+UYcLOklBzeUA1a434wqfJwEDuWsOdWnJqJ7dKCIaCpF+7T+HqoqexzUPmxnzEUp",
+	"F7WjVCkB2VUvPNnfoqNwBF6lBBSolCmIsk15bryrwgiPlZdmFhBxtvgBO7YXzECWJUy6pVvY46Z8xb1Z",
+	"R5988HlX0PPQmIW3SAyf5++d56IoNSfiuRZIacLmVKRJKgEy+aC1HpTz1tT4STWLxNArXp6F8Oj49c+6",
+	"ndya++3UuH1tL/zskoldW5ylH7PgMZt5xeaUavMoYL/MNQxi9YheDh7r0v6hWdZr1p2mG1Prhajk0WkV",
+	"onI5sspO8AWTI8IZ2vHiGIUU1N/cXF5489b9NzZfmtZxebZOxjLSYN4z4PnKgu8S5iUsKV0P5Fq1ylDU",
+	"xphJu5SzExffsRLafK6n8JdU44ETdA0okhKP9K77NhV9JlJBr+sqCm4riUTnZciTBKlaUpu2rFKmmL5S",
+	"TK3/uafdT1foepoeaHlP2QV4qcI3LWOYumPtU8/T8xrmAufFzHX4G6uHpPdO9kwEUFKpoQuC7DZo8QUz",
+	"zqpz02yH7TV9rPos1LyW1/bX7JP9v8+NfDhazsWhmOXJpBETH3hCvpRJoUAPcP9u3vx1Vp2InwBtjeTU",
+	"dAiq19xy5cHv8T1RB4atRjhYR2HoizHua163GddW1rxmhFLo8n3iqScP1Ys+ZK2f6UaAGTyGzXJthVqF",
+	"PHU/qR+oFCd0LXMNTtTn8Hm7ZqqE9FQXzXz+4PNxxv9VNB2kbEqLlukM7xD3K/LOlONjzHhFYRxsasU1",
+	"Ya9L9+W/CzKbE1Z7LVlRSnsNtefPZ5hd7ik35Vm/5DkVPgdtReOLKa0F90+eBG2Q3HaivowSJUd2hoDe",
+	"mj024d5WR5BPcEshCjoorwmdIW8zWngarSYeWVqSSlcg/bXQwiq0oIMJa3z7LOvTyBR5muX2s3KOa2zj",
+	"Z84/ZjKOFXn8ifypKsb4VbbqeTsBK+odTBb1Za/dCfkCTLjt+VCVc8sBn08mEQPFTD7rSW+m7NoMZg6b",
+	"iT5OCraSfJDZalc/qHwLT/DQ5FvDog7GUyQ0hui2H8UoLLJe+VPna7yvO6N506m5wO5JRlDPzvL6VYQy",
+	"820ss3MfnssIuT4M+O9gaI9EC6riYcCbm/8zAHOc223C3wAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -51,11 +51,13 @@ func (p *PostgresProvider) Close() error {
 	return nil
 }
 
+// Execute and ExecuteTx are not cancelled with ctx: a write started for a
+// client that disconnects is still finished.
 func (p *PostgresProvider) Execute(
 	ctx context.Context,
 	receiver func(context.Context, domain.Connection) error,
 ) error {
-	return p.acquire(ctx, func(ctx context.Context, c *pgxpool.Conn) error {
+	return p.acquire(context.WithoutCancel(ctx), func(ctx context.Context, c *pgxpool.Conn) error {
 		return receiver(ctx, p.connFactory(c))
 	})
 }
@@ -63,22 +65,47 @@ func (p *PostgresProvider) Execute(
 func (p *PostgresProvider) ExecuteTx(
 	ctx context.Context,
 	receiver func(context.Context, domain.Connection) error,
+) error {
+	return p.executeTx(context.WithoutCancel(ctx), pgx.TxOptions{}, receiver)
+}
+
+// ExecuteReadOnly runs the receiver in a read-only repeatable read
+// transaction, so all its statements see the same snapshot. Unlike the writes
+// it is cancelled with ctx, which ends the queries of a stream or an export
+// whose client disconnected.
+func (p *PostgresProvider) ExecuteReadOnly(
+	ctx context.Context,
+	receiver func(context.Context, domain.Connection) error,
+) error {
+	return p.executeTx(
+		ctx,
+		pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly},
+		receiver,
+	)
+}
+
+func (p *PostgresProvider) executeTx(
+	ctx context.Context,
+	options pgx.TxOptions,
+	receiver func(context.Context, domain.Connection) error,
 ) error {
 	return p.acquire(ctx, func(ctx context.Context, c *pgxpool.Conn) error {
-		tx, err := c.Begin(ctx)
+		tx, err := c.BeginTx(ctx, options)
 		if err != nil {
 			return err
 		}
 
+		// The rollback outlives a cancelled ctx, so that the connection goes
+		// back to the pool clean.
 		defer func(tx pgx.Tx) {
 			if err := recover(); err != nil {
-				_ = tx.Rollback(ctx)
+				_ = tx.Rollback(context.WithoutCancel(ctx))
 			}
 		}(tx)
 
 		err = receiver(ctx, p.txFactory(tx))
 		if err != nil {
-			_ = tx.Rollback(ctx)
+			_ = tx.Rollback(context.WithoutCancel(ctx))
 		} else {
 			err = tx.Commit(ctx)
 		}
@@ -91,7 +118,6 @@ func (p *PostgresProvider) acquire(
 	ctx context.Context,
 	f func(context.Context, *pgxpool.Conn) error,
 ) error {
-	ctx = context.WithoutCancel(ctx)
 	conn, err := p.pool.Acquire(ctx)
 	if err != nil {
 		return err
@@ -136,6 +162,24 @@ func (p *PostgresConnection) SelectContext(
 	return pgxscan.Select(ctx, p.connection, dest, query, args...)
 }
 
+func (p *PostgresConnection) EachContext(
+	ctx context.Context,
+	dest any,
+	each func() error,
+	query string,
+	args ...any,
+) error {
+	return eachRow(ctx, p.connection, dest, each, query, args...)
+}
+
+func (p *PostgresConnection) ExecBatch(
+	ctx context.Context,
+	query string,
+	args [][]any,
+) error {
+	return execBatch(ctx, p.connection, query, args)
+}
+
 func NewPostgresTransaction(transaction pgx.Tx) *PostgresTransaction {
 	return &PostgresTransaction{transaction: transaction}
 }
@@ -170,3 +214,61 @@ func (p *PostgresTransaction) SelectContext(
 ) error {
 	return pgxscan.Select(ctx, p.transaction, dest, query, args...)
 }
+
+func (p *PostgresTransaction) EachContext(
+	ctx context.Context,
+	dest any,
+	each func() error,
+	query string,
+	args ...any,
+) error {
+	return eachRow(ctx, p.transaction, dest, each, query, args...)
+}
+
+func (p *PostgresTransaction) ExecBatch(
+	ctx context.Context,
+	query string,
+	args [][]any,
+) error {
+	return execBatch(ctx, p.transaction, query, args)
+}
+
+type batchSender interface {
+	SendBatch(context.Context, *pgx.Batch) pgx.BatchResults
+}
+
+func execBatch(ctx context.Context, sender batchSender, query string, args [][]any) error {
+	batch := &pgx.Batch{}
+	for _, queryArgs := range args {
+		batch.Queue(query, queryArgs...)
+	}
+
+	return sender.SendBatch(ctx, batch).Close()
+}
+
+func eachRow(
+	ctx context.Context,
+	querier pgxscan.Querier,
+	dest any,
+	each func() error,
+	query string,
+	args ...any,
+) error {
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	scanner := pgxscan.NewRowScanner(rows)
+	for rows.Next() {
+		if err := scanner.Scan(dest); err != nil {
+			return err
+		}
+		if err := each(); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
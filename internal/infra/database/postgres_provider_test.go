@@ -83,4 +83,88 @@ func TestIntegrationPostgresProvider(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
+
+	err = provider.Execute(
+		t.Context(),
+		func(ctx context.Context, connection domain.Connection) error {
+			return connection.ExecBatch(ctx, "select $1::int", [][]any{{1}, {2}})
+		},
+	)
+	require.NoError(t, err)
+
+	err = provider.ExecuteTx(
+		t.Context(),
+		func(ctx context.Context, connection domain.Connection) error {
+			return connection.ExecBatch(ctx, "select 1 / $1::int", [][]any{{1}, {0}})
+		},
+	)
+	require.Error(t, err)
+
+	err = provider.ExecuteReadOnly(
+		t.Context(),
+		func(ctx context.Context, connection domain.Connection) error {
+			var row nowRow
+			return connection.GetContext(ctx, &row, "select now() as now")
+		},
+	)
+	require.NoError(t, err)
+
+	err = provider.ExecuteReadOnly(
+		t.Context(),
+		func(ctx context.Context, connection domain.Connection) error {
+			_, err = connection.ExecContext(ctx, "create temporary table read_only_check (id int)")
+
+			return err
+		},
+	)
+	require.Error(t, err)
+}
+
+// A client that disconnects mid-stream cancels ctx after some rows were read;
+// the query ends instead of running to the end of its result set.
+func TestIntegrationPostgresProviderReadOnlyCancel(t *testing.T) {
+	t.Chdir("../../..")
+	require.NoError(t, godotenv.Load())
+
+	provider := database.NewPostgresProvider(
+		noerr.Must(pgxpool.New(t.Context(), os.Getenv("DB_CONNECTION"))),
+	)
+	defer provider.Close()
+
+	const total = 100_000_000
+	const query = `select n from generate_series(1, $1::int) as n -- read only cancel check`
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	read := 0
+	started := time.Now()
+	err := provider.ExecuteReadOnly(ctx, func(ctx context.Context, connection domain.Connection) error {
+		var n int
+		return connection.EachContext(ctx, &n, func() error {
+			read++
+			if read == 1 {
+				cancel()
+			}
+
+			return ctx.Err()
+		}, query, total)
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, read)
+	require.Less(t, time.Since(started), 5*time.Second)
+
+	require.Eventually(t, func() bool {
+		var running int
+		err := provider.Execute(t.Context(), func(ctx context.Context, connection domain.Connection) error {
+			return connection.GetContext(
+				ctx,
+				&running,
+				`select count(*) from pg_stat_activity
+				where state = 'active' and pid <> pg_backend_pid() and query like '%read only cancel check%'`,
+			)
+		})
+
+		return err == nil && running == 0
+	}, 5*time.Second, 50*time.Millisecond)
 }
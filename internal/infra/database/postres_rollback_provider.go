@@ -26,6 +26,13 @@ func (p PostgresRollbackProvider) Execute(
 	return p.ExecuteTx(ctx, receiver)
 }
 
+func (p PostgresRollbackProvider) ExecuteReadOnly(
+	ctx context.Context,
+	receiver func(context.Context, domain.Connection) error,
+) error {
+	return p.ExecuteTx(ctx, receiver)
+}
+
 func (p PostgresRollbackProvider) ExecuteTx(
 	ctx context.Context,
 	receiver func(context.Context, domain.Connection) error,
@@ -12,6 +12,7 @@ type Metrics struct {
 	receptionCounter prometheus.Counter
 	productsCounter  prometheus.Counter
 	userCounter      prometheus.Counter
+	staleCounter     prometheus.Counter
 	totalCounter     prometheus.Counter
 	httpDuration     prometheus.Histogram
 }
@@ -34,6 +35,10 @@ func NewMetrics() *Metrics {
 			Name: "avito.pvz.user_total",
 			Help: "The total number of created users",
 		}),
+		staleCounter: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "avito.pvz.stale_receptions_closed_total",
+			Help: "The total number of receptions closed after being idle",
+		}),
 		totalCounter: promauto.NewCounter(prometheus.CounterOpts{
 			Name: "avito.pvz.requests_total",
 			Help: "The total number of requests",
@@ -62,6 +67,10 @@ func (m Metrics) IncUsers() {
 	m.userCounter.Inc()
 }
 
+func (m Metrics) IncStaleReceptionsClosed() {
+	m.staleCounter.Inc()
+}
+
 func (m Metrics) IncRequests(duration time.Duration) {
 	m.httpDuration.Observe(duration.Seconds())
 	m.totalCounter.Inc()
@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"avito_pvz/internal/domain"
+)
+
+var _ domain.AnalyticsRepository = (*Analytics)(nil)
+
+var (
+	errAnalytics                = errors.New("analytics error")
+	ErrAnalyticsAddReception    = errors.Join(errAnalytics, errors.New("add reception failed"))
+	ErrAnalyticsCloseReception  = errors.Join(errAnalytics, errors.New("close reception failed"))
+	ErrAnalyticsReopenReception = errors.Join(errAnalytics, errors.New("reopen reception failed"))
+	ErrAnalyticsAddProduct      = errors.Join(errAnalytics, errors.New("add product failed"))
+	ErrAnalyticsAddProducts     = errors.Join(errAnalytics, errors.New("add products failed"))
+	ErrAnalyticsRemoveProduct   = errors.Join(errAnalytics, errors.New("remove product failed"))
+	ErrAnalyticsDaily           = errors.Join(errAnalytics, errors.New("daily failed"))
+	ErrAnalyticsCities          = errors.Join(errAnalytics, errors.New("cities failed"))
+)
+
+// receptionStatsUpsert adds the selected row to the counters of its day.
+const receptionStatsUpsert = `insert into reception_daily_stats
+	(pvz_id, day, receptions, closed_receptions, reception_seconds) `
+
+const receptionStatsOnConflict = ` on conflict (pvz_id, day) do update set
+	receptions = reception_daily_stats.receptions + excluded.receptions,
+	closed_receptions = reception_daily_stats.closed_receptions + excluded.closed_receptions,
+	reception_seconds = reception_daily_stats.reception_seconds + excluded.reception_seconds`
+
+// productStatsUpsert adds the selected row to the counter of its day and type.
+const productStatsUpsert = `insert into product_daily_stats (pvz_id, day, type, products) `
+
+const productStatsOnConflict = ` on conflict (pvz_id, day, type) do update set
+	products = product_daily_stats.products + excluded.products`
+
+type Analytics struct{}
+
+func NewAnalytics() *Analytics {
+	return &Analytics{}
+}
+
+// AddReception counts an opened reception on the day it was created.
+func (a *Analytics) AddReception(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+) error {
+	const query = receptionStatsUpsert + `
+	select receptions.pvz_id, (receptions.created_at at time zone pvz.time_zone)::date, 1, 0, 0
+	from receptions join pvz on pvz.id = receptions.pvz_id
+	where receptions.id = $1` + receptionStatsOnConflict
+
+	_, err := connection.ExecContext(ctx, query, receptionID)
+	if err != nil {
+		return errors.Join(ErrAnalyticsAddReception, err)
+	}
+
+	return nil
+}
+
+// CloseReception counts a closed reception and its duration on the day it was
+// created, so the average duration belongs to the same day as the reception.
+func (a *Analytics) CloseReception(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+) error {
+	const query = receptionStatsUpsert + `
+	select receptions.pvz_id, (receptions.created_at at time zone pvz.time_zone)::date, 0, 1,
+		extract(epoch from receptions.closed_at - receptions.created_at)
+	from receptions join pvz on pvz.id = receptions.pvz_id
+	where receptions.id = $1 and receptions.closed_at is not null` + receptionStatsOnConflict
+
+	_, err := connection.ExecContext(ctx, query, receptionID)
+	if err != nil {
+		return errors.Join(ErrAnalyticsCloseReception, err)
+	}
+
+	return nil
+}
+
+// ReopenReception subtracts what CloseReception counted for a reception that
+// is being put back in progress.
+func (a *Analytics) ReopenReception(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+) error {
+	const query = receptionStatsUpsert + `
+	select receptions.pvz_id, (receptions.created_at at time zone pvz.time_zone)::date, 0, -1,
+		-extract(epoch from receptions.closed_at - receptions.created_at)
+	from receptions join pvz on pvz.id = receptions.pvz_id
+	where receptions.id = $1 and receptions.closed_at is not null` + receptionStatsOnConflict
+
+	_, err := connection.ExecContext(ctx, query, receptionID)
+	if err != nil {
+		return errors.Join(ErrAnalyticsReopenReception, err)
+	}
+
+	return nil
+}
+
+func (a *Analytics) AddProduct(
+	ctx context.Context,
+	connection domain.Connection,
+	productID domain.ProductID,
+) error {
+	const query = productStatsUpsert + `
+	select receptions.pvz_id, (products.created_at at time zone pvz.time_zone)::date, products.type, 1
+	from products
+	join receptions on receptions.id = products.reception_id
+	join pvz on pvz.id = receptions.pvz_id
+	where products.id = $1` + productStatsOnConflict
+
+	_, err := connection.ExecContext(ctx, query, productID)
+	if err != nil {
+		return errors.Join(ErrAnalyticsAddProduct, err)
+	}
+
+	return nil
+}
+
+// AddProducts counts the products with a row per day and type, as an upsert
+// cannot touch the same row twice.
+func (a *Analytics) AddProducts(
+	ctx context.Context,
+	connection domain.Connection,
+	productIDs []domain.ProductID,
+) error {
+	const query = productStatsUpsert + `
+	select receptions.pvz_id, (products.created_at at time zone pvz.time_zone)::date, products.type, count(*)
+	from products
+	join receptions on receptions.id = products.reception_id
+	join pvz on pvz.id = receptions.pvz_id
+	where products.id = any($1)
+	group by 1, 2, 3` + productStatsOnConflict
+
+	_, err := connection.ExecContext(ctx, query, productIDs)
+	if err != nil {
+		return errors.Join(ErrAnalyticsAddProducts, err)
+	}
+
+	return nil
+}
+
+// RemoveProduct takes the product as it was before deletion, the row itself
+// is already gone.
+func (a *Analytics) RemoveProduct(
+	ctx context.Context,
+	connection domain.Connection,
+	product domain.Product,
+) error {
+	const query = productStatsUpsert + `
+	select receptions.pvz_id, ($2::timestamptz at time zone pvz.time_zone)::date, $3::text, -1
+	from receptions join pvz on pvz.id = receptions.pvz_id
+	where receptions.id = $1` + productStatsOnConflict
+
+	_, err := connection.ExecContext(ctx, query, product.ReceptionID, product.CreatedAt, product.Type)
+	if err != nil {
+		return errors.Join(ErrAnalyticsRemoveProduct, err)
+	}
+
+	return nil
+}
+
+// Daily reads the rollups of the days from from to to inclusive. Only days
+// with any activity are returned; pvzID narrows them to a single PVZ.
+func (a *Analytics) Daily(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID *domain.PVZID,
+	from, to time.Time,
+) ([]domain.DailyStats, error) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	where := "day between " + arg(from) + " and " + arg(to)
+	if pvzID != nil {
+		where += " and pvz_id = " + arg(*pvzID)
+	}
+
+	query := `with receptions as (
+		select day,
+			sum(receptions) as receptions,
+			sum(closed_receptions) as closed_receptions,
+			sum(reception_seconds) as reception_seconds
+		from reception_daily_stats where ` + where + `
+		group by day
+	), products as (
+		select day, json_object_agg(type, products) as products_by_type from (
+			select day, type, sum(products) as products from product_daily_stats
+			where ` + where + `
+			group by day, type
+			having sum(products) <> 0
+		) as by_type
+		group by day
+	)
+	select day,
+		coalesce(receptions, 0) as receptions,
+		coalesce(closed_receptions, 0) as closed_receptions,
+		coalesce(reception_seconds, 0) as reception_seconds,
+		coalesce(products_by_type, '{}') as products_by_type
+	from receptions full join products using (day)
+	order by day`
+
+	var rows []struct {
+		Day              time.Time
+		Receptions       int
+		ClosedReceptions int
+		ReceptionSeconds float64
+		ProductsByType   map[domain.ProductType]int
+	}
+	err := connection.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, errors.Join(ErrAnalyticsDaily, err)
+	}
+
+	stats := make([]domain.DailyStats, 0, len(rows))
+	for _, row := range rows {
+		day := domain.DailyStats{
+			Day:              row.Day,
+			Receptions:       row.Receptions,
+			ClosedReceptions: row.ClosedReceptions,
+			ProductsByType:   row.ProductsByType,
+		}
+		if row.ClosedReceptions > 0 {
+			day.AvgReceptionDuration = time.Duration(
+				row.ReceptionSeconds / float64(row.ClosedReceptions) * float64(time.Second),
+			)
+		}
+
+		stats = append(stats, day)
+	}
+
+	return stats, nil
+}
+
+// Cities aggregates receptions opened from from to to inclusive (in PVZ local
+// days) and their products by city, ranking the busiest PVZs of every city.
+// Counting happens in the database, products are never loaded. JSON keys match
+// domain field names so the aggregated columns decode straight into domain types.
+func (a *Analytics) Cities(
+	ctx context.Context,
+	connection domain.Connection,
+	from, to time.Time,
+	busiest int,
+) ([]domain.CityStats, error) {
+	const query = `with window_receptions as (
+		select pvz.city, receptions.pvz_id, receptions.id
+		from receptions join pvz on pvz.id = receptions.pvz_id
+		where (receptions.created_at at time zone pvz.time_zone)::date between $1 and $2
+	), per_pvz as (
+		select window_receptions.city, window_receptions.pvz_id,
+			count(distinct window_receptions.id) as receptions,
+			count(products.id) as products
+		from window_receptions
+		left join products on products.reception_id = window_receptions.id
+		group by window_receptions.city, window_receptions.pvz_id
+	), ranked as (
+		select per_pvz.*, row_number() over (
+			partition by city order by products desc, receptions desc, pvz_id
+		) as position
+		from per_pvz
+	), by_type as (
+		select window_receptions.city, products.type, count(*) as total
+		from window_receptions join products on products.reception_id = window_receptions.id
+		group by window_receptions.city, products.type
+	)
+	select pvz.city,
+		count(*) as pvzs,
+		coalesce((select sum(receptions) from per_pvz where per_pvz.city = pvz.city), 0) as receptions,
+		coalesce((select json_object_agg(type, total) from by_type
+			where by_type.city = pvz.city), '{}') as products_by_type,
+		coalesce((select json_agg(json_build_object(
+			'PVZID', pvz_id, 'Receptions', receptions, 'Products', products
+		) order by position)
+		from ranked where ranked.city = pvz.city and position <= $3), '[]') as busiest
+	from pvz
+	where (pvz.registered_at at time zone pvz.time_zone)::date <= $2
+	group by pvz.city
+	order by pvz.city`
+
+	var cities []domain.CityStats
+	err := connection.SelectContext(ctx, &cities, query, from, to, busiest)
+	if err != nil {
+		return nil, errors.Join(ErrAnalyticsCities, err)
+	}
+
+	return cities, nil
+}
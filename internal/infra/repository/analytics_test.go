@@ -0,0 +1,215 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/repository"
+)
+
+func TestAnalyticsIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		analytics := repository.NewAnalytics()
+		products := repository.NewProduct()
+
+		pvzID1, pvzID2 := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID1, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID2, "Казань")
+
+		receptionID1, receptionID2 := uuid.New(), uuid.New()
+		for _, reception := range []struct{ id, pvzID uuid.UUID }{{receptionID1, pvzID1}, {receptionID2, pvzID2}} {
+			_ = fixtureCreateReceptin(ctx, t, connection, reception.id, reception.pvzID)
+			require.NoError(t, analytics.AddReception(ctx, connection, reception.id))
+		}
+
+		now := time.Now()
+		for _, productType := range []domain.ProductType{domain.Shoes, domain.Shoes, domain.Clothes} {
+			product := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID1, productType, now)
+			require.NoError(t, analytics.AddProduct(ctx, connection, product.ID))
+		}
+		product := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID2, domain.Electronics, now)
+		require.NoError(t, analytics.AddProduct(ctx, connection, product.ID))
+
+		deleted, err := products.DeleteLast(ctx, connection, receptionID2, uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, analytics.RemoveProduct(ctx, connection, deleted))
+
+		require.NoError(t, repository.NewReceptions().Close(ctx, connection, receptionID1, uuid.New()))
+		require.NoError(t, analytics.CloseReception(ctx, connection, receptionID1))
+
+		location, err := time.LoadLocation(domain.DefaultTimeZone)
+		require.NoError(t, err)
+		today := now.In(location)
+		day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+		fleet, err := analytics.Daily(ctx, connection, nil, day.AddDate(0, 0, -1), day)
+		require.NoError(t, err)
+		require.Len(t, fleet, 1)
+		require.True(t, day.Equal(fleet[0].Day))
+		require.Equal(t, 2, fleet[0].Receptions)
+		require.Equal(t, 1, fleet[0].ClosedReceptions)
+		require.Equal(t, map[domain.ProductType]int{domain.Shoes: 2, domain.Clothes: 1}, fleet[0].ProductsByType)
+
+		single, err := analytics.Daily(ctx, connection, &pvzID2, day, day)
+		require.NoError(t, err)
+		require.Len(t, single, 1)
+		require.Equal(t, 1, single[0].Receptions)
+		require.Zero(t, single[0].ClosedReceptions)
+		require.Empty(t, single[0].ProductsByType)
+
+		require.NoError(t, analytics.ReopenReception(ctx, connection, receptionID1))
+
+		fleet, err = analytics.Daily(ctx, connection, nil, day, day)
+		require.NoError(t, err)
+		require.Len(t, fleet, 1)
+		require.Equal(t, 2, fleet[0].Receptions)
+		require.Zero(t, fleet[0].ClosedReceptions)
+	})
+}
+
+func TestAnalyticsCitiesIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID1, pvzID2, pvzID3 := uuid.New(), uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID1, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID2, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID3, "Казань")
+
+		now := time.Now()
+		receptionID1, receptionID2 := uuid.New(), uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID1, pvzID1)
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID2, pvzID2)
+		for _, productType := range []domain.ProductType{domain.Shoes, domain.Shoes, domain.Clothes} {
+			_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID2, productType, now)
+		}
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID1, domain.Electronics, now)
+
+		location, err := time.LoadLocation(domain.DefaultTimeZone)
+		require.NoError(t, err)
+		today := now.In(location)
+		day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+		cities, err := repository.NewAnalytics().Cities(ctx, connection, day, day, 1)
+		require.NoError(t, err)
+		require.Len(t, cities, 2)
+
+		kzn, msk := cities[0], cities[1]
+		require.Equal(t, domain.Kzn, kzn.City)
+		require.Equal(t, 1, kzn.PVZs)
+		require.Zero(t, kzn.Receptions)
+		require.Empty(t, kzn.Busiest)
+
+		require.Equal(t, domain.Msk, msk.City)
+		require.Equal(t, 2, msk.PVZs)
+		require.Equal(t, 2, msk.Receptions)
+		require.Equal(
+			t,
+			map[domain.ProductType]int{domain.Shoes: 2, domain.Clothes: 1, domain.Electronics: 1},
+			msk.ProductsByType,
+		)
+		require.Equal(t, []domain.BusyPVZ{{PVZID: pvzID2, Receptions: 1, Products: 3}}, msk.Busiest)
+
+		cities, err = repository.NewAnalytics().Cities(ctx, connection, day.AddDate(0, 0, -7), day.AddDate(0, 0, -7), 1)
+		require.NoError(t, err)
+		for _, city := range cities {
+			require.Zero(t, city.Receptions)
+		}
+	})
+}
+
+func TestAnalyticsUnitErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(context.Context, domain.Connection) error
+		want error
+	}{
+		{
+			name: "Add reception",
+			call: func(ctx context.Context, connection domain.Connection) error {
+				return repository.NewAnalytics().AddReception(ctx, connection, uuid.New())
+			},
+			want: repository.ErrAnalyticsAddReception,
+		},
+		{
+			name: "Close reception",
+			call: func(ctx context.Context, connection domain.Connection) error {
+				return repository.NewAnalytics().CloseReception(ctx, connection, uuid.New())
+			},
+			want: repository.ErrAnalyticsCloseReception,
+		},
+		{
+			name: "Reopen reception",
+			call: func(ctx context.Context, connection domain.Connection) error {
+				return repository.NewAnalytics().ReopenReception(ctx, connection, uuid.New())
+			},
+			want: repository.ErrAnalyticsReopenReception,
+		},
+		{
+			name: "Add product",
+			call: func(ctx context.Context, connection domain.Connection) error {
+				return repository.NewAnalytics().AddProduct(ctx, connection, uuid.New())
+			},
+			want: repository.ErrAnalyticsAddProduct,
+		},
+		{
+			name: "Add products",
+			call: func(ctx context.Context, connection domain.Connection) error {
+				return repository.NewAnalytics().AddProducts(ctx, connection, []domain.ProductID{uuid.New()})
+			},
+			want: repository.ErrAnalyticsAddProducts,
+		},
+		{
+			name: "Remove product",
+			call: func(ctx context.Context, connection domain.Connection) error {
+				return repository.NewAnalytics().RemoveProduct(ctx, connection, domain.Product{})
+			},
+			want: repository.ErrAnalyticsRemoveProduct,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			connection := mocks.NewMockConnection(t)
+			connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything).
+				Return(0, errors.New("some error")).
+				Maybe()
+			connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(0, errors.New("some error")).
+				Maybe()
+
+			err := test.call(t.Context(), connection)
+			require.ErrorIs(t, err, test.want)
+			require.ErrorContains(t, err, "some error")
+		})
+	}
+}
+
+func TestAnalyticsUnitDaily(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewAnalytics().Daily(t.Context(), connection, nil, time.Now(), time.Now())
+	require.ErrorIs(t, err, repository.ErrAnalyticsDaily)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestAnalyticsUnitCities(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().
+		SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewAnalytics().Cities(t.Context(), connection, time.Now(), time.Now(), domain.BusiestPVZs)
+	require.ErrorIs(t, err, repository.ErrAnalyticsCities)
+	require.ErrorContains(t, err, "some error")
+}
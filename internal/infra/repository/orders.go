@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ domain.OrdersRepository = (*Orders)(nil)
+
+var (
+	errOrders             = errors.New("orders repository error")
+	ErrOrdersCreate       = errors.Join(errOrders, errors.New("create failed"))
+	ErrOrdersFindWaiting  = errors.Join(errOrders, errors.New("find waiting failed"))
+	ErrOrdersFindProducts = errors.Join(errOrders, errors.New("find products failed"))
+	ErrOrdersMarkIssued   = errors.Join(errOrders, errors.New("mark issued failed"))
+
+	ErrOrdersFindWaitingByProduct = errors.Join(errOrders, errors.New("find waiting by product failed"))
+)
+
+const (
+	orderProductsPrimaryKey  = "order_products_pkey"
+	orderWaitingPickupUnique = "orders_waiting_pickup_code_unique"
+	orderColumns             = `id, pvz_id, pickup_code, created_at, created_by, issued_at, issued_by`
+)
+
+type Orders struct{}
+
+func NewOrders() *Orders {
+	return &Orders{}
+}
+
+// Create inserts the order and links the products in one statement. A taken
+// pickup code inserts nothing, so no products are linked either.
+func (r *Orders) Create(
+	ctx context.Context,
+	connection domain.Connection,
+	order domain.Order,
+	productIDs []domain.ProductID,
+) error {
+	const query = `with created as (
+		insert into orders (id, pvz_id, pickup_code, created_at, created_by)
+		values ($1, $2, $3, $4, $5)
+		on conflict (pvz_id, pickup_code) where issued_at is null do nothing
+		returning id
+	)
+	insert into order_products (product_id, order_id)
+	select product_id, created.id from unnest($6::uuid[]) as product_id, created`
+
+	linked, err := connection.ExecContext(
+		ctx,
+		query,
+		order.ID,
+		order.PVZID,
+		order.PickupCode,
+		order.CreatedAt,
+		order.CreatedBy,
+		productIDs,
+	)
+	if isUniqueViolation(err, orderProductsPrimaryKey) {
+		return errors.Join(ErrOrdersCreate, domain.ErrProductInOrder)
+	}
+	if isUniqueViolation(err, orderWaitingPickupUnique) {
+		return errors.Join(ErrOrdersCreate, domain.ErrPickupCodeTaken)
+	}
+	if err != nil {
+		return errors.Join(ErrOrdersCreate, err)
+	}
+	if linked == 0 {
+		return errors.Join(ErrOrdersCreate, domain.ErrPickupCodeTaken)
+	}
+
+	return nil
+}
+
+func (r *Orders) FindWaiting(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+	code string,
+) (domain.Order, error) {
+	const query = `select ` + orderColumns + ` from orders
+	where pvz_id = $1 and pickup_code = $2 and issued_at is null
+	for update`
+
+	var order domain.Order
+	err := connection.GetContext(ctx, &order, query, pvzID, code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return order, errors.Join(ErrOrdersFindWaiting, domain.ErrOrderNotFound)
+	}
+	if err != nil {
+		return order, errors.Join(ErrOrdersFindWaiting, err)
+	}
+
+	return order, nil
+}
+
+func (r *Orders) FindWaitingByProduct(
+	ctx context.Context,
+	connection domain.Connection,
+	productID domain.ProductID,
+) (domain.Order, error) {
+	const query = `select ` + orderColumns + ` from orders
+	where id = (select order_id from order_products where product_id = $1) and issued_at is null
+	for update`
+
+	var order domain.Order
+	err := connection.GetContext(ctx, &order, query, productID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return order, errors.Join(ErrOrdersFindWaitingByProduct, domain.ErrOrderNotFound)
+	}
+	if err != nil {
+		return order, errors.Join(ErrOrdersFindWaitingByProduct, err)
+	}
+
+	return order, nil
+}
+
+func (r *Orders) FindProducts(
+	ctx context.Context,
+	connection domain.Connection,
+	orderID domain.OrderID,
+) ([]domain.Product, error) {
+	const query = `select ` + productColumns + ` from products
+	where id in (select product_id from order_products where order_id = $1)
+	order by created_at`
+
+	var products []domain.Product
+	err := connection.SelectContext(ctx, &products, query, orderID)
+	if err != nil {
+		return nil, errors.Join(ErrOrdersFindProducts, err)
+	}
+
+	return products, nil
+}
+
+func (r *Orders) MarkIssued(
+	ctx context.Context,
+	connection domain.Connection,
+	orderID domain.OrderID,
+	issuedBy domain.UserID,
+) error {
+	const query = `update orders set issued_at = now(), issued_by = $2
+	where id = $1 and issued_at is null`
+
+	issued, err := connection.ExecContext(ctx, query, orderID, issuedBy)
+	if err != nil {
+		return errors.Join(ErrOrdersMarkIssued, err)
+	}
+	if issued == 0 {
+		return errors.Join(ErrOrdersMarkIssued, domain.ErrOrderNotFound)
+	}
+
+	return nil
+}
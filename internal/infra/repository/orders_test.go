@@ -0,0 +1,148 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/repository"
+)
+
+func TestOrdersIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Казань")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		first := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, domain.Shoes, now)
+		second := fixtureCreateProduct(
+			ctx,
+			t,
+			connection,
+			uuid.New(),
+			receptionID,
+			domain.Clothes,
+			now.Add(time.Microsecond),
+		)
+
+		orders := repository.NewOrders()
+		order := domain.Order{ID: uuid.New(), PVZID: pvzID, PickupCode: "123456", CreatedAt: now}
+		require.NoError(t, orders.Create(ctx, connection, order, []domain.ProductID{first.ID, second.ID}))
+
+		err := orders.Create(
+			ctx,
+			connection,
+			domain.Order{ID: uuid.New(), PVZID: pvzID, PickupCode: "123456", CreatedAt: now},
+			[]domain.ProductID{first.ID},
+		)
+		require.ErrorIs(t, err, domain.ErrPickupCodeTaken)
+
+		found, err := orders.FindWaiting(ctx, connection, pvzID, "123456")
+		require.NoError(t, err)
+		require.Equal(t, order.ID, found.ID)
+		require.Nil(t, found.IssuedAt)
+
+		found, err = orders.FindWaitingByProduct(ctx, connection, second.ID)
+		require.NoError(t, err)
+		require.Equal(t, order.ID, found.ID)
+
+		// The last product is still received, the order keeps it from deletion.
+		_, err = repository.NewProduct().DeleteLast(ctx, connection, receptionID, uuid.New())
+		require.ErrorIs(t, err, domain.ErrProductInOrder)
+
+		products, err := orders.FindProducts(ctx, connection, order.ID)
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+		require.Equal(t, first.ID, products[0].ID)
+
+		issuedBy := uuid.New()
+		require.NoError(t, orders.MarkIssued(ctx, connection, order.ID, issuedBy))
+		require.ErrorIs(t, orders.MarkIssued(ctx, connection, order.ID, issuedBy), domain.ErrOrderNotFound)
+
+		_, err = orders.FindWaiting(ctx, connection, pvzID, "123456")
+		require.ErrorIs(t, err, domain.ErrOrderNotFound)
+		_, err = orders.FindWaitingByProduct(ctx, connection, second.ID)
+		require.ErrorIs(t, err, domain.ErrOrderNotFound)
+
+		// The code is free again once the order is picked up, the products
+		// still belong to their first order.
+		err = orders.Create(
+			ctx,
+			connection,
+			domain.Order{ID: uuid.New(), PVZID: pvzID, PickupCode: "123456", CreatedAt: now},
+			[]domain.ProductID{first.ID},
+		)
+		require.ErrorIs(t, err, domain.ErrProductInOrder)
+	})
+}
+
+func TestOrdersUnitCreate(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything).
+		Return(0, nil).
+		Once()
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything).
+		Return(0, &pgconn.PgError{Code: "23505", ConstraintName: "order_products_pkey"}).
+		Once()
+
+	productIDs := []domain.ProductID{uuid.New()}
+
+	err := repository.NewOrders().Create(t.Context(), connection, domain.Order{ID: uuid.New()}, productIDs)
+	require.ErrorIs(t, err, repository.ErrOrdersCreate)
+	require.ErrorIs(t, err, domain.ErrPickupCodeTaken)
+
+	err = repository.NewOrders().Create(t.Context(), connection, domain.Order{ID: uuid.New()}, productIDs)
+	require.ErrorIs(t, err, repository.ErrOrdersCreate)
+	require.ErrorIs(t, err, domain.ErrProductInOrder)
+}
+
+func TestOrdersUnitErrors(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows).
+		Once()
+	connection.EXPECT().
+		SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, nil).
+		Once()
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows).
+		Once()
+
+	_, err := repository.NewOrders().FindWaiting(t.Context(), connection, uuid.New(), "123456")
+	require.ErrorIs(t, err, repository.ErrOrdersFindWaiting)
+	require.ErrorIs(t, err, domain.ErrOrderNotFound)
+
+	_, err = repository.NewOrders().FindProducts(t.Context(), connection, uuid.New())
+	require.ErrorIs(t, err, repository.ErrOrdersFindProducts)
+
+	err = repository.NewOrders().MarkIssued(t.Context(), connection, uuid.New(), uuid.New())
+	require.ErrorIs(t, err, repository.ErrOrdersMarkIssued)
+	require.ErrorIs(t, err, domain.ErrOrderNotFound)
+
+	_, err = repository.NewOrders().FindWaitingByProduct(t.Context(), connection, uuid.New())
+	require.ErrorIs(t, err, repository.ErrOrdersFindWaitingByProduct)
+	require.ErrorIs(t, err, domain.ErrOrderNotFound)
+}
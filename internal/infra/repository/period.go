@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"avito_pvz/internal/domain"
+)
+
+func validatePeriod(period domain.Period) error {
+	if period.From != nil && period.To != nil && period.To.Before(*period.From) {
+		return errors.New("from must be less than to")
+	}
+	if period.LocalFrom != nil && period.LocalTo != nil && period.LocalTo.Before(*period.LocalFrom) {
+		return errors.New("local from must be less than local to")
+	}
+
+	return nil
+}
+
+// periodConditions renders bounds of the period for the given timestamp
+// column. Local dates are calendar days in timeZone (an SQL expression), the
+// upper local bound includes the whole day.
+func periodConditions(
+	column string,
+	timeZone string,
+	period domain.Period,
+	arg func(any) string,
+) []string {
+	var conditions []string
+
+	if period.From != nil {
+		conditions = append(conditions, arg(*period.From)+" <= "+column)
+	}
+	if period.To != nil {
+		conditions = append(conditions, column+" <= "+arg(*period.To))
+	}
+	if period.LocalFrom != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"(%s::date::timestamp at time zone %s) <= %s",
+			arg(period.LocalFrom.Format(time.DateOnly)),
+			timeZone,
+			column,
+		))
+	}
+	if period.LocalTo != nil {
+		conditions = append(conditions, fmt.Sprintf(
+			"%s < ((%s::date + 1)::timestamp at time zone %s)",
+			column,
+			arg(period.LocalTo.Format(time.DateOnly)),
+			timeZone,
+		))
+	}
+
+	return conditions
+}
+
+// afterCondition renders the keyset bound for pages ordered by
+// (timeColumn, idColumn).
+func afterCondition(
+	timeColumn string,
+	idColumn string,
+	after domain.Cursor,
+	descending bool,
+	arg func(any) string,
+) string {
+	operator := ">"
+	if descending {
+		operator = "<"
+	}
+
+	return fmt.Sprintf("(%s, %s) %s (%s, %s)", timeColumn, idColumn, operator, arg(after.At), arg(after.ID))
+}
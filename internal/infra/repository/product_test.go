@@ -3,15 +3,19 @@ package repository_test
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
 	"avito_pvz/internal/infra/repository"
 )
 
@@ -48,122 +52,310 @@ func TestProductIntegration(t *testing.T) {
 		)
 		_ = fixtureCreateProduct(ctx, t, connection, productID3, receptionID, "обувь", now)
 
-		err := products.DeleteLast(ctx, connection, receptionID)
+		deleted, err := products.DeleteLast(ctx, connection, receptionID, uuid.New())
 		require.NoError(t, err)
+		require.Equal(t, productID3, deleted.ID)
 
-		productsFound, err := products.Search(ctx, connection, nil, nil, nil, nil)
+		productsFound, err := products.FindByReceptionIDs(ctx, connection, []domain.ReceptionID{receptionID})
 		require.NoError(t, err)
 		require.Len(t, productsFound, 2)
-
-		limit := 1
-		productsFound, err = products.Search(ctx, connection, nil, nil, nil, &limit)
-		require.NoError(t, err)
-		require.Len(t, productsFound, limit)
 		require.Equal(t, product2.ID, productsFound[1].ID)
 	})
 }
 
-func TestProductIntegrationSearch(t *testing.T) {
+func TestProductBatchIntegration(t *testing.T) {
 	rollback(t, func(ctx context.Context, connection domain.Connection) {
-		pvzID := uuid.New()
-		receptionID := uuid.New()
-
+		pvzID, receptionID := uuid.New(), uuid.New()
 		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Казань")
 		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
 
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		batch := []domain.Product{
+			{ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now},
+			{ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now.Add(time.Microsecond)},
+			{ID: uuid.New(), ReceptionID: receptionID, Type: domain.Clothes, CreatedAt: now.Add(2 * time.Microsecond)},
+		}
 		products := repository.NewProduct()
-		productID1 := uuid.New()
-		productID2 := uuid.New()
-		productID3 := uuid.New()
+		require.NoError(t, products.CreateBatch(ctx, connection, batch))
 
-		now := time.Now()
+		found, err := products.FindByReceptionIDs(ctx, connection, []domain.ReceptionID{receptionID})
+		require.NoError(t, err)
+		require.Len(t, found, len(batch))
 
-		product1 := fixtureCreateProduct(
-			ctx,
+		analytics := repository.NewAnalytics()
+		require.NoError(
 			t,
-			connection,
-			productID1,
-			receptionID,
-			"электроника",
-			now.Add(-2*time.Hour),
+			analytics.AddProducts(ctx, connection, []domain.ProductID{batch[0].ID, batch[1].ID, batch[2].ID}),
 		)
-		product2 := fixtureCreateProduct(
+		stats, err := analytics.Daily(ctx, connection, &pvzID, now.AddDate(0, 0, -1), now.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		require.Equal(t, map[domain.ProductType]int{domain.Shoes: 2, domain.Clothes: 1}, stats[0].ProductsByType)
+
+		deleted, err := products.DeleteLast(ctx, connection, receptionID, uuid.New())
+		require.NoError(t, err)
+		require.Equal(t, batch[2].ID, deleted.ID)
+
+		err = products.CreateBatch(ctx, connection, []domain.Product{
+			{ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now},
+			{ID: batch[0].ID, ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now},
+		})
+		require.ErrorIs(t, err, repository.ErrCreateBatch)
+	})
+}
+
+func TestProductBarcodeIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Казань")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		barcode := "4600000000017"
+		products := repository.NewProduct()
+		require.NoError(t, products.Create(ctx, connection, domain.Product{
+			ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now, Barcode: &barcode,
+		}))
+		// Products without a barcode never clash.
+		require.NoError(t, products.Create(ctx, connection, domain.Product{
+			ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now,
+		}))
+		require.NoError(t, products.Create(ctx, connection, domain.Product{
+			ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now,
+		}))
+
+		err := products.Create(ctx, connection, domain.Product{
+			ID: uuid.New(), ReceptionID: receptionID, Type: domain.Clothes, CreatedAt: now, Barcode: &barcode,
+		})
+		require.ErrorIs(t, err, repository.ErrCreateProduct)
+		require.ErrorIs(t, err, domain.ErrProductDuplicateBarcode)
+	})
+
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID, otherPVZID := uuid.New(), uuid.New()
+		receptionID, otherReceptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Казань")
+		_ = fixtureCreatePVZ(ctx, t, connection, otherPVZID, "Москва")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+		_ = fixtureCreateReceptin(ctx, t, connection, otherReceptionID, otherPVZID)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		barcode := "4600000000017"
+		products := repository.NewProduct()
+		first := domain.Product{
+			ID: uuid.New(), ReceptionID: receptionID, Type: domain.Shoes, CreatedAt: now, Barcode: &barcode,
+		}
+		second := domain.Product{
+			ID:          uuid.New(),
+			ReceptionID: otherReceptionID,
+			Type:        domain.Shoes,
+			CreatedAt:   now.Add(time.Second),
+			Barcode:     &barcode,
+		}
+		require.NoError(t, products.CreateBatch(ctx, connection, []domain.Product{first}))
+		require.NoError(t, products.CreateBatch(ctx, connection, []domain.Product{second}))
+
+		found, err := products.FindInProgressByBarcodes(ctx, connection, []string{barcode, "unknown"})
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		require.Equal(t, first.ID, found[0].ID)
+		require.Equal(t, &barcode, found[0].Barcode)
+
+		require.NoError(t, repository.NewReceptions().Close(ctx, connection, otherReceptionID, uuid.New()))
+		found, err = products.FindInProgressByBarcodes(ctx, connection, []string{barcode})
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		require.Equal(t, first.ID, found[0].ID)
+
+		locations, err := products.FindByBarcode(ctx, connection, barcode)
+		require.NoError(t, err)
+		require.Len(t, locations, 2)
+		require.Equal(t, second.ID, locations[0].Product.ID)
+		require.Equal(t, otherPVZID, locations[0].PVZ.ID)
+		require.Equal(t, domain.Close, locations[0].Reception.Status)
+		require.Equal(t, first.ID, locations[1].Product.ID)
+		require.Equal(t, pvzID, locations[1].PVZ.ID)
+
+		err = products.CreateBatch(ctx, connection, []domain.Product{
+			{ID: uuid.New(), ReceptionID: receptionID, Type: domain.Clothes, CreatedAt: now, Barcode: &barcode},
+		})
+		require.ErrorIs(t, err, repository.ErrCreateBatch)
+		require.ErrorIs(t, err, domain.ErrProductDuplicateBarcode)
+	})
+}
+
+func TestProductAttributesIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Казань")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		inspected := domain.Product{
+			ID:          uuid.New(),
+			ReceptionID: receptionID,
+			Type:        domain.Shoes,
+			CreatedAt:   now,
+			ProductAttributes: domain.ProductAttributes{
+				WeightGrams:      pointer.Ref(1200),
+				LengthCM:         pointer.Ref(30),
+				WidthCM:          pointer.Ref(20),
+				HeightCM:         pointer.Ref(10),
+				DeclaredValue:    pointer.Ref(int64(450000)),
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+				InspectionReason: pointer.Ref("Коробка вскрыта"),
+			},
+		}
+		products := repository.NewProduct()
+		require.NoError(t, products.Create(ctx, connection, inspected))
+		require.NoError(t, products.Create(ctx, connection, domain.Product{
+			ID: uuid.New(), ReceptionID: receptionID, Type: domain.Clothes, CreatedAt: now.Add(time.Microsecond),
+		}))
+
+		found, err := products.FindByReceptionIDs(ctx, connection, []domain.ReceptionID{receptionID})
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		require.Equal(t, inspected.ProductAttributes, found[0].ProductAttributes)
+		require.Equal(t, domain.ProductAttributes{}, found[1].ProductAttributes)
+
+		details, err := repository.NewReceptions().FindDetails(ctx, connection, receptionID)
+		require.NoError(t, err)
+		require.Equal(t, inspected.ProductAttributes, details.Products[0].ProductAttributes)
+
+		_, err = products.DeleteLast(ctx, connection, receptionID, uuid.New())
+		require.NoError(t, err)
+		deleted, err := products.DeleteLast(ctx, connection, receptionID, uuid.New())
+		require.NoError(t, err)
+		require.Equal(t, inspected.ProductAttributes, deleted.ProductAttributes)
+
+		details, err = repository.NewReceptions().FindDetails(ctx, connection, receptionID)
+		require.NoError(t, err)
+		require.Len(t, details.DeletedProducts, 2)
+		// Both deletions share the transaction time, so their order is not fixed.
+		for _, product := range details.DeletedProducts {
+			if product.ID == inspected.ID {
+				require.Equal(t, inspected.ProductAttributes, product.ProductAttributes)
+			}
+		}
+	})
+}
+
+func TestProductStatusIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Казань")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		first := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, domain.Shoes, now)
+		second := fixtureCreateProduct(
 			ctx,
 			t,
 			connection,
-			productID2,
+			uuid.New(),
 			receptionID,
-			"одежда",
-			now.Add(-1*time.Hour),
+			domain.Clothes,
+			now.Add(time.Microsecond),
 		)
-		product3 := fixtureCreateProduct(ctx, t, connection, productID3, receptionID, "обувь", now)
 
-		limit := 1
-		page := 0
-		productsFound, err := products.Search(ctx, connection, nil, nil, &page, &limit)
+		products := repository.NewProduct()
+		found, err := products.FindByIDs(ctx, connection, []domain.ProductID{first.ID})
 		require.NoError(t, err)
-		require.Len(t, productsFound, 1)
-		require.Equal(t, product1.ID, productsFound[0].ID)
+		require.Len(t, found, 1)
+		require.Equal(t, domain.ProductReceived, found[0].Status)
+
+		rejected := domain.Product{
+			ID:          uuid.New(),
+			ReceptionID: receptionID,
+			Type:        domain.Shoes,
+			CreatedAt:   now.Add(2 * time.Microsecond),
+			ProductAttributes: domain.ProductAttributes{
+				InspectionStatus: pointer.Ref(domain.InspectionRejected),
+				InspectionReason: pointer.Ref("Коробка вскрыта"),
+			},
+		}
+		require.NoError(t, products.Create(ctx, connection, rejected))
 
-		page = 1
-		productsFound, err = products.Search(ctx, connection, nil, nil, &page, &limit)
+		storedBy := uuid.New()
+		require.NoError(t, products.StoreReceived(ctx, connection, []domain.ReceptionID{receptionID}, &storedBy))
+
+		found, err = products.FindByIDs(ctx, connection, []domain.ProductID{rejected.ID})
 		require.NoError(t, err)
-		require.Len(t, productsFound, 1)
-		require.Equal(t, product2.ID, productsFound[0].ID)
-
-		limit = 3
-		page = 0
-		to := now.Add(4 * time.Hour)
-		from := now.Add(-4 * time.Hour)
-		productsFound, err = products.Search(ctx, connection, &from, nil, &page, &limit)
+		require.Len(t, found, 1)
+		require.Equal(t, domain.ProductReturned, found[0].Status)
+
+		issue := domain.ProductTransition{
+			ProductID: first.ID,
+			From:      domain.ProductStored,
+			To:        domain.ProductIssued,
+			ChangedBy: pointer.Ref(uuid.New()),
+		}
+		issued, err := products.ChangeStatus(ctx, connection, issue)
 		require.NoError(t, err)
-		require.Len(t, productsFound, 3)
+		require.Equal(t, domain.ProductIssued, issued.Status)
 
-		productsFound, err = products.Search(ctx, connection, nil, &to, &page, &limit)
-		require.NoError(t, err)
-		require.Len(t, productsFound, 3)
+		_, err = products.ChangeStatus(ctx, connection, issue)
+		require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+
+		_, err = products.ChangeStatus(ctx, connection, domain.ProductTransition{
+			ProductID: second.ID,
+			From:      domain.ProductReceived,
+			To:        domain.ProductReturned,
+		})
+		require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
 
-		productsFound, err = products.Search(ctx, connection, &from, &to, &page, &limit)
+		var transitions []domain.ProductTransition
+		err = connection.SelectContext(
+			ctx,
+			&transitions,
+			`select product_id, from_status, to_status, changed_at, changed_by
+			from product_transitions where product_id = $1 order by id`,
+			first.ID,
+		)
 		require.NoError(t, err)
-		require.Len(t, productsFound, 3)
-		require.Equal(t, product1.ID, productsFound[0].ID)
+		require.Len(t, transitions, 2)
+		require.Equal(t, domain.ProductStored, transitions[0].To)
+		require.Equal(t, &storedBy, transitions[0].ChangedBy)
+		require.Equal(t, domain.ProductIssued, transitions[1].To)
+		require.Equal(t, issue.ChangedBy, transitions[1].ChangedBy)
 
-		limit = 1
-		productsFound, err = products.Search(ctx, connection, nil, nil, nil, &limit)
+		tree, err := repository.NewPVZ().SearchReceptionsProducts(
+			ctx,
+			connection,
+			domain.PVZFilter{ProductStatuses: []domain.ProductStatus{domain.ProductStored}},
+			1,
+			10,
+			nil,
+		)
 		require.NoError(t, err)
-		require.Len(t, productsFound, limit)
-		require.Equal(t, product3.ID, productsFound[2].ID)
+		require.Len(t, tree, 1)
+		require.Len(t, tree[0].Receptions[0].Products, 1)
+		require.Equal(t, second.ID, tree[0].Receptions[0].Products[0].ID)
+		require.Equal(t, domain.ProductStored, tree[0].Receptions[0].Products[0].Status)
+
+		// A product no longer received keeps its transitions, it is not deleted.
+		_, err = products.DeleteLast(ctx, connection, receptionID, uuid.New())
+		require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+		found, err = products.FindByIDs(ctx, connection, []domain.ProductID{rejected.ID})
+		require.NoError(t, err)
+		require.Len(t, found, 1)
 	})
 }
 
-func TestProductSearchErrors(t *testing.T) {
+func TestProductUnitFindByReceptionIDs(t *testing.T) {
 	connection := mocks.NewMockConnection(t)
 
-	from := time.Now()
-	to := from.Add(-time.Hour)
-	_, err := repository.NewProduct().Search(t.Context(), connection, &from, &to, nil, nil)
-	require.ErrorIs(t, err, repository.ErrSearchProduct)
-	require.ErrorContains(t, err, "from must be less than to")
-
-	page := -1
-	limit := 1
-	_, err = repository.NewProduct().Search(t.Context(), connection, nil, nil, &page, &limit)
-	require.ErrorIs(t, err, repository.ErrSearchProduct)
-	require.ErrorContains(t, err, "invalid page")
-
-	page = 1
-	limit = 0
-	_, err = repository.NewProduct().Search(t.Context(), connection, nil, nil, &page, &limit)
-	require.ErrorIs(t, err, repository.ErrSearchProduct)
-	require.ErrorContains(t, err, "invalid limit")
-
-	limit = 1
-	_, err = repository.NewProduct().Search(t.Context(), connection, nil, nil, &page, nil)
-	require.ErrorIs(t, err, repository.ErrSearchProduct)
-	require.ErrorContains(t, err, "page without limit")
+	connection.EXPECT().
+		SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewProduct().FindByReceptionIDs(t.Context(), connection, nil)
+	require.ErrorIs(t, err, repository.ErrFindByReceptionIDsProduct)
+	require.ErrorContains(t, err, "some error")
 }
 
-func TestProductUnitSearch(t *testing.T) {
+func TestProductUnitFindByIDs(t *testing.T) {
 	connection := mocks.NewMockConnection(t)
 
 	connection.EXPECT().
@@ -171,8 +363,47 @@ func TestProductUnitSearch(t *testing.T) {
 		Return(errors.New("some error")).
 		Once()
 
-	_, err := repository.NewProduct().Search(t.Context(), connection, nil, nil, nil, nil)
-	require.ErrorIs(t, err, repository.ErrSearchProduct)
+	_, err := repository.NewProduct().FindByIDs(t.Context(), connection, []domain.ProductID{uuid.New()})
+	require.ErrorIs(t, err, repository.ErrFindByIDsProduct)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestProductUnitChangeStatus(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows).
+		Once()
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	transition := domain.ProductTransition{
+		ProductID: uuid.New(),
+		From:      domain.ProductStored,
+		To:        domain.ProductIssued,
+	}
+	_, err := repository.NewProduct().ChangeStatus(t.Context(), connection, transition)
+	require.ErrorIs(t, err, repository.ErrChangeStatus)
+	require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+
+	_, err = repository.NewProduct().ChangeStatus(t.Context(), connection, transition)
+	require.ErrorIs(t, err, repository.ErrChangeStatus)
+	require.NotErrorIs(t, err, domain.ErrProductInvalidTransition)
+}
+
+func TestProductUnitStoreReceived(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, errors.New("some error")).
+		Once()
+
+	err := repository.NewProduct().StoreReceived(t.Context(), connection, []domain.ReceptionID{uuid.New()}, nil)
+	require.ErrorIs(t, err, repository.ErrStoreReceived)
 	require.ErrorContains(t, err, "some error")
 }
 
@@ -189,22 +420,138 @@ func TestProductUnitCreate(t *testing.T) {
 	require.ErrorContains(t, err, "some error")
 }
 
+func TestProductUnitCreateBatch(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	product := domain.Product{
+		ID:          uuid.New(),
+		ReceptionID: uuid.New(),
+		Type:        domain.Shoes,
+		CreatedAt:   time.Now(),
+		ProductAttributes: domain.ProductAttributes{
+			WeightGrams:      pointer.Ref(1200),
+			InspectionStatus: pointer.Ref(domain.InspectionRejected),
+			InspectionReason: pointer.Ref("Коробка вскрыта"),
+		},
+	}
+	connection.EXPECT().
+		ExecBatch(mock.Anything, mock.Anything, [][]any{{
+			product.ID, product.ReceptionID, product.Type, product.CreatedAt, product.CreatedBy, product.Barcode,
+			product.WeightGrams, product.LengthCM, product.WidthCM, product.HeightCM, product.DeclaredValue,
+			product.InspectionStatus, product.InspectionReason,
+		}}).
+		Return(errors.New("some error")).
+		Once()
+
+	err := repository.NewProduct().CreateBatch(t.Context(), connection, []domain.Product{product})
+	require.ErrorIs(t, err, repository.ErrCreateBatch)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestProductUnitCreateDuplicateBarcode(t *testing.T) {
+	duplicate := &pgconn.PgError{Code: "23505", ConstraintName: "products_reception_barcode_unique"}
+
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, duplicate).
+		Once()
+	connection.EXPECT().ExecBatch(mock.Anything, mock.Anything, mock.Anything).Return(duplicate).Once()
+
+	err := repository.NewProduct().Create(t.Context(), connection, domain.Product{})
+	require.ErrorIs(t, err, repository.ErrCreateProduct)
+	require.ErrorIs(t, err, domain.ErrProductDuplicateBarcode)
+
+	err = repository.NewProduct().CreateBatch(t.Context(), connection, []domain.Product{{}})
+	require.ErrorIs(t, err, repository.ErrCreateBatch)
+	require.ErrorIs(t, err, domain.ErrProductDuplicateBarcode)
+}
+
+func TestProductUnitFindInProgressByBarcodes(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewProduct().FindInProgressByBarcodes(t.Context(), connection, []string{"4600000000017"})
+	require.ErrorIs(t, err, repository.ErrFindInProgressByBarcodes)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestProductUnitFindByBarcode(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewProduct().FindByBarcode(t.Context(), connection, "4600000000017")
+	require.ErrorIs(t, err, repository.ErrFindByBarcode)
+	require.ErrorContains(t, err, "some error")
+}
+
 func TestProductUnitDelete(t *testing.T) {
 	connection := mocks.NewMockConnection(t)
 
-	connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything).
-		Return(0, errors.New("some error")).
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
 		Once()
 
 	var receptionID domain.ReceptionID
-	err := repository.NewProduct().DeleteLast(t.Context(), connection, receptionID)
+	_, err := repository.NewProduct().DeleteLast(t.Context(), connection, receptionID, uuid.New())
 	require.ErrorIs(t, err, repository.ErrDeleteProduct)
 	require.ErrorContains(t, err, "some error")
 }
 
+func TestProductUnitDeleteEmpty(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows).
+		Once()
+
+	_, err := repository.NewProduct().DeleteLast(t.Context(), connection, uuid.New(), uuid.New())
+	require.ErrorIs(t, err, repository.ErrDeleteProduct)
+	require.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestProductUnitDeleteNotReceived(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	_, err := repository.NewProduct().DeleteLast(t.Context(), connection, uuid.New(), uuid.New())
+	require.ErrorIs(t, err, repository.ErrDeleteProduct)
+	require.ErrorIs(t, err, domain.ErrProductInvalidTransition)
+	require.NotErrorIs(t, err, domain.ErrProductInOrder)
+}
+
+func TestProductUnitDeleteInOrder(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, dest any, _ string, _ ...any) error {
+			reflect.ValueOf(dest).Elem().FieldByName("Status").SetString(string(domain.ProductReceived))
+			reflect.ValueOf(dest).Elem().FieldByName("InOrder").SetBool(true)
+
+			return nil
+		}).
+		Once()
+
+	_, err := repository.NewProduct().DeleteLast(t.Context(), connection, uuid.New(), uuid.New())
+	require.ErrorIs(t, err, repository.ErrDeleteProduct)
+	require.ErrorIs(t, err, domain.ErrProductInOrder)
+	require.NotErrorIs(t, err, domain.ErrProductInvalidTransition)
+}
+
 func fixtureCreateProduct(
 	ctx context.Context,
-	t *testing.T,
+	t testing.TB,
 	connection domain.Connection,
 	id domain.ReceptionID,
 	receptionID domain.ReceptionID,
@@ -222,45 +569,5 @@ func fixtureCreateProduct(
 	return product
 }
 
-func TestUnitProducts_Search(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name         string
-		from, to     *time.Time
-		page, limit  *int
-		prepareMocks func(*mocks.MockConnection)
-		check        func(*testing.T, []domain.Product, error)
-	}{
-		{
-			name: "Success - no params",
-			prepareMocks: func(connection *mocks.MockConnection) {
-				const expectedQuery = "select id, reception_id, type, created_at from products order by created_at"
-				connection.EXPECT().
-					SelectContext(mock.Anything, mock.Anything, expectedQuery).
-					Return(nil).
-					Once()
-			},
-			check: func(t *testing.T, _ []domain.Product, err error) {
-				require.NoError(t, err)
-			},
-		},
-	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			connection := mocks.NewMockConnection(t)
-			if test.prepareMocks != nil {
-				test.prepareMocks(connection)
-			}
-
-			products, err := repository.NewProduct().
-				Search(t.Context(), connection, test.from, test.to, test.page, test.limit)
-
-			if test.check != nil {
-				test.check(t, products, err)
-			}
-		})
-	}
-}
+const productColumns = "id, reception_id, type, created_at, created_by, barcode,\n\t" +
+	"weight_grams, length_cm, width_cm, height_cm, declared_value, inspection_status, inspection_reason, status"
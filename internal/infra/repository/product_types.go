@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+)
+
+var _ domain.ProductTypesRepository = (*ProductTypes)(nil)
+
+var (
+	errProductTypes            = errors.New("product types repository error")
+	ErrProductTypesFindAll     = errors.Join(errProductTypes, errors.New("find all failed"))
+	ErrProductTypesFindByCodes = errors.Join(errProductTypes, errors.New("find by codes failed"))
+	ErrProductTypesCreate      = errors.Join(errProductTypes, errors.New("create failed"))
+	ErrProductTypesUpdate      = errors.Join(errProductTypes, errors.New("update failed"))
+)
+
+const (
+	productTypesPrimaryKey = "product_types_pkey"
+	productTypeColumns     = `code, names, active`
+)
+
+type ProductTypes struct{}
+
+func NewProductTypes() *ProductTypes {
+	return &ProductTypes{}
+}
+
+func (r *ProductTypes) FindAll(
+	ctx context.Context,
+	connection domain.Connection,
+	includeInactive bool,
+) ([]domain.ProductTypeEntry, error) {
+	const query = `select ` + productTypeColumns + ` from product_types
+	where active or $1 order by code`
+
+	var entries []domain.ProductTypeEntry
+	err := connection.SelectContext(ctx, &entries, query, includeInactive)
+	if err != nil {
+		return nil, errors.Join(ErrProductTypesFindAll, err)
+	}
+
+	return entries, nil
+}
+
+func (r *ProductTypes) FindByCodes(
+	ctx context.Context,
+	connection domain.Connection,
+	codes []domain.ProductType,
+) ([]domain.ProductTypeEntry, error) {
+	const query = `select ` + productTypeColumns + ` from product_types
+	where code = any($1) order by code`
+
+	var entries []domain.ProductTypeEntry
+	err := connection.SelectContext(ctx, &entries, query, texts(codes))
+	if err != nil {
+		return nil, errors.Join(ErrProductTypesFindByCodes, err)
+	}
+
+	return entries, nil
+}
+
+func (r *ProductTypes) Create(
+	ctx context.Context,
+	connection domain.Connection,
+	entry domain.ProductTypeEntry,
+) error {
+	const query = `insert into product_types (` + productTypeColumns + `) values ($1, $2, $3)`
+
+	_, err := connection.ExecContext(ctx, query, entry.Code, entry.Names, entry.Active)
+	if isUniqueViolation(err, productTypesPrimaryKey) {
+		return errors.Join(ErrProductTypesCreate, domain.ErrProductTypeExists)
+	}
+	if err != nil {
+		return errors.Join(ErrProductTypesCreate, err)
+	}
+
+	return nil
+}
+
+func (r *ProductTypes) Update(
+	ctx context.Context,
+	connection domain.Connection,
+	entry domain.ProductTypeEntry,
+) error {
+	const query = `update product_types set names = $2, active = $3 where code = $1`
+
+	affected, err := connection.ExecContext(ctx, query, entry.Code, entry.Names, entry.Active)
+	if err != nil {
+		return errors.Join(ErrProductTypesUpdate, err)
+	}
+	if affected == 0 {
+		return errors.Join(ErrProductTypesUpdate, domain.ErrProductTypeNotFound)
+	}
+
+	return nil
+}
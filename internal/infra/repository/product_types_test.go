@@ -0,0 +1,102 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/repository"
+)
+
+func TestProductTypesIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		productTypes := repository.NewProductTypes()
+
+		entries, err := productTypes.FindAll(ctx, connection, false)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, "Shoes", entries[0].Names["en"])
+
+		furniture := domain.ProductTypeEntry{Code: "мебель", Names: map[string]string{"ru": "Мебель"}, Active: true}
+		require.NoError(t, productTypes.Create(ctx, connection, furniture))
+
+		err = productTypes.Create(ctx, connection, furniture)
+		require.ErrorIs(t, err, domain.ErrProductTypeExists)
+
+		furniture.Active = false
+		require.NoError(t, productTypes.Update(ctx, connection, furniture))
+
+		entries, err = productTypes.FindAll(ctx, connection, false)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+
+		entries, err = productTypes.FindAll(ctx, connection, true)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+
+		entries, err = productTypes.FindByCodes(ctx, connection, []domain.ProductType{domain.Shoes, "мебель", "посуда"})
+		require.NoError(t, err)
+		require.Equal(t, []domain.ProductTypeEntry{
+			{Code: "мебель", Names: map[string]string{"ru": "Мебель"}},
+			{Code: domain.Shoes, Names: map[string]string{"ru": "Обувь", "en": "Shoes"}, Active: true},
+		}, entries)
+
+		err = productTypes.Update(ctx, connection, domain.ProductTypeEntry{Code: "посуда", Names: furniture.Names})
+		require.ErrorIs(t, err, domain.ErrProductTypeNotFound)
+	})
+}
+
+func TestProductTypesUnitErrors(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Twice()
+
+	_, err := repository.NewProductTypes().FindAll(t.Context(), connection, true)
+	require.ErrorIs(t, err, repository.ErrProductTypesFindAll)
+
+	_, err = repository.NewProductTypes().FindByCodes(t.Context(), connection, []domain.ProductType{domain.Shoes})
+	require.ErrorIs(t, err, repository.ErrProductTypesFindByCodes)
+}
+
+func TestProductTypesUnitCreateExisting(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, &pgconn.PgError{Code: "23505", ConstraintName: "product_types_pkey"}).
+		Once()
+
+	err := repository.NewProductTypes().Create(t.Context(), connection, domain.ProductTypeEntry{Code: domain.Shoes})
+	require.ErrorIs(t, err, repository.ErrProductTypesCreate)
+	require.ErrorIs(t, err, domain.ErrProductTypeExists)
+}
+
+func TestProductTypesUnitUpdate(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, nil).
+		Once()
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, errors.New("some error")).
+		Once()
+
+	err := repository.NewProductTypes().Update(t.Context(), connection, domain.ProductTypeEntry{Code: "посуда"})
+	require.ErrorIs(t, err, repository.ErrProductTypesUpdate)
+	require.ErrorIs(t, err, domain.ErrProductTypeNotFound)
+
+	err = repository.NewProductTypes().Update(t.Context(), connection, domain.ProductTypeEntry{Code: domain.Shoes})
+	require.ErrorIs(t, err, repository.ErrProductTypesUpdate)
+	require.NotErrorIs(t, err, domain.ErrProductTypeNotFound)
+}
@@ -3,11 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
-	"strconv"
-	"strings"
-	"time"
 
 	"avito_pvz/internal/domain"
+
+	"github.com/jackc/pgx/v5"
 )
 
 var _ domain.ProductsRepository = (*Product)(nil)
@@ -15,8 +14,48 @@ var _ domain.ProductsRepository = (*Product)(nil)
 var (
 	errProduct       = errors.New("products repository error")
 	ErrCreateProduct = errors.Join(errProduct, errors.New("create failed"))
+	ErrCreateBatch   = errors.Join(errProduct, errors.New("create batch failed"))
 	ErrDeleteProduct = errors.Join(errProduct, errors.New("delete failed"))
-	ErrSearchProduct = errors.Join(errProduct, errors.New("search failed"))
+
+	ErrFindByReceptionIDsProduct = errors.Join(
+		errProduct,
+		errors.New("find by reception IDs failed"),
+	)
+	ErrFindInProgressByBarcodes = errors.Join(
+		errProduct,
+		errors.New("find in progress by barcodes failed"),
+	)
+	ErrFindByBarcode    = errors.Join(errProduct, errors.New("find by barcode failed"))
+	ErrFindByIDsProduct = errors.Join(errProduct, errors.New("find by IDs failed"))
+	ErrChangeStatus     = errors.Join(errProduct, errors.New("change status failed"))
+	ErrStoreReceived    = errors.Join(errProduct, errors.New("store received failed"))
+)
+
+const productReceptionBarcodeUnique = "products_reception_barcode_unique"
+
+// productInsertColumns leaves the status out: a new product is always received.
+const (
+	productInsertColumns = `id, reception_id, type, created_at, created_by, barcode,
+	weight_grams, length_cm, width_cm, height_cm, declared_value, inspection_status, inspection_reason`
+	productColumns = productInsertColumns + `, status`
+)
+
+// productAttributesJSON and deletedProductAttributesJSON are the
+// json_build_object pairs of the product attributes, keyed by domain field
+// names.
+const (
+	productAttributesJSON = `'WeightGrams', products.weight_grams,
+	'LengthCM', products.length_cm, 'WidthCM', products.width_cm, 'HeightCM', products.height_cm,
+	'DeclaredValue', products.declared_value,
+	'InspectionStatus', products.inspection_status, 'InspectionReason', products.inspection_reason,
+	'Status', products.status`
+	deletedProductAttributesJSON = `'WeightGrams', deleted_products.weight_grams,
+	'LengthCM', deleted_products.length_cm, 'WidthCM', deleted_products.width_cm,
+	'HeightCM', deleted_products.height_cm,
+	'DeclaredValue', deleted_products.declared_value,
+	'InspectionStatus', deleted_products.inspection_status,
+	'InspectionReason', deleted_products.inspection_reason,
+	'Status', deleted_products.status`
 )
 
 type Product struct{}
@@ -25,24 +64,20 @@ func NewProduct() *Product {
 	return &Product{}
 }
 
+const productInsert = `insert into products
+    (` + productInsertColumns + `)
+	values
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
 func (p *Product) Create(
 	ctx context.Context,
 	connection domain.Connection,
 	product domain.Product,
 ) error {
-	const query = `insert into products
-    (id, reception_id, type, created_at)
-	values
-    ($1, $2, $3, $4)`
-
-	_, err := connection.ExecContext(
-		ctx,
-		query,
-		product.ID,
-		product.ReceptionID,
-		product.Type,
-		product.CreatedAt,
-	)
+	_, err := connection.ExecContext(ctx, productInsert, productInsertArgs(product)...)
+	if isUniqueViolation(err, productReceptionBarcodeUnique) {
+		return errors.Join(ErrCreateProduct, domain.ErrProductDuplicateBarcode)
+	}
 	if err != nil {
 		return errors.Join(ErrCreateProduct, err)
 	}
@@ -50,83 +85,245 @@ func (p *Product) Create(
 	return nil
 }
 
-func (p *Product) DeleteLast(
+func (p *Product) CreateBatch(
 	ctx context.Context,
 	connection domain.Connection,
-	receptionID domain.ReceptionID,
+	products []domain.Product,
 ) error {
-	const query = ` 
-	delete from products 
-	where id = (select id from products
-	where reception_id = $1 order by created_at desc limit 1)`
+	args := make([][]any, 0, len(products))
+	for _, product := range products {
+		args = append(args, productInsertArgs(product))
+	}
 
-	_, err := connection.ExecContext(ctx, query, receptionID)
+	err := connection.ExecBatch(ctx, productInsert, args)
+	if isUniqueViolation(err, productReceptionBarcodeUnique) {
+		return errors.Join(ErrCreateBatch, domain.ErrProductDuplicateBarcode)
+	}
 	if err != nil {
-		return errors.Join(ErrDeleteProduct, err)
+		return errors.Join(ErrCreateBatch, err)
 	}
 
 	return nil
 }
 
-func (p *Product) Search(
+func productInsertArgs(product domain.Product) []any {
+	return []any{
+		product.ID,
+		product.ReceptionID,
+		product.Type,
+		product.CreatedAt,
+		product.CreatedBy,
+		product.Barcode,
+		product.WeightGrams,
+		product.LengthCM,
+		product.WidthCM,
+		product.HeightCM,
+		product.DeclaredValue,
+		product.InspectionStatus,
+		product.InspectionReason,
+	}
+}
+
+// DeleteLast removes the most recently added product of the reception, moves
+// it to deleted_products with deletedBy and returns it. Only a received
+// product outside of orders is removed: a product in an order fails with
+// ErrProductInOrder, a product past received fails with
+// ErrProductInvalidTransition, and both keep their transitions and orders.
+func (p *Product) DeleteLast(
 	ctx context.Context,
 	connection domain.Connection,
-	from *time.Time,
-	to *time.Time,
-	page *int,
-	limit *int,
-) ([]domain.Product, error) {
-	if from != nil && to != nil && to.Before(*from) {
-		return nil, errors.Join(ErrSearchProduct, errors.New("from must be less than to"))
+	receptionID domain.ReceptionID,
+	deletedBy domain.UserID,
+) (domain.Product, error) {
+	const lastQuery = `select id, status,
+		exists (select 1 from order_products where order_products.product_id = products.id) as in_order
+	from products
+	where reception_id = $1 order by created_at desc limit 1
+	for update`
+	const query = `with deleted as (
+		delete from products
+		where id = $1 and status = 'received'
+			and not exists (select 1 from order_products where order_products.product_id = products.id)
+		returning ` + productColumns + `
+	), archived as (
+		insert into deleted_products (` + productColumns + `, deleted_by)
+		select ` + productColumns + `, $2 from deleted
+	)
+	select ` + productColumns + ` from deleted`
+
+	var product domain.Product
+	var last struct {
+		ID      domain.ProductID     `db:"id"`
+		Status  domain.ProductStatus `db:"status"`
+		InOrder bool                 `db:"in_order"`
+	}
+	err := connection.GetContext(ctx, &last, lastQuery, receptionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return product, errors.Join(ErrDeleteProduct, domain.ErrProductNotFound)
 	}
-	if page != nil && *page < 0 {
-		return nil, errors.Join(ErrSearchProduct, errors.New("invalid page"))
+	if err != nil {
+		return product, errors.Join(ErrDeleteProduct, err)
 	}
-	if limit != nil && *limit <= 0 {
-		return nil, errors.Join(ErrSearchProduct, errors.New("invalid limit"))
+	if last.InOrder {
+		return product, errors.Join(ErrDeleteProduct, domain.ErrProductInOrder)
 	}
-	if page != nil && limit == nil {
-		return nil, errors.Join(ErrSearchProduct, errors.New("page without limit"))
+	if last.Status != domain.ProductReceived {
+		return product, errors.Join(ErrDeleteProduct, domain.ErrProductInvalidTransition)
 	}
 
-	var conditions []string
-	var limits string
-	var args []any
+	err = connection.GetContext(ctx, &product, query, last.ID, deletedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return product, errors.Join(ErrDeleteProduct, domain.ErrProductInvalidTransition)
+	}
+	if err != nil {
+		return product, errors.Join(ErrDeleteProduct, err)
+	}
 
-	arg := func(v any) string {
-		args = append(args, v)
+	return product, nil
+}
+
+func (p *Product) FindByIDs(
+	ctx context.Context,
+	connection domain.Connection,
+	productIDs []domain.ProductID,
+) ([]domain.Product, error) {
+	const query = `select ` + productColumns + ` from products where id = any($1)`
 
-		return "$" + strconv.Itoa(len(args))
+	var products []domain.Product
+	err := connection.SelectContext(ctx, &products, query, productIDs)
+	if err != nil {
+		return nil, errors.Join(ErrFindByIDsProduct, err)
 	}
 
-	query := `select id, reception_id, type, created_at from products`
+	return products, nil
+}
+
+// ChangeStatus updates the status only while the product still has the
+// status the transition starts from, so concurrent changes cannot both win.
+func (p *Product) ChangeStatus(
+	ctx context.Context,
+	connection domain.Connection,
+	transition domain.ProductTransition,
+) (domain.Product, error) {
+	const query = `with changed as (
+		update products set status = $3
+		where id = $1 and status = $2
+		returning ` + productColumns + `
+	), logged as (
+		insert into product_transitions (product_id, from_status, to_status, changed_by)
+		select id, $2, $3, $4 from changed
+	)
+	select ` + productColumns + ` from changed`
 
-	if from != nil {
-		conditions = append(conditions, arg(*from)+" <= created_at")
+	var product domain.Product
+	err := connection.GetContext(
+		ctx,
+		&product,
+		query,
+		transition.ProductID,
+		transition.From,
+		transition.To,
+		transition.ChangedBy,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return product, errors.Join(ErrChangeStatus, domain.ErrProductInvalidTransition)
 	}
-	if to != nil {
-		conditions = append(conditions, "created_at <= "+arg(*to))
+	if err != nil {
+		return product, errors.Join(ErrChangeStatus, err)
 	}
-	if limit != nil {
-		if page != nil {
-			limits += " offset " + arg((*page)*(*limit))
-		}
 
-		limits += " limit " + arg(*limit)
-	}
+	return product, nil
+}
 
-	if 0 < len(conditions) {
-		query += " where " + strings.Join(conditions, " and ")
+func (p *Product) StoreReceived(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionIDs []domain.ReceptionID,
+	storedBy *domain.UserID,
+) error {
+	const query = `with stored as (
+		update products set status = case when inspection_status = 'rejected'
+			then 'returned'::product_status else 'stored'::product_status end
+		where reception_id = any($1) and status = 'received'
+		returning id, status
+	)
+	insert into product_transitions (product_id, from_status, to_status, changed_by)
+	select id, 'received', status, $2 from stored`
+
+	_, err := connection.ExecContext(ctx, query, receptionIDs, storedBy)
+	if err != nil {
+		return errors.Join(ErrStoreReceived, err)
 	}
-	query += " order by created_at"
-	if limits != "" {
-		query += limits
+
+	return nil
+}
+
+func (p *Product) FindByReceptionIDs(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionIDs []domain.ReceptionID,
+) ([]domain.Product, error) {
+	const query = `select ` + productColumns + ` from products
+	where reception_id = any($1) order by created_at`
+
+	var products []domain.Product
+	err := connection.SelectContext(ctx, &products, query, receptionIDs)
+	if err != nil {
+		return nil, errors.Join(ErrFindByReceptionIDsProduct, err)
 	}
 
+	return products, nil
+}
+
+func (p *Product) FindInProgressByBarcodes(
+	ctx context.Context,
+	connection domain.Connection,
+	barcodes []string,
+) ([]domain.Product, error) {
+	const query = `select ` + productColumns + ` from products
+	where barcode = any($1) and exists (select 1 from receptions
+		where receptions.id = products.reception_id and receptions.status = 'in_progress')
+	order by created_at, id`
+
 	var products []domain.Product
-	err := connection.SelectContext(ctx, &products, query, args...)
+	err := connection.SelectContext(ctx, &products, query, barcodes)
 	if err != nil {
-		return nil, errors.Join(ErrSearchProduct, err)
+		return nil, errors.Join(ErrFindInProgressByBarcodes, err)
 	}
+
 	return products, nil
 }
+
+func (p *Product) FindByBarcode(
+	ctx context.Context,
+	connection domain.Connection,
+	barcode string,
+) ([]domain.ProductLocation, error) {
+	const query = `select pvz.id as "pvz.id", pvz.city as "pvz.city", pvz.registered_at as "pvz.registered_at",
+		pvz.time_zone as "pvz.time_zone",
+		to_char(pvz.opens_at, 'HH24:MI') as "pvz.opens_at", to_char(pvz.closes_at, 'HH24:MI') as "pvz.closes_at",
+		receptions.id as "reception.id", receptions.pvz_id as "reception.pvz_id",
+		receptions.status as "reception.status", receptions.created_at as "reception.created_at",
+		receptions.created_by as "reception.created_by", receptions.closed_by as "reception.closed_by",
+		products.id as "product.id", products.reception_id as "product.reception_id",
+		products.type as "product.type", products.created_at as "product.created_at",
+		products.created_by as "product.created_by", products.barcode as "product.barcode",
+		products.weight_grams as "product.weight_grams", products.length_cm as "product.length_cm",
+		products.width_cm as "product.width_cm", products.height_cm as "product.height_cm",
+		products.declared_value as "product.declared_value",
+		products.inspection_status as "product.inspection_status",
+		products.inspection_reason as "product.inspection_reason", products.status as "product.status"
+	from products
+	join receptions on receptions.id = products.reception_id
+	join pvz on pvz.id = receptions.pvz_id
+	where products.barcode = $1
+	order by products.created_at desc, products.id`
+
+	var locations []domain.ProductLocation
+	err := connection.SelectContext(ctx, &locations, query, barcode)
+	if err != nil {
+		return nil, errors.Join(ErrFindByBarcode, err)
+	}
+
+	return locations, nil
+}
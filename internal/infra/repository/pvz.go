@@ -3,19 +3,33 @@ package repository
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 
 	"avito_pvz/internal/domain"
+
+	"github.com/jackc/pgx/v5"
 )
 
 var _ domain.PVZsRepository = (*PVZ)(nil)
 
 var (
-	errPVZ          = errors.New("pvzs error")
-	ErrPVZCreate    = errors.Join(errPVZ, errors.New("create failed"))
-	ErrPVZFindByIDs = errors.Join(errPVZ, errors.New("find by IDs failed"))
-	ErrPVZFindAll   = errors.Join(errPVZ, errors.New("find all failed"))
+	errPVZ            = errors.New("pvzs error")
+	ErrPVZCreate      = errors.Join(errPVZ, errors.New("create failed"))
+	ErrPVZFindByID    = errors.Join(errPVZ, errors.New("find by ID failed"))
+	ErrPVZFindByIDs   = errors.Join(errPVZ, errors.New("find by IDs failed"))
+	ErrPVZFindAll     = errors.Join(errPVZ, errors.New("find all failed"))
+	ErrPVZFindDetails = errors.Join(errPVZ, errors.New("find details failed"))
+
+	ErrPVZCount                    = errors.Join(errPVZ, errors.New("count failed"))
+	ErrPVZExport                   = errors.Join(errPVZ, errors.New("export failed"))
+	ErrPVZStream                   = errors.Join(errPVZ, errors.New("stream failed"))
+	ErrPVZSearchReceptionsProducts = errors.Join(errPVZ, errors.New("search with receptions and products failed"))
 )
 
+const pvzColumns = `id, city, registered_at, time_zone,
+	to_char(opens_at, 'HH24:MI') as opens_at, to_char(closes_at, 'HH24:MI') as closes_at`
+
 type PVZ struct{}
 
 func NewPVZ() *PVZ {
@@ -24,11 +38,20 @@ func NewPVZ() *PVZ {
 
 func (p *PVZ) Create(ctx context.Context, connection domain.Connection, pvz domain.PVZ) error {
 	const query = `insert into pvz
-    (id, city, registered_at)
+    (id, city, registered_at, time_zone, opens_at, closes_at)
 	values
-    ($1, $2, $3)`
+    ($1, $2, $3, $4, $5::time, $6::time)`
 
-	_, err := connection.ExecContext(ctx, query, pvz.ID, pvz.City, pvz.RegisteredAt)
+	_, err := connection.ExecContext(
+		ctx,
+		query,
+		pvz.ID,
+		pvz.City,
+		pvz.RegisteredAt,
+		pvz.TimeZone,
+		pvz.OpensAt,
+		pvz.ClosesAt,
+	)
 	if err != nil {
 		return errors.Join(ErrPVZCreate, err)
 	}
@@ -37,7 +60,7 @@ func (p *PVZ) Create(ctx context.Context, connection domain.Connection, pvz doma
 }
 
 func (p *PVZ) FindAll(ctx context.Context, connection domain.Connection) ([]domain.PVZ, error) {
-	const query = `select id, city, registered_at from pvz`
+	const query = `select ` + pvzColumns + ` from pvz`
 
 	var pvzs []domain.PVZ
 	err := connection.SelectContext(ctx, &pvzs, query)
@@ -48,12 +71,31 @@ func (p *PVZ) FindAll(ctx context.Context, connection domain.Connection) ([]doma
 	return pvzs, nil
 }
 
+func (p *PVZ) FindByID(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+) (domain.PVZ, error) {
+	const query = `select ` + pvzColumns + ` from pvz where id = $1`
+
+	var pvz domain.PVZ
+	err := connection.GetContext(ctx, &pvz, query, pvzID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pvz, errors.Join(ErrPVZFindByID, domain.ErrPVZNotFound)
+	}
+	if err != nil {
+		return pvz, errors.Join(ErrPVZFindByID, err)
+	}
+
+	return pvz, nil
+}
+
 func (p *PVZ) FindByIDs(
 	ctx context.Context,
 	connection domain.Connection,
 	pvzIDs []domain.PVZID,
 ) ([]domain.PVZ, error) {
-	const query = `select id, city, registered_at from pvz where id = any($1)`
+	const query = `select ` + pvzColumns + ` from pvz where id = any($1)`
 
 	var pvzs []domain.PVZ
 	err := connection.SelectContext(ctx, &pvzs, query, pvzIDs)
@@ -63,3 +105,450 @@ func (p *PVZ) FindByIDs(
 
 	return pvzs, nil
 }
+
+// SearchReceptionsProducts selects a page of PVZs matching the filter together
+// with the matching receptions of every PVZ and their products in one
+// statement. Pages start from 1; when after is set the page is ignored and rows
+// following the cursor are returned, which requires ordering by registration.
+func (p *PVZ) SearchReceptionsProducts(
+	ctx context.Context,
+	connection domain.Connection,
+	filter domain.PVZFilter,
+	page int,
+	limit int,
+	after *domain.Cursor,
+) ([]domain.PVZReceptionsProducts, error) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	search, err := newPVZSearch(filter, page, limit, after, arg)
+	if err != nil {
+		return nil, errors.Join(ErrPVZSearchReceptionsProducts, err)
+	}
+
+	query := `with page as (
+		select pvz.*, row_number() over (order by ` + search.order + `) as position
+		from pvz` + search.where + ` order by ` + search.order + search.limits + `
+	)
+	select ` + pvzColumns + `, ` + receptionsColumn(filter, "page", arg) + `
+	from page order by position`
+
+	var rows []struct {
+		domain.PVZ
+		Receptions []domain.ReceptionsProducts
+	}
+	err = connection.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, errors.Join(ErrPVZSearchReceptionsProducts, err)
+	}
+
+	result := make([]domain.PVZReceptionsProducts, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, domain.PVZReceptionsProducts{
+			PVZ:        row.PVZ,
+			Receptions: row.Receptions,
+		})
+	}
+
+	return result, nil
+}
+
+// Stream calls each for every PVZ matching the filter with its receptions and
+// products, in the order of the PVZ list. Rows come off the cursor one PVZ at
+// a time, so only the PVZ being handled is kept in memory.
+func (p *PVZ) Stream(
+	ctx context.Context,
+	connection domain.Connection,
+	filter domain.PVZFilter,
+	each func(domain.PVZReceptionsProducts) error,
+) error {
+	if err := validatePeriod(filter.Period); err != nil {
+		return errors.Join(ErrPVZStream, err)
+	}
+
+	order, err := pvzOrder(filter)
+	if err != nil {
+		return errors.Join(ErrPVZStream, err)
+	}
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	query := `select ` + pvzColumns + `, ` + receptionsColumn(filter, "pvz", arg) + `
+	from pvz` + pvzWhere(filter, nil, arg) + ` order by ` + order
+
+	var row struct {
+		domain.PVZ
+		Receptions []domain.ReceptionsProducts
+	}
+	err = connection.EachContext(ctx, &row, func() error {
+		item := domain.PVZReceptionsProducts{PVZ: row.PVZ, Receptions: row.Receptions}
+		// The next row must not be decoded into the slice handed over.
+		row.Receptions = nil
+
+		return each(item)
+	}, query, args...)
+	if err != nil {
+		return errors.Join(ErrPVZStream, err)
+	}
+
+	return nil
+}
+
+// receptionsColumn renders the receptions column of SearchReceptionsProducts:
+// the receptions of the PVZ in table matching the filter, each with its
+// matching products, aggregated into JSON.
+func receptionsColumn(filter domain.PVZFilter, table string, arg func(any) string) string {
+	receptionConditions := append(
+		[]string{"receptions.pvz_id = " + table + ".id"},
+		receptionFilterConditions(filter, table+".time_zone", arg)...,
+	)
+	productConditions := append(
+		[]string{"products.reception_id = receptions.id"},
+		productFilterConditions(filter, arg)...,
+	)
+
+	return `coalesce((select json_agg(json_build_object(
+			'Reception', json_build_object(
+				'ID', receptions.id,
+				'PVZID', receptions.pvz_id,
+				'Status', receptions.status,
+				'CreatedAt', receptions.created_at,
+				'CreatedBy', receptions.created_by,
+				'ClosedBy', receptions.closed_by
+			),
+			'Products', coalesce((select json_agg(json_build_object(
+				'ID', products.id,
+				'ReceptionID', products.reception_id,
+				'Type', products.type,
+				'CreatedAt', products.created_at,
+				'CreatedBy', products.created_by,
+				'Barcode', products.barcode,
+				` + productAttributesJSON + `
+			) order by products.created_at)
+			from products where ` + strings.Join(productConditions, " and ") + `), '[]')
+		) order by receptions.created_at)
+		from receptions where ` + strings.Join(receptionConditions, " and ") + `), '[]') as receptions`
+}
+
+// Count returns the number of PVZs matching the filter.
+func (p *PVZ) Count(ctx context.Context, connection domain.Connection, filter domain.PVZFilter) (int, error) {
+	if err := validatePeriod(filter.Period); err != nil {
+		return 0, errors.Join(ErrPVZCount, err)
+	}
+
+	var args []any
+	where := pvzWhere(filter, nil, func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	})
+
+	var row struct {
+		Total int
+	}
+	err := connection.GetContext(ctx, &row, `select count(*) as total from pvz`+where, args...)
+	if err != nil {
+		return 0, errors.Join(ErrPVZCount, err)
+	}
+
+	return row.Total, nil
+}
+
+// Export streams every product of the PVZs matching the filter, in the order
+// of the PVZ list, then by reception and product creation. Receptions and
+// products are narrowed by the filter the same way SearchReceptionsProducts
+// narrows them.
+func (p *PVZ) Export(
+	ctx context.Context,
+	connection domain.Connection,
+	filter domain.PVZFilter,
+	each func(domain.ExportRow) error,
+) error {
+	if err := validatePeriod(filter.Period); err != nil {
+		return errors.Join(ErrPVZExport, err)
+	}
+
+	order, err := pvzOrder(filter)
+	if err != nil {
+		return errors.Join(ErrPVZExport, err)
+	}
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	receptionConditions := append(
+		[]string{"receptions.pvz_id = pvz.id"},
+		receptionFilterConditions(filter, "pvz.time_zone", arg)...,
+	)
+	productConditions := append(
+		[]string{"products.reception_id = receptions.id"},
+		productFilterConditions(filter, arg)...,
+	)
+
+	query := `select pvz.id as "pvz.id", pvz.city as "pvz.city", pvz.registered_at as "pvz.registered_at",
+		receptions.id as "reception.id", receptions.pvz_id as "reception.pvz_id",
+		receptions.status as "reception.status", receptions.created_at as "reception.created_at",
+		receptions.created_by as "reception.created_by", receptions.closed_by as "reception.closed_by",
+		products.id as "product.id", products.reception_id as "product.reception_id",
+		products.type as "product.type", products.created_at as "product.created_at",
+		products.created_by as "product.created_by", products.barcode as "product.barcode",
+		products.status as "product.status"
+	from pvz
+	join receptions on ` + strings.Join(receptionConditions, " and ") + `
+	join products on ` + strings.Join(productConditions, " and ") +
+		pvzWhere(filter, nil, arg) + `
+	order by ` + order + `, receptions.created_at, receptions.id, products.created_at, products.id`
+
+	var row domain.ExportRow
+	err = connection.EachContext(ctx, &row, func() error {
+		return each(row)
+	}, query, args...)
+	if err != nil {
+		return errors.Join(ErrPVZExport, err)
+	}
+
+	return nil
+}
+
+// pvzSearch holds SQL fragments selecting a page of the pvz table.
+type pvzSearch struct {
+	where  string
+	order  string
+	limits string
+}
+
+func newPVZSearch(
+	filter domain.PVZFilter,
+	page int,
+	limit int,
+	after *domain.Cursor,
+	arg func(any) string,
+) (pvzSearch, error) {
+	var search pvzSearch
+
+	if err := validatePeriod(filter.Period); err != nil {
+		return search, err
+	}
+	if page < 1 {
+		return search, errors.New("invalid page")
+	}
+	if limit < 1 {
+		return search, errors.New("invalid limit")
+	}
+
+	order, err := pvzOrder(filter)
+	if err != nil {
+		return search, err
+	}
+	if after != nil && filter.Sort != domain.SortByRegisteredAt && filter.Sort != "" {
+		return search, errors.New("cursor requires sorting by registration")
+	}
+	search.order = order
+
+	search.where = pvzWhere(filter, after, arg)
+
+	if after == nil {
+		search.limits = " offset " + arg((page-1)*limit)
+	}
+	search.limits += " limit " + arg(limit)
+
+	return search, nil
+}
+
+// pvzOrder renders the order by clause of the PVZ list, ties are broken by id.
+func pvzOrder(filter domain.PVZFilter) (string, error) {
+	direction := " asc"
+	if filter.Descending {
+		direction = " desc"
+	}
+
+	var sortKey string
+	switch filter.Sort {
+	case domain.SortByRegisteredAt, "":
+		sortKey = "pvz.registered_at"
+	case domain.SortByLastReceptionAt:
+		sortKey = "(select max(created_at) from receptions where receptions.pvz_id = pvz.id)"
+	case domain.SortByProductCount:
+		sortKey = `(select count(*) from products
+			join receptions on receptions.id = products.reception_id
+			where receptions.pvz_id = pvz.id)`
+	default:
+		return "", errors.New("invalid sort")
+	}
+
+	return sortKey + direction + " nulls last, pvz.id" + direction, nil
+}
+
+// pvzWhere renders the where clause selecting PVZs matching the filter and,
+// when after is set, following the cursor.
+func pvzWhere(filter domain.PVZFilter, after *domain.Cursor, arg func(any) string) string {
+	var conditions []string
+	if 0 < len(filter.Cities) {
+		conditions = append(conditions, "pvz.city::text = any("+arg(texts(filter.Cities))+"::text[])")
+	}
+	if filter.Status != nil {
+		condition := openNowCondition
+		if *filter.Status == domain.PVZClosed {
+			condition = "not " + condition
+		}
+		conditions = append(conditions, condition)
+	}
+	if filter.HasActiveReception != nil {
+		condition := `exists (select 1 from receptions
+			where receptions.pvz_id = pvz.id and receptions.status = 'in_progress')`
+		if !*filter.HasActiveReception {
+			condition = "not " + condition
+		}
+		conditions = append(conditions, condition)
+	}
+	receptionConditions := receptionFilterConditions(filter, "pvz.time_zone", arg)
+	if 0 < len(receptionConditions) {
+		conditions = append(conditions, `exists (select 1 from receptions
+			where receptions.pvz_id = pvz.id and `+strings.Join(receptionConditions, " and ")+`)`)
+	}
+	if after != nil {
+		conditions = append(conditions, afterCondition("pvz.registered_at", "pvz.id", *after, filter.Descending, arg))
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return " where " + strings.Join(conditions, " and ")
+}
+
+// openNowCondition checks the current local time of the PVZ against its
+// working hours, which may cross midnight.
+const openNowCondition = `(case when pvz.opens_at < pvz.closes_at
+	then pvz.opens_at <= (now() at time zone pvz.time_zone)::time
+		and (now() at time zone pvz.time_zone)::time < pvz.closes_at
+	else pvz.opens_at <= (now() at time zone pvz.time_zone)::time
+		or (now() at time zone pvz.time_zone)::time < pvz.closes_at
+	end)`
+
+// receptionFilterConditions selects receptions shown for a PVZ, the same
+// conditions decide whether the PVZ is listed at all.
+func receptionFilterConditions(filter domain.PVZFilter, timeZone string, arg func(any) string) []string {
+	conditions := periodConditions("receptions.created_at", timeZone, filter.Period, arg)
+	if filter.ReceptionStatus != nil {
+		conditions = append(conditions, "receptions.status::text = "+arg(string(*filter.ReceptionStatus)))
+	}
+	if filter.ReceptionCreatedBy != nil {
+		conditions = append(conditions, "receptions.created_by = "+arg(*filter.ReceptionCreatedBy))
+	}
+	if 0 < len(filter.ProductTypes) || 0 < len(filter.ProductStatuses) {
+		conditions = append(conditions, `exists (select 1 from products
+			where products.reception_id = receptions.id and `+
+			strings.Join(productFilterConditions(filter, arg), " and ")+`)`)
+	}
+
+	return conditions
+}
+
+func texts[T ~string](values []T) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, string(value))
+	}
+
+	return result
+}
+
+func productFilterConditions(filter domain.PVZFilter, arg func(any) string) []string {
+	var conditions []string
+	if 0 < len(filter.ProductTypes) {
+		conditions = append(conditions, "products.type::text = any("+arg(texts(filter.ProductTypes))+"::text[])")
+	}
+	if 0 < len(filter.ProductStatuses) {
+		conditions = append(
+			conditions,
+			"products.status::text = any("+arg(texts(filter.ProductStatuses))+"::text[])",
+		)
+	}
+
+	return conditions
+}
+
+// FindDetails loads the PVZ, its in-progress reception with products and the
+// all-time counters in one statement. JSON keys match domain field names so
+// the aggregated columns decode straight into domain types.
+func (p *PVZ) FindDetails(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+) (domain.PVZDetails, error) {
+	const query = `with active as (
+		select ` + receptionColumns + ` from receptions
+		where pvz_id = $1 and status = 'in_progress'
+		order by created_at desc
+		limit 1
+	)
+	select ` + pvzColumns + `,
+		(select json_build_object(
+			'ID', id, 'PVZID', pvz_id, 'Status', status, 'CreatedAt', created_at,
+			'CreatedBy', created_by, 'ClosedBy', closed_by
+		) from active) as active_reception,
+		coalesce((select json_agg(json_build_object(
+			'ID', products.id,
+			'ReceptionID', products.reception_id,
+			'Type', products.type,
+			'CreatedAt', products.created_at,
+			'CreatedBy', products.created_by,
+			'Barcode', products.barcode,
+			` + productAttributesJSON + `
+		) order by products.created_at)
+		from products join active on active.id = products.reception_id), '[]') as active_products,
+		(select count(*) from receptions where pvz_id = pvz.id) as receptions_total,
+		(select count(*) from receptions
+			where pvz_id = pvz.id and status = 'close') as receptions_closed,
+		coalesce((select json_object_agg(type, total) from (
+			select products.type, count(*) as total from products
+			join receptions on receptions.id = products.reception_id
+			where receptions.pvz_id = pvz.id
+			group by products.type
+		) as by_type), '{}') as products_by_type
+	from pvz where id = $1`
+
+	var row struct {
+		domain.PVZ
+		ActiveReception  *domain.Reception
+		ActiveProducts   []domain.Product
+		ReceptionsTotal  int
+		ReceptionsClosed int
+		ProductsByType   map[domain.ProductType]int
+	}
+	err := connection.GetContext(ctx, &row, query, pvzID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.PVZDetails{}, errors.Join(ErrPVZFindDetails, domain.ErrPVZNotFound)
+	}
+	if err != nil {
+		return domain.PVZDetails{}, errors.Join(ErrPVZFindDetails, err)
+	}
+
+	details := domain.PVZDetails{
+		PVZ:              row.PVZ,
+		ReceptionsTotal:  row.ReceptionsTotal,
+		ReceptionsClosed: row.ReceptionsClosed,
+		ProductsByType:   row.ProductsByType,
+	}
+	if row.ActiveReception != nil {
+		details.ActiveReception = &domain.ReceptionsProducts{
+			Reception: *row.ActiveReception,
+			Products:  row.ActiveProducts,
+		}
+	}
+
+	return details, nil
+}
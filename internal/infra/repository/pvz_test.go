@@ -12,6 +12,7 @@ import (
 
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
 	"avito_pvz/internal/infra/repository"
 )
 
@@ -42,6 +43,331 @@ func TestPVZsIntegration(t *testing.T) {
 		require.Equal(t, pvzFound[0].City, pvzDefault[0].City)
 		require.Equal(t, pvzFound[1].ID, pvzDefault[1].ID)
 		require.Equal(t, pvzFound[1].City, pvzDefault[1].City)
+
+		pvzByID, err := repoPvz.FindByID(ctx, connection, uuid1)
+		require.NoError(t, err)
+		require.Equal(t, pvz1.ID, pvzByID.ID)
+		require.Equal(t, pvz1.PVZSchedule, pvzByID.PVZSchedule)
+	})
+}
+
+func TestPVZDetailsIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoPvz := repository.NewPVZ()
+
+		pvzID := uuid.New()
+		closedReceptionID := uuid.New()
+		activeReceptionID := uuid.New()
+		now := time.Now()
+
+		pvz := fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		_ = fixtureCreateReceptin(ctx, t, connection, closedReceptionID, pvzID)
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), closedReceptionID, "обувь", now)
+		require.NoError(t, repository.NewReceptions().Close(ctx, connection, closedReceptionID, uuid.New()))
+
+		_ = fixtureCreateReceptin(ctx, t, connection, activeReceptionID, pvzID)
+		product := fixtureCreateProduct(
+			ctx,
+			t,
+			connection,
+			uuid.New(),
+			activeReceptionID,
+			"обувь",
+			now,
+		)
+
+		details, err := repoPvz.FindDetails(ctx, connection, pvzID)
+		require.NoError(t, err)
+		require.Equal(t, pvz.ID, details.PVZ.ID)
+		require.Equal(t, 2, details.ReceptionsTotal)
+		require.Equal(t, 1, details.ReceptionsClosed)
+		require.Equal(t, 2, details.ProductsByType["обувь"])
+		require.NotNil(t, details.ActiveReception)
+		require.Equal(t, activeReceptionID, details.ActiveReception.Reception.ID)
+		require.Len(t, details.ActiveReception.Products, 1)
+		require.Equal(t, product.ID, details.ActiveReception.Products[0].ID)
+
+		_, err = repoPvz.FindDetails(ctx, connection, uuid.New())
+		require.ErrorIs(t, err, domain.ErrPVZNotFound)
+	})
+}
+
+func TestPVZSearchIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoPvz := repository.NewPVZ()
+
+		pvzIDs := []domain.PVZID{uuid.New(), uuid.New(), uuid.New()}
+		for _, pvzID := range pvzIDs {
+			_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		}
+		receptionID := uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzIDs[1])
+
+		search := func(filter domain.PVZFilter, page, limit int, after *domain.Cursor) []domain.PVZ {
+			tree, err := repoPvz.SearchReceptionsProducts(ctx, connection, filter, page, limit, after)
+			require.NoError(t, err)
+
+			pvzs := make([]domain.PVZ, 0, len(tree))
+			for _, item := range tree {
+				pvzs = append(pvzs, item.PVZ)
+			}
+
+			return pvzs
+		}
+
+		firstPage := search(domain.PVZFilter{}, 1, 2, nil)
+		require.Len(t, firstPage, 2)
+
+		secondPage := search(domain.PVZFilter{}, 2, 2, nil)
+		require.Len(t, secondPage, 1)
+		require.NotContains(t, firstPage, secondPage[0])
+
+		last := firstPage[len(firstPage)-1]
+		afterFirst := search(domain.PVZFilter{}, 1, 2, &domain.Cursor{At: last.RegisteredAt, ID: last.ID})
+		require.Equal(t, secondPage, afterFirst)
+
+		from := time.Now().Add(-time.Hour)
+		withReceptions := search(domain.PVZFilter{Period: domain.Period{From: &from}}, 1, 10, nil)
+		require.Len(t, withReceptions, 1)
+		require.Equal(t, pvzIDs[1], withReceptions[0].ID)
+
+		product := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, "обувь", time.Now())
+		tree, err := repoPvz.SearchReceptionsProducts(
+			ctx,
+			connection,
+			domain.PVZFilter{Period: domain.Period{From: &from}},
+			1,
+			10,
+			nil,
+		)
+		require.NoError(t, err)
+		require.Len(t, tree, 1)
+		require.Equal(t, pvzIDs[1], tree[0].PVZ.ID)
+		require.Len(t, tree[0].Receptions, 1)
+		require.Equal(t, receptionID, tree[0].Receptions[0].Reception.ID)
+		require.Len(t, tree[0].Receptions[0].Products, 1)
+		require.Equal(t, product.ID, tree[0].Receptions[0].Products[0].ID)
+
+		tree, err = repoPvz.SearchReceptionsProducts(ctx, connection, domain.PVZFilter{}, 1, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, tree, 3)
+		require.Empty(t, tree[0].Receptions)
+	})
+}
+
+func TestPVZSearchFiltersIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoPvz := repository.NewPVZ()
+		now := time.Now()
+
+		pvzID1, pvzID2, pvzID3 := uuid.New(), uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID1, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID2, "Казань")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID3, "Москва")
+
+		activeReceptionID, employeeID := uuid.New(), uuid.New()
+		require.NoError(t, repository.NewReceptions().Create(ctx, connection, domain.Reception{
+			ID:        activeReceptionID,
+			PVZID:     pvzID1,
+			CreatedBy: &employeeID,
+		}))
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), activeReceptionID, "обувь", now)
+
+		closedReceptionID := uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, closedReceptionID, pvzID2)
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), closedReceptionID, "электроника", now)
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), closedReceptionID, "обувь", now)
+		require.NoError(t, repository.NewReceptions().Close(ctx, connection, closedReceptionID, uuid.New()))
+
+		search := func(filter domain.PVZFilter) []domain.PVZID {
+			tree, err := repoPvz.SearchReceptionsProducts(ctx, connection, filter, 1, 10, nil)
+			require.NoError(t, err)
+
+			ids := make([]domain.PVZID, 0, len(tree))
+			for _, item := range tree {
+				ids = append(ids, item.PVZ.ID)
+			}
+
+			return ids
+		}
+
+		require.ElementsMatch(
+			t,
+			[]domain.PVZID{pvzID1, pvzID3},
+			search(domain.PVZFilter{Cities: []domain.PVZCity{domain.Msk}}),
+		)
+		require.Equal(t, []domain.PVZID{pvzID1}, search(domain.PVZFilter{HasActiveReception: pointer.Ref(true)}))
+		require.ElementsMatch(
+			t,
+			[]domain.PVZID{pvzID2, pvzID3},
+			search(domain.PVZFilter{HasActiveReception: pointer.Ref(false)}),
+		)
+		require.Equal(t, []domain.PVZID{pvzID2}, search(domain.PVZFilter{ReceptionStatus: pointer.Ref(domain.Close)}))
+		require.Equal(t, []domain.PVZID{pvzID1}, search(domain.PVZFilter{ReceptionCreatedBy: &employeeID}))
+		require.Len(t, search(domain.PVZFilter{Status: pointer.Ref(domain.PVZOpen)}), 3)
+		require.Empty(t, search(domain.PVZFilter{Status: pointer.Ref(domain.PVZClosed)}))
+
+		total, err := repoPvz.Count(ctx, connection, domain.PVZFilter{Cities: []domain.PVZCity{domain.Msk}})
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+
+		sorted := search(domain.PVZFilter{Sort: domain.SortByProductCount, Descending: true})
+		require.Equal(t, []domain.PVZID{pvzID2, pvzID1, pvzID3}, sorted)
+
+		sorted = search(domain.PVZFilter{Sort: domain.SortByLastReceptionAt})
+		require.Equal(t, []domain.PVZID{pvzID1, pvzID2, pvzID3}, sorted)
+
+		tree, err := repoPvz.SearchReceptionsProducts(
+			ctx,
+			connection,
+			domain.PVZFilter{ProductTypes: []domain.ProductType{domain.Electronics}},
+			1,
+			10,
+			nil,
+		)
+		require.NoError(t, err)
+		require.Len(t, tree, 1)
+		require.Equal(t, pvzID2, tree[0].PVZ.ID)
+		require.Len(t, tree[0].Receptions, 1)
+		require.Len(t, tree[0].Receptions[0].Products, 1)
+		require.Equal(t, domain.Electronics, tree[0].Receptions[0].Products[0].Type)
+	})
+}
+
+// BenchmarkPVZSearchReceptionsProducts loads a full page of GET /pvz through
+// the service and the pool, so that the read-only transaction is measured
+// together with the statement. The fixtures are committed for the pool to see
+// them and are deleted with their PVZs afterwards.
+func BenchmarkPVZSearchReceptionsProducts(b *testing.B) {
+	const pvzCount, receptionsPerPVZ, productsPerReception = domain.MaxLimit, 5, 10
+
+	provider := newProvider(b)
+	registeredAt := time.Now().Add(-time.Hour)
+	pvzIDs := make([]domain.PVZID, 0, pvzCount)
+
+	b.Cleanup(func() {
+		require.NoError(b, provider.Execute(context.Background(), func(ctx context.Context, c domain.Connection) error {
+			_, err := c.ExecContext(ctx, "delete from pvz where id = any($1)", pvzIDs)
+
+			return err
+		}))
+	})
+	require.NoError(b, provider.ExecuteTx(b.Context(), func(ctx context.Context, connection domain.Connection) error {
+		for range pvzCount {
+			pvzID := uuid.New()
+			pvzIDs = append(pvzIDs, pvzID)
+			_ = fixtureCreatePVZ(ctx, b, connection, pvzID, "Москва")
+			for range receptionsPerPVZ {
+				receptionID := uuid.New()
+				_ = fixtureCreateReceptin(ctx, b, connection, receptionID, pvzID)
+				for range productsPerReception {
+					_ = fixtureCreateProduct(ctx, b, connection, uuid.New(), receptionID, "обувь", registeredAt)
+				}
+				require.NoError(b, repository.NewReceptions().Close(ctx, connection, receptionID, uuid.New()))
+			}
+		}
+
+		return nil
+	}))
+
+	service := domain.NewPVZService(
+		provider,
+		repository.NewPVZ(),
+		repository.NewProduct(),
+		repository.NewReceptions(),
+		nil,
+	)
+	authUser, err := domain.AuthenticateByToken(uuid.NewString() + ":" + string(domain.Moderator))
+	require.NoError(b, err)
+	filter := domain.PVZFilter{Period: domain.Period{From: &registeredAt}}
+	limit := domain.MaxLimit
+
+	for b.Loop() {
+		page, err := service.FindPVZReceptionProducts(b.Context(), authUser, filter, nil, &limit, nil)
+		require.NoError(b, err)
+		require.Len(b, page.Items, pvzCount)
+	}
+}
+
+func TestPVZExportIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		now := time.Now()
+
+		pvzID1, pvzID2 := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID1, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID2, "Казань")
+
+		receptionID1, receptionID2 := uuid.New(), uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID1, pvzID1)
+		productID1 := uuid.New()
+		productID2 := uuid.New()
+		_ = fixtureCreateProduct(ctx, t, connection, productID1, receptionID1, "обувь", now)
+		_ = fixtureCreateProduct(ctx, t, connection, productID2, receptionID1, "электроника", now.Add(time.Second))
+
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID2, pvzID2)
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID2, "обувь", now)
+
+		export := func(filter domain.PVZFilter) []domain.ExportRow {
+			var rows []domain.ExportRow
+			err := repository.NewPVZ().Export(ctx, connection, filter, func(row domain.ExportRow) error {
+				rows = append(rows, row)
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			return rows
+		}
+
+		rows := export(domain.PVZFilter{Cities: []domain.PVZCity{domain.Msk}})
+		require.Len(t, rows, 2)
+		require.Equal(t, pvzID1, rows[0].PVZ.ID)
+		require.Equal(t, domain.Msk, rows[0].PVZ.City)
+		require.Equal(t, receptionID1, rows[0].Reception.ID)
+		require.Equal(t, productID1, rows[0].Product.ID)
+		require.Equal(t, productID2, rows[1].Product.ID)
+		require.Equal(t, domain.Electronics, rows[1].Product.Type)
+
+		rows = export(domain.PVZFilter{ProductTypes: []domain.ProductType{domain.Shoes}})
+		require.Len(t, rows, 2)
+
+		stop := errors.New("stop")
+		err := repository.NewPVZ().Export(ctx, connection, domain.PVZFilter{}, func(domain.ExportRow) error {
+			return stop
+		})
+		require.ErrorIs(t, err, stop)
+	})
+}
+
+func TestPVZStreamIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		now := time.Now()
+
+		pvzID1, pvzID2 := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID1, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID2, "Казань")
+
+		receptionID := uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID1)
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, "обувь", now)
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, "электроника", now.Add(time.Second))
+
+		var items []domain.PVZReceptionsProducts
+		err := repository.NewPVZ().Stream(ctx, connection, domain.PVZFilter{}, func(
+			item domain.PVZReceptionsProducts,
+		) error {
+			items = append(items, item)
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		require.Equal(t, pvzID1, items[0].PVZ.ID)
+		require.Len(t, items[0].Receptions, 1)
+		require.Equal(t, receptionID, items[0].Receptions[0].Reception.ID)
+		require.Len(t, items[0].Receptions[0].Products, 2)
+		require.Equal(t, pvzID2, items[1].PVZ.ID)
+		require.Empty(t, items[1].Receptions)
 	})
 }
 
@@ -87,9 +413,143 @@ func TestPVZUnitFindByIDsl(t *testing.T) {
 	require.ErrorContains(t, err, "some error")
 }
 
+func TestPVZUnitFindByID(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewPVZ().FindByID(t.Context(), connection, uuid.New())
+	require.ErrorIs(t, err, repository.ErrPVZFindByID)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestPVZUnitFindDetails(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewPVZ().FindDetails(t.Context(), connection, uuid.New())
+	require.ErrorIs(t, err, repository.ErrPVZFindDetails)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestPVZUnitSearchReceptionsProducts(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewPVZ().SearchReceptionsProducts(t.Context(), connection, domain.PVZFilter{}, 1, 10, nil)
+	require.ErrorIs(t, err, repository.ErrPVZSearchReceptionsProducts)
+	require.ErrorContains(t, err, "some error")
+
+	_, err = repository.NewPVZ().SearchReceptionsProducts(t.Context(), connection, domain.PVZFilter{}, 0, 10, nil)
+	require.ErrorIs(t, err, repository.ErrPVZSearchReceptionsProducts)
+	require.ErrorContains(t, err, "invalid page")
+}
+
+func TestPVZUnitCount(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewPVZ().Count(t.Context(), connection, domain.PVZFilter{})
+	require.ErrorIs(t, err, repository.ErrPVZCount)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestPVZUnitExport(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().
+		EachContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	err := repository.NewPVZ().Export(t.Context(), connection, domain.PVZFilter{}, func(domain.ExportRow) error {
+		return nil
+	})
+	require.ErrorIs(t, err, repository.ErrPVZExport)
+	require.ErrorContains(t, err, "some error")
+
+	err = repository.NewPVZ().
+		Export(t.Context(), connection, domain.PVZFilter{Sort: "city"}, func(domain.ExportRow) error {
+			return nil
+		})
+	require.ErrorIs(t, err, repository.ErrPVZExport)
+}
+
+func TestPVZUnitStream(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+	connection.EXPECT().
+		EachContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	each := func(domain.PVZReceptionsProducts) error { return nil }
+
+	err := repository.NewPVZ().Stream(t.Context(), connection, domain.PVZFilter{}, each)
+	require.ErrorIs(t, err, repository.ErrPVZStream)
+	require.ErrorContains(t, err, "some error")
+
+	err = repository.NewPVZ().Stream(t.Context(), connection, domain.PVZFilter{Sort: "city"}, each)
+	require.ErrorIs(t, err, repository.ErrPVZStream)
+}
+
+func TestPVZSearchErrors(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		filter      domain.PVZFilter
+		page, limit int
+		after       *domain.Cursor
+	}{
+		{
+			name:   "Invalid period",
+			filter: domain.PVZFilter{Period: domain.Period{From: &now, To: &before}},
+			page:   1,
+			limit:  10,
+		},
+		{
+			name:   "Invalid local period",
+			filter: domain.PVZFilter{Period: domain.Period{LocalFrom: &now, LocalTo: &before}},
+			page:   1,
+			limit:  10,
+		},
+		{name: "Invalid sort", filter: domain.PVZFilter{Sort: "city"}, page: 1, limit: 10},
+		{
+			name:   "Cursor with sort by product count",
+			filter: domain.PVZFilter{Sort: domain.SortByProductCount},
+			page:   1,
+			limit:  10,
+			after:  &domain.Cursor{},
+		},
+		{name: "Zero page", page: 0, limit: 10},
+		{name: "Zero limit", page: 1, limit: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := repository.NewPVZ().SearchReceptionsProducts(
+				t.Context(),
+				mocks.NewMockConnection(t),
+				test.filter,
+				test.page,
+				test.limit,
+				test.after,
+			)
+			require.ErrorIs(t, err, repository.ErrPVZSearchReceptionsProducts)
+		})
+	}
+}
+
 func fixtureCreatePVZ(
 	ctx context.Context,
-	t *testing.T,
+	t testing.TB,
 	connection domain.Connection,
 	id domain.PVZID,
 	city string,
@@ -98,6 +558,7 @@ func fixtureCreatePVZ(
 		ID:           id,
 		City:         domain.PVZCity(city),
 		RegisteredAt: time.Now(),
+		PVZSchedule:  domain.PVZSchedule{}.WithDefaults(),
 	}
 	require.NoError(t, repository.NewPVZ().Create(ctx, connection, pvz))
 
@@ -3,20 +3,43 @@ package repository
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	"avito_pvz/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 var _ domain.ReceptionsRepository = (*Reception)(nil)
 
 var (
-	errReception           = errors.New("resseptions error")
-	ErrCreateReception     = errors.Join(errReception, errors.New("create failed"))
-	ErrFindActiveReception = errors.Join(errReception, errors.New("find active failed"))
-	ErrCloseReception      = errors.Join(errReception, errors.New("close failed"))
-	ErrFindByIDsReception  = errors.Join(errReception, errors.New("find by IDs failed"))
+	errReception            = errors.New("resseptions error")
+	ErrCreateReception      = errors.Join(errReception, errors.New("create failed"))
+	ErrFindActiveReception  = errors.Join(errReception, errors.New("find active failed"))
+	ErrCloseReception       = errors.Join(errReception, errors.New("close failed"))
+	ErrFindByIDsReception   = errors.Join(errReception, errors.New("find by IDs failed"))
+	ErrFindByPVZReception   = errors.Join(errReception, errors.New("find by PVZ failed"))
+	ErrCountByPVZReception  = errors.Join(errReception, errors.New("count by PVZ failed"))
+	ErrFindDetailsReception = errors.Join(errReception, errors.New("find details failed"))
+	ErrCloseStaleReception  = errors.Join(errReception, errors.New("close stale failed"))
+	ErrReopenReception      = errors.Join(errReception, errors.New("reopen failed"))
+	ErrSaveManifest         = errors.Join(errReception, errors.New("save manifest failed"))
+	ErrFindManifest         = errors.Join(errReception, errors.New("find manifest failed"))
+	ErrSaveDiscrepancy      = errors.Join(errReception, errors.New("save discrepancy failed"))
+)
+
+const (
+	uniqueViolation = "23505"
+
+	receptionInProgressUnique = "reception_in_progress_unique"
 )
 
+const receptionColumns = `receptions.id, receptions.created_at, receptions.pvz_id, receptions.status,
+	receptions.created_by, receptions.closed_by`
+
 type Reception struct{}
 
 func NewReceptions() *Reception {
@@ -29,11 +52,14 @@ func (r *Reception) Create(
 	reception domain.Reception,
 ) error {
 	const query = `insert into receptions
-    (id, created_at, pvz_id, status)
+    (id, created_at, pvz_id, status, created_by)
 	values
-    ($1, default, $2, $3)`
+    ($1, default, $2, $3, $4)`
 
-	_, err := connection.ExecContext(ctx, query, reception.ID, reception.PVZID, domain.InProgress)
+	_, err := connection.ExecContext(ctx, query, reception.ID, reception.PVZID, domain.InProgress, reception.CreatedBy)
+	if isUniqueViolation(err, receptionInProgressUnique) {
+		return errors.Join(ErrCreateReception, domain.ErrReceptionInProgress)
+	}
 	if err != nil {
 		return errors.Join(ErrCreateReception, err)
 	}
@@ -41,27 +67,117 @@ func (r *Reception) Create(
 	return nil
 }
 
+// Close fails with ErrReceptionNotFound when the reception is no longer in
+// progress, so it is closed and counted in analytics only once.
 func (r *Reception) Close(
 	ctx context.Context,
 	connection domain.Connection,
 	receptionID domain.ReceptionID,
+	closedBy domain.UserID,
 ) error {
-	const query = `update receptions set status = $2 where id = $1`
+	const query = `update receptions set status = $2, closed_at = now(), closed_by = $3
+	where id = $1 and status = 'in_progress'`
 
-	_, err := connection.ExecContext(ctx, query, receptionID, domain.Close)
+	affected, err := connection.ExecContext(ctx, query, receptionID, domain.Close, closedBy)
 	if err != nil {
 		return errors.Join(ErrCloseReception, err)
 	}
 
+	if affected == 0 {
+		return errors.Join(ErrCloseReception, domain.ErrReceptionNotFound)
+	}
+
 	return nil
 }
 
+// CloseStale closes the receptions idle since the given time. A reception is
+// active when it was opened, reopened or got a product.
+func (r *Reception) CloseStale(
+	ctx context.Context,
+	connection domain.Connection,
+	idleSince time.Time,
+) ([]domain.Reception, error) {
+	const query = `update receptions set status = 'close', closed_at = now()
+	where id in (
+		select receptions.id from receptions
+		where receptions.status = 'in_progress'
+			and greatest(
+				coalesce(
+					(select max(products.created_at) from products where products.reception_id = receptions.id),
+					receptions.created_at
+				),
+				(select max(reception_reopenings.reopened_at) from reception_reopenings
+				where reception_reopenings.reception_id = receptions.id)
+			) < $1
+		for update skip locked
+	)
+	returning ` + receptionColumns
+
+	var receptions []domain.Reception
+	err := connection.SelectContext(ctx, &receptions, query, idleSince)
+	if err != nil {
+		return nil, errors.Join(ErrCloseStaleReception, err)
+	}
+
+	return receptions, nil
+}
+
+// Reopen reads the close it undoes before clearing it, the audit row keeps it.
+// Nothing is updated when the reception is not closed or its PVZ has a newer
+// reception.
+func (r *Reception) Reopen(
+	ctx context.Context,
+	connection domain.Connection,
+	reopening domain.ReceptionReopening,
+) error {
+	const query = `with previous as (
+		select receptions.id, receptions.closed_at, receptions.closed_by from receptions
+		where receptions.id = $2 and receptions.status = 'close'
+			and not exists (
+				select 1 from receptions newer
+				where newer.pvz_id = receptions.pvz_id and newer.created_at > receptions.created_at
+			)
+		for update
+	), reopened as (
+		update receptions set status = 'in_progress', closed_at = null, closed_by = null
+		from previous where receptions.id = previous.id
+		returning receptions.id, previous.closed_at, previous.closed_by
+	)
+	insert into reception_reopenings (id, reception_id, reopened_by, reason, closed_at, closed_by)
+	select $1, reopened.id, $3, $4, reopened.closed_at, reopened.closed_by from reopened`
+
+	affected, err := connection.ExecContext(
+		ctx,
+		query,
+		reopening.ID,
+		reopening.ReceptionID,
+		reopening.ReopenedBy,
+		reopening.Reason,
+	)
+	if isUniqueViolation(err, receptionInProgressUnique) {
+		return errors.Join(ErrReopenReception, domain.ErrReceptionInProgress)
+	}
+	if err != nil {
+		return errors.Join(ErrReopenReception, err)
+	}
+
+	if affected == 0 {
+		return errors.Join(ErrReopenReception, domain.ErrReceptionNotReopenable)
+	}
+
+	return nil
+}
+
+// FindActive locks the reception until the end of the transaction, so that a
+// concurrent close waits for it and then finds nothing.
 func (r *Reception) FindActive(
 	ctx context.Context,
 	connection domain.Connection,
 	pvzID domain.PVZID,
 ) (domain.Reception, error) {
-	const query = `select id, created_at, pvz_id, status from receptions where pvz_id = $1 and status = 'in_progress'`
+	const query = `select ` + receptionColumns + ` from receptions
+	where pvz_id = $1 and status = 'in_progress'
+	for update`
 
 	var reception domain.Reception
 	err := connection.GetContext(ctx, &reception, query, pvzID)
@@ -77,7 +193,7 @@ func (r *Reception) FindByIDs(
 	connection domain.Connection,
 	receptionIDs []domain.ReceptionID,
 ) ([]domain.Reception, error) {
-	const query = `select id, created_at, pvz_id, status from receptions where id = any($1)`
+	const query = `select ` + receptionColumns + ` from receptions where id = any($1)`
 
 	var receptions []domain.Reception
 	err := connection.SelectContext(ctx, &receptions, query, receptionIDs)
@@ -87,3 +203,246 @@ func (r *Reception) FindByIDs(
 
 	return receptions, nil
 }
+
+// FindByPVZ returns a page of the receptions of the PVZ matching the filter,
+// newest first, counting the products of every reception.
+func (r *Reception) FindByPVZ(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+	filter domain.ReceptionFilter,
+	page, limit int,
+) ([]domain.ReceptionSummary, error) {
+	if page < 1 || limit < 1 {
+		return nil, errors.Join(ErrFindByPVZReception, errors.New("invalid page"))
+	}
+	if err := validatePeriod(filter.Period); err != nil {
+		return nil, errors.Join(ErrFindByPVZReception, err)
+	}
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	query := `select ` + receptionColumns + `,
+		(select count(*) from products where products.reception_id = receptions.id) as products
+	from receptions join pvz on pvz.id = receptions.pvz_id` +
+		receptionHistoryWhere(pvzID, filter, arg) + `
+	order by receptions.created_at desc, receptions.id desc
+	limit ` + arg(limit) + ` offset ` + arg((page-1)*limit)
+
+	var receptions []domain.ReceptionSummary
+	err := connection.SelectContext(ctx, &receptions, query, args...)
+	if err != nil {
+		return nil, errors.Join(ErrFindByPVZReception, err)
+	}
+
+	return receptions, nil
+}
+
+// CountByPVZ returns the number of receptions of the PVZ matching the filter.
+func (r *Reception) CountByPVZ(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+	filter domain.ReceptionFilter,
+) (int, error) {
+	if err := validatePeriod(filter.Period); err != nil {
+		return 0, errors.Join(ErrCountByPVZReception, err)
+	}
+
+	var args []any
+	where := receptionHistoryWhere(pvzID, filter, func(v any) string {
+		args = append(args, v)
+
+		return "$" + strconv.Itoa(len(args))
+	})
+
+	var row struct {
+		Total int
+	}
+	err := connection.GetContext(
+		ctx,
+		&row,
+		`select count(*) as total from receptions join pvz on pvz.id = receptions.pvz_id`+where,
+		args...,
+	)
+	if err != nil {
+		return 0, errors.Join(ErrCountByPVZReception, err)
+	}
+
+	return row.Total, nil
+}
+
+// FindDetails returns the reception with its PVZ, all its products in the
+// order they were added and the products deleted from it, oldest deletion
+// first.
+func (r *Reception) FindDetails(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+) (domain.ReceptionDetails, error) {
+	const query = `select receptions.id as "reception.id", receptions.pvz_id as "reception.pvz_id",
+		receptions.status as "reception.status", receptions.created_at as "reception.created_at",
+		receptions.created_by as "reception.created_by", receptions.closed_by as "reception.closed_by",
+		receptions.closed_at,
+		pvz.id as "pvz.id", pvz.city as "pvz.city", pvz.registered_at as "pvz.registered_at",
+		pvz.time_zone as "pvz.time_zone",
+		to_char(pvz.opens_at, 'HH24:MI') as "pvz.opens_at", to_char(pvz.closes_at, 'HH24:MI') as "pvz.closes_at",
+		coalesce((select json_agg(json_build_object(
+			'ID', products.id,
+			'ReceptionID', products.reception_id,
+			'Type', products.type,
+			'CreatedAt', products.created_at,
+			'CreatedBy', products.created_by,
+			'Barcode', products.barcode,
+			` + productAttributesJSON + `
+		) order by products.created_at, products.id)
+		from products where products.reception_id = receptions.id), '[]') as products,
+		coalesce((select json_agg(json_build_object(
+			'ID', deleted_products.id,
+			'ReceptionID', deleted_products.reception_id,
+			'Type', deleted_products.type,
+			'CreatedAt', deleted_products.created_at,
+			'CreatedBy', deleted_products.created_by,
+			'Barcode', deleted_products.barcode,
+			` + deletedProductAttributesJSON + `,
+			'DeletedAt', deleted_products.deleted_at,
+			'DeletedBy', deleted_products.deleted_by
+		) order by deleted_products.deleted_at, deleted_products.id)
+		from deleted_products where deleted_products.reception_id = receptions.id), '[]') as deleted_products,
+		coalesce((select json_agg(json_build_object(
+			'ID', reception_reopenings.id,
+			'ReceptionID', reception_reopenings.reception_id,
+			'ReopenedAt', reception_reopenings.reopened_at,
+			'ReopenedBy', reception_reopenings.reopened_by,
+			'Reason', reception_reopenings.reason,
+			'ClosedAt', reception_reopenings.closed_at,
+			'ClosedBy', reception_reopenings.closed_by
+		) order by reception_reopenings.reopened_at, reception_reopenings.id)
+		from reception_reopenings where reception_reopenings.reception_id = receptions.id), '[]') as reopenings
+	from receptions join pvz on pvz.id = receptions.pvz_id
+	where receptions.id = $1`
+
+	var row struct {
+		Reception       domain.Reception
+		PVZ             domain.PVZ
+		ClosedAt        *time.Time
+		Products        []domain.Product
+		DeletedProducts []domain.DeletedProduct
+		Reopenings      []domain.ReceptionReopening
+	}
+	err := connection.GetContext(ctx, &row, query, receptionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ReceptionDetails{}, errors.Join(ErrFindDetailsReception, domain.ErrReceptionNotFound)
+	}
+	if err != nil {
+		return domain.ReceptionDetails{}, errors.Join(ErrFindDetailsReception, err)
+	}
+
+	return domain.ReceptionDetails{
+		Reception: row.Reception,
+		PVZ:       row.PVZ,
+		Timeline: domain.ReceptionTimeline{
+			OpenedAt:   row.Reception.CreatedAt,
+			OpenedBy:   row.Reception.CreatedBy,
+			ClosedAt:   row.ClosedAt,
+			ClosedBy:   row.Reception.ClosedBy,
+			Reopenings: row.Reopenings,
+		},
+		Products:        row.Products,
+		DeletedProducts: row.DeletedProducts,
+	}, nil
+}
+
+func (r *Reception) SaveManifest(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+	manifest domain.ReceptionManifest,
+) error {
+	const query = `insert into reception_manifests (reception_id, type, count) values ($1, $2, $3)`
+
+	for _, item := range manifest {
+		_, err := connection.ExecContext(ctx, query, receptionID, item.Type, item.Count)
+		if err != nil {
+			return errors.Join(ErrSaveManifest, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reception) FindManifest(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+) (domain.ReceptionManifest, error) {
+	const query = `select type, count from reception_manifests where reception_id = $1 order by type`
+
+	var manifest domain.ReceptionManifest
+	err := connection.SelectContext(ctx, &manifest, query, receptionID)
+	if err != nil {
+		return nil, errors.Join(ErrFindManifest, err)
+	}
+
+	return manifest, nil
+}
+
+// SaveDiscrepancy keeps a row per missing or extra product type, a report of
+// a reception closed again after a reopening replaces the previous one.
+func (r *Reception) SaveDiscrepancy(
+	ctx context.Context,
+	connection domain.Connection,
+	receptionID domain.ReceptionID,
+	report domain.DiscrepancyReport,
+) error {
+	const deleteQuery = `delete from reception_discrepancies where reception_id = $1`
+	const insertQuery = `insert into reception_discrepancies (reception_id, type, missing, extra)
+	values ($1, $2, $3, $4)`
+
+	if _, err := connection.ExecContext(ctx, deleteQuery, receptionID); err != nil {
+		return errors.Join(ErrSaveDiscrepancy, err)
+	}
+
+	for _, item := range report.Missing {
+		if _, err := connection.ExecContext(ctx, insertQuery, receptionID, item.Type, item.Count, 0); err != nil {
+			return errors.Join(ErrSaveDiscrepancy, err)
+		}
+	}
+	for _, item := range report.Extra {
+		if _, err := connection.ExecContext(ctx, insertQuery, receptionID, item.Type, 0, item.Count); err != nil {
+			return errors.Join(ErrSaveDiscrepancy, err)
+		}
+	}
+
+	return nil
+}
+
+func receptionHistoryWhere(pvzID domain.PVZID, filter domain.ReceptionFilter, arg func(any) string) string {
+	conditions := []string{"receptions.pvz_id = " + arg(pvzID)}
+	if filter.Status != nil {
+		conditions = append(conditions, "receptions.status = "+arg(*filter.Status))
+	}
+	if filter.CreatedBy != nil {
+		conditions = append(conditions, "receptions.created_by = "+arg(*filter.CreatedBy))
+	}
+	if filter.ClosedBy != nil {
+		conditions = append(conditions, "receptions.closed_by = "+arg(*filter.ClosedBy))
+	}
+	conditions = append(
+		conditions,
+		periodConditions("receptions.created_at", "pvz.time_zone", filter.Period, arg)...,
+	)
+
+	return " where " + strings.Join(conditions, " and ")
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
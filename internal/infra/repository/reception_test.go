@@ -4,14 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
 	"avito_pvz/internal/infra/repository"
 )
 
@@ -32,15 +37,18 @@ func TestReceptionIntegration(t *testing.T) {
 		require.Equal(t, reception.PVZID, receptionFound.PVZID)
 		require.Equal(t, domain.InProgress, receptionFound.Status)
 
-		_ = repoReception.Close(ctx, connection, receptionID)
+		_ = repoReception.Close(ctx, connection, receptionID, uuid.New())
 		_, err = repoReception.FindActive(ctx, connection, pvzID)
 		errors.Is(err, sql.ErrNoRows)
 
+		err = repoReception.Close(ctx, connection, receptionID, uuid.New())
+		require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+
 		receptionID2 := uuid.New()
 		receptionID3 := uuid.New()
 
 		reception2 := fixtureCreateReceptin(ctx, t, connection, receptionID2, pvzID)
-		_ = repoReception.Close(ctx, connection, receptionID2)
+		_ = repoReception.Close(ctx, connection, receptionID2, uuid.New())
 
 		_ = fixtureCreateReceptin(ctx, t, connection, receptionID3, pvzID)
 		receptionIDs := []domain.ReceptionID{receptionID2, receptionID3}
@@ -55,6 +63,383 @@ func TestReceptionIntegration(t *testing.T) {
 	})
 }
 
+func TestReceptionHistoryIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoReception := repository.NewReceptions()
+
+		pvzID, otherPVZID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, otherPVZID, "Казань")
+		_ = fixtureCreateReceptin(ctx, t, connection, uuid.New(), otherPVZID)
+
+		closedID, activeID := uuid.New(), uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, closedID, pvzID)
+		for range 2 {
+			_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), closedID, "обувь", time.Now())
+		}
+		closedBy := uuid.New()
+		require.NoError(t, repoReception.Close(ctx, connection, closedID, closedBy))
+		_, err := connection.ExecContext(
+			ctx,
+			"update receptions set created_at = created_at - interval '1 hour' where id = $1",
+			closedID,
+		)
+		require.NoError(t, err)
+		_ = fixtureCreateReceptin(ctx, t, connection, activeID, pvzID)
+
+		receptions, err := repoReception.FindByPVZ(ctx, connection, pvzID, domain.ReceptionFilter{}, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, receptions, 2)
+		require.Equal(t, activeID, receptions[0].ID)
+		require.Zero(t, receptions[0].Products)
+		require.Equal(t, closedID, receptions[1].ID)
+		require.Equal(t, 2, receptions[1].Products)
+
+		receptions, err = repoReception.FindByPVZ(ctx, connection, pvzID, domain.ReceptionFilter{}, 2, 1)
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		require.Equal(t, closedID, receptions[0].ID)
+
+		closed := domain.ReceptionFilter{Status: pointer.Ref(domain.Close)}
+		receptions, err = repoReception.FindByPVZ(ctx, connection, pvzID, closed, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		require.Equal(t, closedID, receptions[0].ID)
+
+		receptions, err = repoReception.FindByPVZ(
+			ctx,
+			connection,
+			pvzID,
+			domain.ReceptionFilter{ClosedBy: &closedBy},
+			1,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, receptions, 1)
+		require.Equal(t, closedID, receptions[0].ID)
+		require.Equal(t, &closedBy, receptions[0].ClosedBy)
+
+		from := time.Now().Add(-30 * time.Minute)
+		recent := domain.ReceptionFilter{Period: domain.Period{From: &from}}
+		total, err := repoReception.CountByPVZ(ctx, connection, pvzID, recent)
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+
+		total, err = repoReception.CountByPVZ(ctx, connection, pvzID, domain.ReceptionFilter{})
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+	})
+}
+
+func TestReceptionDetailsIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoReception := repository.NewReceptions()
+
+		pvzID, receptionID := uuid.New(), uuid.New()
+		openedBy, closedBy := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		require.NoError(t, repoReception.Create(ctx, connection, domain.Reception{
+			ID:        receptionID,
+			PVZID:     pvzID,
+			CreatedBy: &openedBy,
+		}))
+
+		details, err := repoReception.FindDetails(ctx, connection, receptionID)
+		require.NoError(t, err)
+		require.Equal(t, receptionID, details.Reception.ID)
+		require.Equal(t, pvzID, details.PVZ.ID)
+		require.Equal(t, domain.Msk, details.PVZ.City)
+		require.Equal(t, details.Reception.CreatedAt, details.Timeline.OpenedAt)
+		require.Equal(t, &openedBy, details.Timeline.OpenedBy)
+		require.Nil(t, details.Timeline.ClosedAt)
+		require.Nil(t, details.Timeline.ClosedBy)
+		require.Empty(t, details.Products)
+		require.Empty(t, details.DeletedProducts)
+
+		added := time.Now()
+		second := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, "обувь", added.Add(time.Second))
+		first := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, "одежда", added)
+		require.NoError(t, repository.NewProduct().Create(ctx, connection, domain.Product{
+			ID:          uuid.New(),
+			ReceptionID: receptionID,
+			Type:        domain.Electronics,
+			CreatedAt:   added.Add(2 * time.Second),
+			CreatedBy:   &openedBy,
+		}))
+		deleted, err := repository.NewProduct().DeleteLast(ctx, connection, receptionID, closedBy)
+		require.NoError(t, err)
+		require.NoError(t, repoReception.Close(ctx, connection, receptionID, closedBy))
+
+		details, err = repoReception.FindDetails(ctx, connection, receptionID)
+		require.NoError(t, err)
+		require.Equal(t, domain.Close, details.Reception.Status)
+		require.NotNil(t, details.Timeline.ClosedAt)
+		require.Equal(t, &closedBy, details.Timeline.ClosedBy)
+		require.Len(t, details.Products, 2)
+		require.Equal(t, first.ID, details.Products[0].ID)
+		require.Equal(t, second.ID, details.Products[1].ID)
+		require.Len(t, details.DeletedProducts, 1)
+		require.Equal(t, deleted.ID, details.DeletedProducts[0].ID)
+		require.Equal(t, &openedBy, details.DeletedProducts[0].CreatedBy)
+		require.Equal(t, &closedBy, details.DeletedProducts[0].DeletedBy)
+
+		_, err = repoReception.FindDetails(ctx, connection, uuid.New())
+		require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+	})
+}
+
+func TestReceptionCloseStaleIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoReception := repository.NewReceptions()
+
+		idlePVZID, busyPVZID, emptyPVZID := uuid.New(), uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, idlePVZID, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, busyPVZID, "Москва")
+		_ = fixtureCreatePVZ(ctx, t, connection, emptyPVZID, "Казань")
+
+		idleID, busyID, emptyID := uuid.New(), uuid.New(), uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, idleID, idlePVZID)
+		_ = fixtureCreateReceptin(ctx, t, connection, busyID, busyPVZID)
+		_ = fixtureCreateReceptin(ctx, t, connection, emptyID, emptyPVZID)
+		_, err := connection.ExecContext(
+			ctx,
+			"update receptions set created_at = created_at - interval '1 day' where id = any($1)",
+			[]domain.ReceptionID{idleID, busyID},
+		)
+		require.NoError(t, err)
+
+		now := time.Now()
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), idleID, "обувь", now.Add(-3*time.Hour))
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), busyID, "обувь", now.Add(-3*time.Hour))
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), busyID, "обувь", now.Add(-time.Minute))
+
+		closed, err := repoReception.CloseStale(ctx, connection, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, closed, 1)
+		require.Equal(t, idleID, closed[0].ID)
+		require.Equal(t, domain.Close, closed[0].Status)
+		require.Nil(t, closed[0].ClosedBy)
+
+		_, err = repoReception.FindActive(ctx, connection, idlePVZID)
+		require.Error(t, err)
+		_, err = repoReception.FindActive(ctx, connection, busyPVZID)
+		require.NoError(t, err)
+		_, err = repoReception.FindActive(ctx, connection, emptyPVZID)
+		require.NoError(t, err)
+
+		closed, err = repoReception.CloseStale(ctx, connection, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, closed)
+	})
+}
+
+func TestReceptionCloseStaleReopenedIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoReception := repository.NewReceptions()
+
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+		_, err := connection.ExecContext(
+			ctx,
+			"update receptions set created_at = created_at - interval '1 day' where id = $1",
+			receptionID,
+		)
+		require.NoError(t, err)
+
+		now := time.Now()
+		_ = fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, "обувь", now.Add(-3*time.Hour))
+
+		require.NoError(t, repoReception.Close(ctx, connection, receptionID, uuid.New()))
+		require.NoError(t, repoReception.Reopen(ctx, connection, domain.ReceptionReopening{
+			ID:          uuid.New(),
+			ReceptionID: receptionID,
+			ReopenedBy:  uuid.New(),
+			Reason:      "закрыли по ошибке",
+		}))
+
+		closed, err := repoReception.CloseStale(ctx, connection, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, closed)
+
+		_, err = repoReception.FindActive(ctx, connection, pvzID)
+		require.NoError(t, err)
+	})
+}
+
+func TestReceptionReopenIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoReception := repository.NewReceptions()
+
+		pvzID := uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+
+		olderID, newerID := uuid.New(), uuid.New()
+		closedBy, moderatorID := uuid.New(), uuid.New()
+		_ = fixtureCreateReceptin(ctx, t, connection, olderID, pvzID)
+
+		reopen := func(receptionID domain.ReceptionID) error {
+			return repoReception.Reopen(ctx, connection, domain.ReceptionReopening{
+				ID:          uuid.New(),
+				ReceptionID: receptionID,
+				ReopenedBy:  moderatorID,
+				Reason:      "закрыли по ошибке",
+			})
+		}
+
+		require.ErrorIs(t, reopen(olderID), domain.ErrReceptionNotReopenable)
+
+		require.NoError(t, repoReception.Close(ctx, connection, olderID, closedBy))
+		require.NoError(t, reopen(olderID))
+
+		details, err := repoReception.FindDetails(ctx, connection, olderID)
+		require.NoError(t, err)
+		require.Equal(t, domain.InProgress, details.Reception.Status)
+		require.Nil(t, details.Reception.ClosedBy)
+		require.Nil(t, details.Timeline.ClosedAt)
+		require.Len(t, details.Timeline.Reopenings, 1)
+		require.Equal(t, moderatorID, details.Timeline.Reopenings[0].ReopenedBy)
+		require.Equal(t, "закрыли по ошибке", details.Timeline.Reopenings[0].Reason)
+		require.Equal(t, &closedBy, details.Timeline.Reopenings[0].ClosedBy)
+		require.NotNil(t, details.Timeline.Reopenings[0].ClosedAt)
+
+		require.NoError(t, repoReception.Close(ctx, connection, olderID, closedBy))
+		_ = fixtureCreateReceptin(ctx, t, connection, newerID, pvzID)
+		require.NoError(t, repoReception.Close(ctx, connection, newerID, closedBy))
+
+		require.ErrorIs(t, reopen(olderID), domain.ErrReceptionNotReopenable)
+		require.NoError(t, reopen(newerID))
+	})
+}
+
+func TestReceptionManifestIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		repoReception := repository.NewReceptions()
+
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+
+		manifest, err := repoReception.FindManifest(ctx, connection, receptionID)
+		require.NoError(t, err)
+		require.Empty(t, manifest)
+
+		expected := domain.ReceptionManifest{{Type: domain.Shoes, Count: 1}, {Type: domain.Electronics, Count: 2}}
+		require.NoError(t, repoReception.SaveManifest(ctx, connection, receptionID, expected))
+		manifest, err = repoReception.FindManifest(ctx, connection, receptionID)
+		require.NoError(t, err)
+		require.Equal(t, expected, manifest)
+
+		countDiscrepancies := func() int {
+			var count int
+			require.NoError(t, connection.GetContext(
+				ctx,
+				&count,
+				"select count(*) from reception_discrepancies where reception_id = $1",
+				receptionID,
+			))
+
+			return count
+		}
+
+		require.NoError(t, repoReception.SaveDiscrepancy(ctx, connection, receptionID, domain.DiscrepancyReport{
+			Missing: []domain.ManifestItem{{Type: domain.Electronics, Count: 1}},
+			Extra:   []domain.ManifestItem{{Type: domain.Clothes, Count: 2}},
+		}))
+		require.Equal(t, 2, countDiscrepancies())
+
+		require.NoError(t, repoReception.SaveDiscrepancy(ctx, connection, receptionID, domain.DiscrepancyReport{}))
+		require.Zero(t, countDiscrepancies())
+	})
+}
+
+// TestReceptionConcurrentCreateIntegration opens receptions of one PVZ from
+// concurrent transactions; exactly one of them must win.
+func TestReceptionConcurrentCreateIntegration(t *testing.T) {
+	const attempts = 10
+
+	provider := newProvider(t)
+
+	pvzID := uuid.New()
+	require.NoError(t, provider.ExecuteTx(t.Context(), func(ctx context.Context, connection domain.Connection) error {
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+
+		return nil
+	}))
+	t.Cleanup(func() {
+		require.NoError(t, provider.Execute(context.Background(), func(ctx context.Context, c domain.Connection) error {
+			_, err := c.ExecContext(ctx, "delete from pvz where id = $1", pvzID)
+
+			return err
+		}))
+	})
+
+	metrics := mocks.NewMockMetrics(t)
+	metrics.EXPECT().IncReceptions().Return().Once()
+
+	service := domain.NewReceptionService(
+		provider,
+		repository.NewReceptions(),
+		repository.NewProduct(),
+		repository.NewPVZ(),
+		repository.NewAnalytics(),
+		mocks.NewMockProductTypesRepository(t),
+		repository.NewOrders(),
+		repository.NewStorageCells(),
+		metrics,
+	)
+	moderator, err := domain.AuthenticateByToken(uuid.NewString() + ":" + string(domain.Moderator))
+	require.NoError(t, err)
+
+	start := make(chan struct{})
+	errs := make(chan error, attempts)
+
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			<-start
+			_, err := service.Create(t.Context(), moderator, pvzID, true, nil)
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		if err == nil {
+			created++
+
+			continue
+		}
+		require.ErrorIs(t, err, domain.ErrReceptionInProgress)
+	}
+	require.Equal(t, 1, created)
+
+	require.NoError(t, provider.Execute(t.Context(), func(ctx context.Context, c domain.Connection) error {
+		_, err := repository.NewReceptions().FindActive(ctx, c, pvzID)
+
+		return err
+	}))
+}
+
+func TestReceptionUnitCreateInProgress(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, &pgconn.PgError{Code: "23505", ConstraintName: "reception_in_progress_unique"}).
+		Once()
+
+	err := repository.NewReceptions().Create(t.Context(), connection, domain.Reception{})
+
+	require.ErrorIs(t, err, repository.ErrCreateReception)
+	require.ErrorIs(t, err, domain.ErrReceptionInProgress)
+}
+
 func TestReceptionUnitCreate(t *testing.T) {
 	connection := mocks.NewMockConnection(t)
 
@@ -76,12 +461,106 @@ func TestReceptionUnitClose(t *testing.T) {
 		Once()
 
 	var receptionID domain.ReceptionID
-	err := repository.NewReceptions().Close(t.Context(), connection, receptionID)
+	err := repository.NewReceptions().Close(t.Context(), connection, receptionID, uuid.New())
 
 	require.ErrorIs(t, err, repository.ErrCloseReception)
 	require.ErrorContains(t, err, "some error")
 }
 
+func TestReceptionUnitCloseNotInProgress(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, nil).
+		Once()
+
+	err := repository.NewReceptions().Close(t.Context(), connection, uuid.New(), uuid.New())
+
+	require.ErrorIs(t, err, repository.ErrCloseReception)
+	require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+}
+
+func TestReceptionUnitReopen(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		err      error
+		want     error
+	}{
+		{name: "Not reopenable", want: domain.ErrReceptionNotReopenable},
+		{
+			name: "In progress",
+			err:  &pgconn.PgError{Code: "23505", ConstraintName: "reception_in_progress_unique"},
+			want: domain.ErrReceptionInProgress,
+		},
+		{name: "Error", err: errors.New("some error"), want: repository.ErrReopenReception},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			connection := mocks.NewMockConnection(t)
+			connection.EXPECT().
+				ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(test.affected, test.err).
+				Once()
+
+			err := repository.NewReceptions().Reopen(t.Context(), connection, domain.ReceptionReopening{})
+
+			require.ErrorIs(t, err, repository.ErrReopenReception)
+			require.ErrorIs(t, err, test.want)
+		})
+	}
+}
+
+func TestReceptionUnitSaveManifest(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, errors.New("some error")).
+		Once()
+
+	err := repository.NewReceptions().SaveManifest(
+		t.Context(),
+		connection,
+		uuid.New(),
+		domain.ReceptionManifest{{Type: domain.Shoes, Count: 1}},
+	)
+
+	require.ErrorIs(t, err, repository.ErrSaveManifest)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestReceptionUnitFindManifest(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewReceptions().FindManifest(t.Context(), connection, uuid.New())
+
+	require.ErrorIs(t, err, repository.ErrFindManifest)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestReceptionUnitSaveDiscrepancy(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything).
+		Return(0, nil).
+		Once()
+	connection.EXPECT().ExecContext(mock.Anything, mock.Anything, mock.Anything).
+		Return(0, errors.New("some error")).
+		Once()
+
+	err := repository.NewReceptions().SaveDiscrepancy(t.Context(), connection, uuid.New(), domain.DiscrepancyReport{
+		Missing: []domain.ManifestItem{{Type: domain.Shoes, Count: 1}},
+	})
+
+	require.ErrorIs(t, err, repository.ErrSaveDiscrepancy)
+	require.ErrorContains(t, err, "some error")
+}
+
 func TestReceptionUnitFindByIDs(t *testing.T) {
 	connection := mocks.NewMockConnection(t)
 
@@ -96,9 +575,75 @@ func TestReceptionUnitFindByIDs(t *testing.T) {
 	require.ErrorContains(t, err, "some error")
 }
 
+func TestReceptionUnitFindByPVZ(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewReceptions().FindByPVZ(t.Context(), connection, uuid.New(), domain.ReceptionFilter{}, 1, 10)
+
+	require.ErrorIs(t, err, repository.ErrFindByPVZReception)
+	require.ErrorContains(t, err, "some error")
+
+	_, err = repository.NewReceptions().FindByPVZ(t.Context(), connection, uuid.New(), domain.ReceptionFilter{}, 0, 10)
+
+	require.ErrorIs(t, err, repository.ErrFindByPVZReception)
+	require.ErrorContains(t, err, "invalid page")
+}
+
+func TestReceptionUnitCountByPVZ(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewReceptions().CountByPVZ(t.Context(), connection, uuid.New(), domain.ReceptionFilter{})
+
+	require.ErrorIs(t, err, repository.ErrCountByPVZReception)
+	require.ErrorContains(t, err, "some error")
+}
+
+func TestReceptionUnitFindDetails(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewReceptions().FindDetails(t.Context(), connection, uuid.New())
+
+	require.ErrorIs(t, err, repository.ErrFindDetailsReception)
+	require.ErrorContains(t, err, "some error")
+
+	connection.EXPECT().GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows).
+		Once()
+
+	_, err = repository.NewReceptions().FindDetails(t.Context(), connection, uuid.New())
+
+	require.ErrorIs(t, err, repository.ErrFindDetailsReception)
+	require.ErrorIs(t, err, domain.ErrReceptionNotFound)
+}
+
+func TestReceptionUnitCloseStale(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().SelectContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+
+	_, err := repository.NewReceptions().CloseStale(t.Context(), connection, time.Now())
+
+	require.ErrorIs(t, err, repository.ErrCloseStaleReception)
+	require.ErrorContains(t, err, "some error")
+}
+
 func fixtureCreateReceptin(
 	ctx context.Context,
-	t *testing.T,
+	t testing.TB,
 	connection domain.Connection,
 	id domain.ReceptionID,
 	pvzID domain.PVZID,
@@ -13,15 +13,19 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func rollback(t *testing.T, receiver func(context.Context, domain.Connection)) {
+func newProvider(t testing.TB) *database.PostgresProvider {
 	require.NoError(t, godotenv.Load("../../../.env"))
 
 	pool, err := pgxpool.New(t.Context(), os.Getenv("DB_CONNECTION"))
 	require.NoError(t, err)
 
-	provider := database.NewPostgresRollbackProvider(database.NewPostgresProvider(pool))
+	return database.NewPostgresProvider(pool)
+}
+
+func rollback(t testing.TB, receiver func(context.Context, domain.Connection)) {
+	provider := database.NewPostgresRollbackProvider(newProvider(t))
 
-	clearTable := func(t *testing.T, connection domain.Connection, table string) {
+	clearTable := func(t testing.TB, connection domain.Connection, table string) {
 		_, errTruncate := connection.ExecContext(t.Context(), "delete from "+table+" cascade")
 		require.NoError(t, errTruncate)
 	}
@@ -31,6 +35,8 @@ func rollback(t *testing.T, receiver func(context.Context, domain.Connection)) {
 		provider.ExecuteTx(
 			t.Context(),
 			func(ctx context.Context, connection domain.Connection) error {
+				clearTable(t, connection, "product_daily_stats")
+				clearTable(t, connection, "reception_daily_stats")
 				clearTable(t, connection, "products")
 				clearTable(t, connection, "receptions")
 				clearTable(t, connection, "pvz")
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"avito_pvz/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ domain.StorageCellsRepository = (*StorageCells)(nil)
+
+var (
+	errStorageCells           = errors.New("storage cells repository error")
+	ErrStorageCellsFindLayout = errors.Join(errStorageCells, errors.New("find layout failed"))
+	ErrStorageCellsSaveLayout = errors.Join(errStorageCells, errors.New("save layout failed"))
+	ErrStorageCellsAllocate   = errors.Join(errStorageCells, errors.New("allocate failed"))
+	ErrStorageCellsFree       = errors.Join(errStorageCells, errors.New("free failed"))
+)
+
+const (
+	storageCellColumns          = `id, pvz_id, code, type, position, product_id`
+	storageCellColumnsQualified = `storage_cells.id, storage_cells.pvz_id, storage_cells.code,
+	storage_cells.type, storage_cells.position, storage_cells.product_id`
+)
+
+type StorageCells struct{}
+
+func NewStorageCells() *StorageCells {
+	return &StorageCells{}
+}
+
+func (r *StorageCells) FindLayout(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+) (domain.StorageLayout, error) {
+	const allocationQuery = `select coalesce(
+		(select allocation from storage_layouts where pvz_id = $1), $2
+	)`
+	const cellsQuery = `select ` + storageCellColumns + ` from storage_cells
+	where pvz_id = $1 order by position`
+
+	layout := domain.StorageLayout{PVZID: pvzID}
+	err := connection.GetContext(ctx, &layout.Allocation, allocationQuery, pvzID, domain.CellAllocationFillFirst)
+	if err != nil {
+		return layout, errors.Join(ErrStorageCellsFindLayout, err)
+	}
+
+	err = connection.SelectContext(ctx, &layout.Cells, cellsQuery, pvzID)
+	if err != nil {
+		return layout, errors.Join(ErrStorageCellsFindLayout, err)
+	}
+
+	return layout, nil
+}
+
+// SaveLayout removes the free cells missing from the layout first, a removed
+// cell left behind holds a product. The kept cells keep their IDs.
+func (r *StorageCells) SaveLayout(
+	ctx context.Context,
+	connection domain.Connection,
+	layout domain.StorageLayout,
+) error {
+	const layoutQuery = `insert into storage_layouts (pvz_id, allocation) values ($1, $2)
+	on conflict (pvz_id) do update set allocation = excluded.allocation`
+	const deleteQuery = `delete from storage_cells
+	where pvz_id = $1 and code <> all($2) and product_id is null`
+	const occupiedQuery = `select exists(
+		select 1 from storage_cells where pvz_id = $1 and code <> all($2)
+	)`
+	const cellQuery = `insert into storage_cells (id, pvz_id, code, type, position)
+	values ($1, $2, $3, $4, $5)
+	on conflict (pvz_id, code) do update set type = excluded.type, position = excluded.position`
+
+	_, err := connection.ExecContext(ctx, layoutQuery, layout.PVZID, layout.Allocation)
+	if err != nil {
+		return errors.Join(ErrStorageCellsSaveLayout, err)
+	}
+
+	codes := make([]string, 0, len(layout.Cells))
+	args := make([][]any, 0, len(layout.Cells))
+	for _, cell := range layout.Cells {
+		codes = append(codes, cell.Code)
+		args = append(args, []any{cell.ID, layout.PVZID, cell.Code, cell.Type, cell.Position})
+	}
+
+	_, err = connection.ExecContext(ctx, deleteQuery, layout.PVZID, codes)
+	if err != nil {
+		return errors.Join(ErrStorageCellsSaveLayout, err)
+	}
+
+	var occupied bool
+	err = connection.GetContext(ctx, &occupied, occupiedQuery, layout.PVZID, codes)
+	if err != nil {
+		return errors.Join(ErrStorageCellsSaveLayout, err)
+	}
+	if occupied {
+		return errors.Join(ErrStorageCellsSaveLayout, domain.ErrStorageCellOccupied)
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+	err = connection.ExecBatch(ctx, cellQuery, args)
+	if err != nil {
+		return errors.Join(ErrStorageCellsSaveLayout, err)
+	}
+
+	return nil
+}
+
+// Allocate takes the first free cell in the order of the PVZ allocation:
+// by position for fill-first; cells of the product type before cells without
+// a type, each by position, for type-grouped. Cells locked by concurrent
+// allocations are skipped instead of waited for.
+func (r *StorageCells) Allocate(
+	ctx context.Context,
+	connection domain.Connection,
+	pvzID domain.PVZID,
+	product domain.Product,
+) (*domain.StorageCell, error) {
+	const query = `with picked as (
+		select storage_cells.id from storage_cells
+		join storage_layouts on storage_layouts.pvz_id = storage_cells.pvz_id
+		where storage_cells.pvz_id = $1 and storage_cells.product_id is null
+		and (storage_layouts.allocation = 'fill_first'
+		or storage_cells.type is null or storage_cells.type = $3)
+		order by storage_layouts.allocation = 'type_grouped' and storage_cells.type is null,
+		storage_cells.position
+		limit 1
+		for update of storage_cells skip locked
+	)
+	update storage_cells set product_id = $2 from picked
+	where storage_cells.id = picked.id
+	returning ` + storageCellColumnsQualified
+
+	var cell domain.StorageCell
+	err := connection.GetContext(ctx, &cell, query, pvzID, product.ID, product.Type)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil //nolint:nilnil // a PVZ without free cells still accepts products.
+	}
+	if err != nil {
+		return nil, errors.Join(ErrStorageCellsAllocate, err)
+	}
+
+	return &cell, nil
+}
+
+func (r *StorageCells) Free(
+	ctx context.Context,
+	connection domain.Connection,
+	productIDs []domain.ProductID,
+) error {
+	const query = `update storage_cells set product_id = null where product_id = any($1)`
+
+	_, err := connection.ExecContext(ctx, query, productIDs)
+	if err != nil {
+		return errors.Join(ErrStorageCellsFree, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"avito_pvz/internal/domain"
+	"avito_pvz/internal/generated/mocks"
+	"avito_pvz/internal/infra/pointer"
+	"avito_pvz/internal/infra/repository"
+)
+
+func TestStorageCellsIntegration(t *testing.T) {
+	rollback(t, func(ctx context.Context, connection domain.Connection) {
+		pvzID, receptionID := uuid.New(), uuid.New()
+		_ = fixtureCreatePVZ(ctx, t, connection, pvzID, "Москва")
+		_ = fixtureCreateReceptin(ctx, t, connection, receptionID, pvzID)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		shoes := fixtureCreateProduct(ctx, t, connection, uuid.New(), receptionID, domain.Shoes, now)
+		clothes := fixtureCreateProduct(
+			ctx,
+			t,
+			connection,
+			uuid.New(),
+			receptionID,
+			domain.Clothes,
+			now.Add(time.Microsecond),
+		)
+
+		cells := repository.NewStorageCells()
+
+		layout, err := cells.FindLayout(ctx, connection, pvzID)
+		require.NoError(t, err)
+		require.Equal(t, domain.CellAllocationFillFirst, layout.Allocation)
+		require.Empty(t, layout.Cells)
+
+		cell, err := cells.Allocate(ctx, connection, pvzID, shoes)
+		require.NoError(t, err)
+		require.Nil(t, cell)
+
+		layout = domain.StorageLayout{
+			PVZID:      pvzID,
+			Allocation: domain.CellAllocationTypeGrouped,
+			Cells: []domain.StorageCell{
+				{ID: uuid.New(), Code: "A-1", Position: 1},
+				{ID: uuid.New(), Code: "A-2", Type: pointer.Ref(domain.Shoes), Position: 2},
+			},
+		}
+		require.NoError(t, cells.SaveLayout(ctx, connection, layout))
+
+		cell, err = cells.Allocate(ctx, connection, pvzID, shoes)
+		require.NoError(t, err)
+		require.NotNil(t, cell)
+		require.Equal(t, "A-2", cell.Code)
+		require.Equal(t, shoes.ID, *cell.ProductID)
+
+		cell, err = cells.Allocate(ctx, connection, pvzID, clothes)
+		require.NoError(t, err)
+		require.NotNil(t, cell)
+		require.Equal(t, "A-1", cell.Code)
+
+		layout.Cells = layout.Cells[:1]
+		err = cells.SaveLayout(ctx, connection, layout)
+		require.ErrorIs(t, err, domain.ErrStorageCellOccupied)
+
+		require.NoError(t, cells.Free(ctx, connection, []domain.ProductID{shoes.ID}))
+		require.NoError(t, cells.SaveLayout(ctx, connection, layout))
+
+		found, err := cells.FindLayout(ctx, connection, pvzID)
+		require.NoError(t, err)
+		require.Equal(t, domain.CellAllocationTypeGrouped, found.Allocation)
+		require.Len(t, found.Cells, 1)
+		require.Equal(t, layout.Cells[0].ID, found.Cells[0].ID)
+		require.Equal(t, clothes.ID, *found.Cells[0].ProductID)
+	})
+}
+
+func TestStorageCellsUnitSaveLayoutOccupied(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(0, nil).
+		Twice()
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, dest any, _ string, _ ...any) error {
+			occupied, ok := dest.(*bool)
+			require.True(t, ok)
+			*occupied = true
+
+			return nil
+		}).
+		Once()
+
+	err := repository.NewStorageCells().SaveLayout(t.Context(), connection, domain.StorageLayout{PVZID: uuid.New()})
+	require.ErrorIs(t, err, repository.ErrStorageCellsSaveLayout)
+	require.ErrorIs(t, err, domain.ErrStorageCellOccupied)
+}
+
+func TestStorageCellsUnitErrors(t *testing.T) {
+	connection := mocks.NewMockConnection(t)
+
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(pgx.ErrNoRows).
+		Once()
+	connection.EXPECT().
+		GetContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("some error")).
+		Once()
+	connection.EXPECT().
+		ExecContext(mock.Anything, mock.Anything, mock.Anything).
+		Return(0, errors.New("some error")).
+		Once()
+
+	_, err := repository.NewStorageCells().FindLayout(t.Context(), connection, uuid.New())
+	require.ErrorIs(t, err, repository.ErrStorageCellsFindLayout)
+
+	product := domain.Product{ID: uuid.New(), Type: domain.Shoes}
+
+	cell, err := repository.NewStorageCells().Allocate(t.Context(), connection, uuid.New(), product)
+	require.NoError(t, err)
+	require.Nil(t, cell)
+
+	_, err = repository.NewStorageCells().Allocate(t.Context(), connection, uuid.New(), product)
+	require.ErrorIs(t, err, repository.ErrStorageCellsAllocate)
+
+	err = repository.NewStorageCells().Free(t.Context(), connection, []domain.ProductID{uuid.New()})
+	require.ErrorIs(t, err, repository.ErrStorageCellsFree)
+}
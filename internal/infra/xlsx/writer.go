@@ -0,0 +1,121 @@
+// Package xlsx writes single-sheet spreadsheets row by row. The sheet is the
+// last entry of the archive, so rows go to the underlying writer as they are
+// written and nothing but the compressor state is kept in memory.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// MaxRows is the row limit of a spreadsheet.
+const MaxRows = 1 << 20
+
+var ErrTooManyRows = errors.New("xlsx: too many rows")
+
+const contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+	`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" ` +
+	`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+	`Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" ` +
+	`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" ` +
+	`Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
+
+const sheetStart = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<sheetData>`
+
+const sheetEnd = `</sheetData></worksheet>`
+
+type Writer struct {
+	archive *zip.Writer
+	sheet   io.Writer
+	rows    int
+}
+
+// NewWriter starts a workbook with one sheet. Close must be called to finish
+// the file; it does not close w.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	archive := zip.NewWriter(w)
+
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+
+	workbook := xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + name.String() + `" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+
+	for _, part := range []struct{ name, content string }{
+		{name: "[Content_Types].xml", content: contentTypes},
+		{name: "_rels/.rels", content: rootRels},
+		{name: "xl/workbook.xml", content: workbook},
+		{name: "xl/_rels/workbook.xml.rels", content: workbookRels},
+	} {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(sheet, sheetStart); err != nil {
+		return nil, err
+	}
+
+	return &Writer{archive: archive, sheet: sheet}, nil
+}
+
+// WriteRow appends a row of text cells.
+func (w *Writer) WriteRow(cells []string) error {
+	if w.rows == MaxRows {
+		return ErrTooManyRows
+	}
+	w.rows++
+
+	var row strings.Builder
+	row.WriteString("<row>")
+	for _, cell := range cells {
+		row.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(&row, []byte(cell)); err != nil {
+			return err
+		}
+		row.WriteString("</t></is></c>")
+	}
+	row.WriteString("</row>")
+
+	_, err := io.WriteString(w.sheet, row.String())
+
+	return err
+}
+
+func (w *Writer) Close() error {
+	if _, err := io.WriteString(w.sheet, sheetEnd); err != nil {
+		return err
+	}
+
+	return w.archive.Close()
+}
@@ -0,0 +1,58 @@
+package xlsx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"avito_pvz/internal/infra/xlsx"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	writer, err := xlsx.NewWriter(&buf, "ПВЗ & приемки")
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteRow([]string{"id", "city"}))
+	require.NoError(t, writer.WriteRow([]string{"1", "Москва <центр>"}))
+	require.NoError(t, writer.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	}
+	require.Contains(t, files, "[Content_Types].xml")
+	require.Contains(t, files, "_rels/.rels")
+	require.Contains(t, files, "xl/_rels/workbook.xml.rels")
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	require.NoError(t, xml.Unmarshal(files["xl/workbook.xml"], &workbook))
+	require.Len(t, workbook.Sheets, 1)
+	require.Equal(t, "ПВЗ & приемки", workbook.Sheets[0].Name)
+
+	var sheet struct {
+		Rows []struct {
+			Cells []string `xml:"c>is>t"`
+		} `xml:"sheetData>row"`
+	}
+	require.NoError(t, xml.Unmarshal(files["xl/worksheets/sheet1.xml"], &sheet))
+	require.Len(t, sheet.Rows, 2)
+	require.Equal(t, []string{"id", "city"}, sheet.Rows[0].Cells)
+	require.Equal(t, []string{"1", "Москва <центр>"}, sheet.Rows[1].Cells)
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // PVZ schedules use IANA zones, the runtime image has no zoneinfo.
 
 	"avito_pvz/internal/domain"
 	"avito_pvz/internal/infra/database"
@@ -33,11 +34,14 @@ const (
 	exitOK = iota
 	exitDotEnvFailed
 	exitServersFailed
+	exitConfigFailed
 )
 
 const (
 	readimeout        = 100 * time.Millisecond
 	readHeaderTimeout = 100 * time.Millisecond
+
+	defaultStaleReceptionCheckInterval = 5 * time.Minute
 )
 
 func main() {
@@ -51,7 +55,30 @@ func Run(ctx context.Context) int {
 		return exitDotEnvFailed
 	}
 
+	staleReceptionIdle, err := durationEnv("STALE_RECEPTION_IDLE", 0)
+	if err != nil {
+		slog.ErrorContext(ctx, "Parsing STALE_RECEPTION_IDLE failed.", log.ErrorAttr(err))
+
+		return exitConfigFailed
+	}
+	staleReceptionCheckInterval, err := durationEnv(
+		"STALE_RECEPTION_CHECK_INTERVAL",
+		defaultStaleReceptionCheckInterval,
+	)
+	if err == nil && staleReceptionCheckInterval <= 0 {
+		err = errors.New("interval must be positive")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Parsing STALE_RECEPTION_CHECK_INTERVAL failed.", log.ErrorAttr(err))
+
+		return exitConfigFailed
+	}
+
 	router := gin.Default()
+	// Handlers get *gin.Context as their context; with the fallback it is
+	// cancelled when the client disconnects. Only ExecuteReadOnly passes that
+	// on to Postgres, so streams and exports stop while writes still finish.
+	router.ContextWithFallback = true
 
 	var stop context.CancelFunc
 	ctx, stop = signal.NotifyContext(
@@ -98,9 +125,22 @@ func Run(ctx context.Context) int {
 		provider,
 		repository.NewReceptions(),
 		repository.NewProduct(),
+		repository.NewPVZ(),
+		repository.NewAnalytics(),
+		repository.NewProductTypes(),
+		repository.NewOrders(),
+		repository.NewStorageCells(),
 		metrics,
 	)
 
+	productTypesService := domain.NewProductTypeService(provider, repository.NewProductTypes())
+
+	analyticsService := domain.NewAnalyticsService(
+		provider,
+		repository.NewAnalytics(),
+		repository.NewPVZ(),
+	)
+
 	middlewares := []oapi.StrictMiddlewareFunc{
 		func(f strictgin.StrictGinHandlerFunc, _ string) strictgin.StrictGinHandlerFunc {
 			return func(ctx *gin.Context, request any) (any, error) {
@@ -116,6 +156,13 @@ func Run(ctx context.Context) int {
 				return f(ctx, request)
 			}
 		},
+		func(f strictgin.StrictGinHandlerFunc, _ string) strictgin.StrictGinHandlerFunc {
+			return func(ctx *gin.Context, request any) (any, error) {
+				ctx.Set(httpapi.CtxAcceptKey, ctx.Request.Header.Get("Accept"))
+
+				return f(ctx, request)
+			}
+		},
 		func(f strictgin.StrictGinHandlerFunc, _ string) strictgin.StrictGinHandlerFunc {
 			return func(ctx *gin.Context, request any) (any, error) {
 				start := time.Now()
@@ -131,7 +178,7 @@ func Run(ctx context.Context) int {
 	oapi.RegisterHandlers(
 		router,
 		oapi.NewStrictHandler(
-			httpapi.NewServer(pvzService, receptionsService, usersService),
+			httpapi.NewServer(pvzService, receptionsService, usersService, analyticsService, productTypesService),
 			middlewares,
 		),
 	)
@@ -139,6 +186,9 @@ func Run(ctx context.Context) int {
 	var eg errgroup.Group
 	startHTTPServer(ctx, &eg, router)
 	startPrometeusServer(ctx, &eg)
+	if 0 < staleReceptionIdle {
+		startStaleReceptionsJob(ctx, &eg, receptionsService, staleReceptionIdle, staleReceptionCheckInterval)
+	}
 
 	if err := eg.Wait(); err != nil {
 		slog.ErrorContext(ctx, "Runing servers failed.", log.ErrorAttr(err))
@@ -200,3 +250,61 @@ func startPrometeusServer(ctx context.Context, eg *errgroup.Group) {
 		return metricsSrv.Shutdown(ctx)
 	})
 }
+
+// startStaleReceptionsJob closes receptions idle for longer than idleFor every
+// interval. Every replica runs it; the service makes sure a reception is
+// closed once.
+func startStaleReceptionsJob(
+	ctx context.Context,
+	eg *errgroup.Group,
+	receptions *domain.ReceptionService,
+	idleFor time.Duration,
+	interval time.Duration,
+) {
+	eg.Go(func() error {
+		slog.InfoContext(
+			ctx,
+			"Starting stale receptions job",
+			slog.Duration("idle", idleFor),
+			slog.Duration("interval", interval),
+		)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+
+			closed, err := receptions.CloseStale(ctx, idleFor)
+			if err != nil {
+				slog.ErrorContext(ctx, "Closing stale receptions failed.", log.ErrorAttr(err))
+
+				continue
+			}
+			for _, reception := range closed {
+				slog.InfoContext(
+					ctx,
+					"Stale reception closed.",
+					slog.String("reception_id", reception.ID.String()),
+					slog.String("pvz_id", reception.PVZID.String()),
+					slog.Time("opened_at", reception.CreatedAt),
+				)
+			}
+		}
+	})
+}
+
+// durationEnv parses the environment variable as a time.Duration, returning
+// fallback when it is not set.
+func durationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	return time.ParseDuration(value)
+}